@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	checkExitAddress string
+	checkExitTimeout time.Duration
+)
+
+var commandCheckExit = &cobra.Command{
+	Use:   "check-exit [outbound]",
+	Short: "show real exit IP, country and IPv6 support of outbounds in a running core",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tag := ""
+		if len(args) > 0 {
+			tag = args[0]
+		}
+		if err := runCheckExit(tag); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandCheckExit.Flags().StringVarP(&checkExitAddress, "address", "a", "127.0.0.1:12345", "core gRPC address")
+	commandCheckExit.Flags().DurationVarP(&checkExitTimeout, "timeout", "t", 0, "overall deadline, 0 waits for the core (each outbound is bounded there)")
+	mainCommand.AddCommand(commandCheckExit)
+}
+
+func runCheckExit(tag string) error {
+	conn, err := grpc.NewClient(checkExitAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// ядро проверяет по 8 outbound'ов параллельно, каждый не дольше 15 с:
+	// на большом профиле любой фиксированный срок оказывается мал
+	ctx := context.Background()
+	if checkExitTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, checkExitTimeout)
+		defer cancel()
+	}
+	resp, err := pb.NewCoreClient(conn).CheckExit(ctx, &pb.CheckExitRequest{OutboundTag: tag})
+	if err != nil {
+		return err
+	}
+	if resp.ResponseCode != pb.ResponseCode_OK {
+		return fmt.Errorf("%s", resp.Message)
+	}
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "OUTBOUND\tEXIT IP\tCOUNTRY\tCOLO\tIPV6\tLATENCY")
+	for _, result := range resp.Results {
+		if result.Error != "" {
+			fmt.Fprintf(writer, "%s\terror: %s\t\t\t\t\n", result.Tag, result.Error)
+			continue
+		}
+		ipv6 := "no"
+		if result.Ipv6 {
+			ipv6 = "yes"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%dms\n", result.Tag, result.Ip, result.Country, result.Colo, ipv6, result.LatencyMs)
+	}
+	return writer.Flush()
+}
@@ -22,6 +22,7 @@ var (
 	configPath             string
 	defaultConfigs         config.RostovVPNOptions = *config.DefaultRostovVPNOptions()
 	commandBuildOutputPath string
+	commandBuildExplain    bool
 )
 
 var commandBuild = &cobra.Command{
@@ -62,6 +63,7 @@ var commandCheck = &cobra.Command{
 
 func init() {
 	commandBuild.Flags().StringVarP(&commandBuildOutputPath, "output", "o", "", "write result to file path instead of stdout")
+	commandBuild.Flags().BoolVar(&commandBuildExplain, "explain", false, "print where every inbound, dns server, rule and outbound came from to stderr")
 	addHConfigFlags(commandBuild)
 
 	mainCommand.AddCommand(commandBuild)
@@ -88,7 +90,14 @@ func build(path string, optionsPath string) error {
 			return err
 		}
 	}
-	config, err := config.BuildConfigJson(*RostovVPNOptions, *options)
+	built, report, err := config.BuildConfigWithReport(*RostovVPNOptions, *options)
+	if err != nil {
+		return err
+	}
+	if commandBuildExplain {
+		fmt.Fprint(os.Stderr, report.Explain(built))
+	}
+	config, err := config.OptionsJson(built)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var commandExplainRouteRequest config.RouteRequest
+
+var commandExplainRoute = &cobra.Command{
+	Use:   "explain-route",
+	Short: "Show which rule of the built config matches a domain or IP",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		commandExplainRouteRequest.Host = args[0]
+		err := explainRoute(configPath, rostovVPNSettingPath, commandExplainRouteRequest)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandExplainRoute.Flags().Uint16Var(&commandExplainRouteRequest.Port, "port", 443, "destination port")
+	commandExplainRoute.Flags().StringVar(&commandExplainRouteRequest.Network, "network", "tcp", "tcp or udp")
+	commandExplainRoute.Flags().StringVar(&commandExplainRouteRequest.Process, "process", "", "process path or android package")
+	commandExplainRoute.Flags().StringVar(&commandExplainRouteRequest.Inbound, "inbound", "", "inbound tag")
+	addHConfigFlags(commandExplainRoute)
+
+	mainCommand.AddCommand(commandExplainRoute)
+}
+
+func explainRoute(path string, optionsPath string, request config.RouteRequest) error {
+	if workingDir != "" {
+		path = filepath.Join(workingDir, path)
+		if optionsPath != "" {
+			optionsPath = filepath.Join(workingDir, optionsPath)
+		}
+	}
+	input, err := readConfigAt(path)
+	if err != nil {
+		return err
+	}
+	rostovVPNOptions := &defaultConfigs
+	if optionsPath != "" {
+		rostovVPNOptions, err = readRostovVPNOptionsAt(optionsPath)
+		if err != nil {
+			return err
+		}
+	}
+	// списки и локальные rule-set'ы ищутся там же, где их найдёт ядро
+	config.SetWorkingDir(workingDir)
+	options, report, err := config.BuildConfigWithReport(*rostovVPNOptions, *input)
+	if err != nil {
+		return err
+	}
+	explanation, err := config.ExplainRoute(nil, options, report, request)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(explanation)
+}
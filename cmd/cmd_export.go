@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	commandExportFormat     string
+	commandExportTags       []string
+	commandExportOutputPath string
+)
+
+var commandExport = &cobra.Command{
+	Use:   "export",
+	Short: "Export outbounds as share links, base64 subscription or Clash YAML",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := export(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandExport.Flags().StringVarP(&commandExportFormat, "format", "f", config.ExportFormatLinks, "links, subscription or clash")
+	commandExport.Flags().StringSliceVarP(&commandExportTags, "tag", "t", nil, "export only outbounds with these tags")
+	commandExport.Flags().StringVarP(&commandExportOutputPath, "output", "o", "", "write result to file path instead of stdout")
+
+	mainCommand.AddCommand(commandExport)
+}
+
+func export(path string) error {
+	if workingDir != "" {
+		path = filepath.Join(workingDir, path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	outbounds, err := config.ParseOutbounds(string(content), nil)
+	if err != nil {
+		return err
+	}
+	result, err := config.ExportOutbounds(config.FilterOutboundsByTag(outbounds, commandExportTags), commandExportFormat)
+	if err != nil {
+		return err
+	}
+	for _, skipped := range result.Skipped {
+		fmt.Fprintln(os.Stderr, "skipped", skipped)
+	}
+	if commandExportOutputPath != "" {
+		outputPath, _ := filepath.Abs(filepath.Join(workingDir, commandExportOutputPath))
+		err = os.WriteFile(outputPath, []byte(result.Content), 0644)
+		if err != nil {
+			return err
+		}
+		fmt.Println("result successfully written to ", outputPath)
+	} else {
+		fmt.Println(result.Content)
+	}
+	return nil
+}
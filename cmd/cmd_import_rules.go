@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var commandImportRulesOutputPath string
+
+var commandImportRules = &cobra.Command{
+	Use:   "import-rules",
+	Short: "Convert Clash rules or v2ray routing into RostovVPN rules and groups",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := importRules(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandImportRules.Flags().StringVarP(&commandImportRulesOutputPath, "output", "o", "", "write result to file path instead of stdout")
+
+	mainCommand.AddCommand(commandImportRules)
+}
+
+func importRules(path string) error {
+	content, err := os.ReadFile(filepath.Join(workingDir, path))
+	if err != nil {
+		return err
+	}
+	result, err := config.ImportRules(string(content))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, result.Summary())
+	settings, err := result.Settings()
+	if err != nil {
+		return err
+	}
+	if commandImportRulesOutputPath != "" {
+		outputPath, _ := filepath.Abs(filepath.Join(workingDir, commandImportRulesOutputPath))
+		err = os.WriteFile(outputPath, settings, 0644)
+		if err != nil {
+			return err
+		}
+		fmt.Println("result successfully written to ", outputPath)
+	} else {
+		os.Stdout.Write(settings)
+	}
+	return nil
+}
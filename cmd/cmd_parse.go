@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,7 +11,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var commandParseOutputPath string
+var (
+	commandParseOutputPath string
+	commandParseReport     bool
+	commandParseFilterPath string
+)
 
 var commandParse = &cobra.Command{
 	Use:   "parse",
@@ -26,6 +31,8 @@ var commandParse = &cobra.Command{
 
 func init() {
 	commandParse.Flags().StringVarP(&commandParseOutputPath, "output", "o", "", "write result to file path instead of stdout")
+	commandParse.Flags().BoolVarP(&commandParseReport, "report", "r", false, "print parse report as json to stderr")
+	commandParse.Flags().StringVarP(&commandParseFilterPath, "filter", "f", "", "profile filter json (include/exclude/rename)")
 
 	mainCommand.AddCommand(commandParse)
 }
@@ -34,7 +41,25 @@ func parse(path string) error {
 	if workingDir != "" {
 		path = filepath.Join(workingDir, path)
 	}
-	config, err := config.ParseConfig(path, true)
+	options := config.DefaultRostovVPNOptions()
+	if commandParseFilterPath != "" {
+		filterContent, err := os.ReadFile(filepath.Join(workingDir, commandParseFilterPath))
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(filterContent, &options.ProfileFilter); err != nil {
+			return fmt.Errorf("invalid profile filter: %w", err)
+		}
+	}
+	config, report, err := config.ParseConfigWithReport(path, true, options)
+	if report != nil {
+		if commandParseReport {
+			reportJson, _ := json.MarshalIndent(report, "", "  ")
+			fmt.Fprintln(os.Stderr, string(reportJson))
+		} else if report.Format != "" {
+			fmt.Fprintln(os.Stderr, report.Summary())
+		}
+	}
 	if err != nil {
 		return err
 	}
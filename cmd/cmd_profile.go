@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	profileAddress  string
+	profileDuration uint32
+	profileNames    []string
+	profileOutput   string
+	profileStop     bool
+)
+
+var commandProfile = &cobra.Command{
+	Use:   "profile",
+	Short: "capture pprof profiles from a running core",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runProfile(); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandProfile.Flags().StringVarP(&profileAddress, "address", "a", "127.0.0.1:12345", "core gRPC address")
+	commandProfile.Flags().Uint32VarP(&profileDuration, "duration", "t", 30, "profiling duration in seconds")
+	commandProfile.Flags().StringSliceVarP(&profileNames, "profiles", "p", nil, "profiles to capture: cpu, heap, goroutine, block (default all)")
+	commandProfile.Flags().StringVarP(&profileOutput, "output", "o", "", "save profiles locally to this directory instead of the core temp path")
+	commandProfile.Flags().BoolVar(&profileStop, "stop", false, "stop the running profiling session")
+	mainCommand.AddCommand(commandProfile)
+}
+
+func runProfile() error {
+	conn, err := grpc.NewClient(profileAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := pb.NewCoreClient(conn)
+
+	if profileStop {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, err := client.StopProfiling(ctx, &pb.Empty{})
+		if err != nil {
+			return err
+		}
+		if resp.ResponseCode != pb.ResponseCode_OK {
+			return fmt.Errorf("%s", resp.Message)
+		}
+		fmt.Println("profiling stopped")
+		return nil
+	}
+
+	stream, err := client.StartProfiling(context.Background(), &pb.ProfilingRequest{
+		DurationSeconds: profileDuration,
+		Profiles:        profileNames,
+		Stream:          profileOutput != "",
+	})
+	if err != nil {
+		return err
+	}
+	if profileOutput != "" {
+		if err := os.MkdirAll(profileOutput, 0o755); err != nil {
+			return err
+		}
+	}
+	timestamp := time.Now().Format("20060102-150405")
+	for {
+		data, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if profileOutput == "" {
+			fmt.Printf("%s: %s\n", data.Name, data.Path)
+			continue
+		}
+		path := filepath.Join(profileOutput, fmt.Sprintf("%s-%s.pprof", data.Name, timestamp))
+		if err := os.WriteFile(path, data.Data, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s\n", data.Name, path)
+	}
+}
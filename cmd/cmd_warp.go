@@ -1,14 +1,13 @@
 package cmd
 
 import (
-	"bufio"
 	"encoding/json"
 	"fmt"
-
 	"os"
-	"strings"
+	"path/filepath"
 
 	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/sagernet/sing-box/log"
 	T "github.com/sagernet/sing-box/option"
 	"github.com/spf13/cobra"
 )
@@ -28,26 +27,45 @@ var commandWarp = &cobra.Command{
 	},
 }
 
-func init() {
-	// commandWarp.Flags().StringVarP(&warpKey, "key", "k", "", "warp key")
-	mainCommand.AddCommand(commandWarp)
-}
+var warpExportOutputPath string
 
-type WireGuardConfig struct {
-	Interface InterfaceConfig `json:"Interface"`
-	Peer      PeerConfig      `json:"Peer"`
+var commandWarpExport = &cobra.Command{
+	Use:   "export [account]",
+	Short: "export warp account as wg-quick .conf",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := "p1"
+		if len(args) > 0 {
+			name = args[0]
+		}
+		conf, err := config.ExportWarpAccountConf(name, nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeWireGuardConf(warpExportOutputPath, conf); err != nil {
+			log.Fatal(err)
+		}
+	},
 }
 
-type InterfaceConfig struct {
-	PrivateKey string   `json:"PrivateKey"`
-	DNS        string   `json:"DNS"`
-	Address    []string `json:"Address"`
+func init() {
+	// commandWarp.Flags().StringVarP(&warpKey, "key", "k", "", "warp key")
+	commandWarpExport.Flags().StringVarP(&warpExportOutputPath, "output", "o", "", "write .conf to file path instead of stdout")
+	commandWarp.AddCommand(commandWarpExport)
+	mainCommand.AddCommand(commandWarp)
 }
 
-type PeerConfig struct {
-	PublicKey  string   `json:"PublicKey"`
-	AllowedIPs []string `json:"AllowedIPs"`
-	Endpoint   string   `json:"Endpoint"`
+func writeWireGuardConf(path string, conf string) error {
+	if path == "" {
+		fmt.Print(conf)
+		return nil
+	}
+	outputPath, _ := filepath.Abs(filepath.Join(workingDir, path))
+	if err := os.WriteFile(outputPath, []byte(conf), 0600); err != nil {
+		return err
+	}
+	fmt.Println("result successfully written to ", outputPath)
+	return nil
 }
 
 type SingboxConfig struct {
@@ -62,55 +80,6 @@ type SingboxConfig struct {
 	MTU           int      `json:"mtu"`
 }
 
-func readWireGuardConfig(filePath string) (WireGuardConfig, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return WireGuardConfig{}, err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-
-	var wgConfig WireGuardConfig
-	var currentSection string
-
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
-			currentSection = strings.TrimSpace(line[1 : len(line)-1])
-			continue
-		}
-
-		if currentSection == "Interface" {
-			parseInterfaceConfig(&wgConfig.Interface, line)
-		} else if currentSection == "Peer" {
-			parsePeerConfig(&wgConfig.Peer, line)
-		}
-	}
-
-	return wgConfig, nil
-}
-
-func parseInterfaceConfig(interfaceConfig *InterfaceConfig, line string) {
-	if strings.HasPrefix(line, "PrivateKey") {
-		interfaceConfig.PrivateKey = strings.TrimSpace(strings.SplitN(line, "=", 2)[1])
-	} else if strings.HasPrefix(line, "DNS") {
-		interfaceConfig.DNS = strings.TrimSpace(strings.SplitN(line, "=", 2)[1])
-	} else if strings.HasPrefix(line, "Address") {
-		interfaceConfig.Address = append(interfaceConfig.Address, strings.TrimSpace(strings.SplitN(line, "=", 2)[1]))
-	}
-}
-
-func parsePeerConfig(peerConfig *PeerConfig, line string) {
-	if strings.HasPrefix(line, "PublicKey") {
-		peerConfig.PublicKey = strings.TrimSpace(strings.SplitN(line, "=", 2)[1])
-	} else if strings.HasPrefix(line, "AllowedIPs") {
-		peerConfig.AllowedIPs = append(peerConfig.AllowedIPs, strings.TrimSpace(strings.SplitN(line, "=", 2)[1]))
-	} else if strings.HasPrefix(line, "Endpoint") {
-		peerConfig.Endpoint = strings.TrimSpace(strings.SplitN(line, "=", 2)[1])
-	}
-}
 func generateWarp() (*T.Outbound, error) {
 	_, _, wg, err := config.GenerateWarpInfo("", "", "")
 
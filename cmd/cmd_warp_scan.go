@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/Darkmen203/rostovvpn-core/v2/warpscan"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	warpScanAccount     string
+	warpScanPrefixes    []string
+	warpScanPorts       []uint
+	warpScanIPv6        bool
+	warpScanConcurrency int
+	warpScanMaxRTT      time.Duration
+	warpScanTimeout     time.Duration
+	warpScanLimit       int
+	warpScanCount       int
+	warpScanNoSave      bool
+)
+
+var commandWarpScan = &cobra.Command{
+	Use:   "scan",
+	Short: "find reachable Warp endpoints and store the best as clean ip",
+	Args:  cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := warpScan(); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandWarpScan.Flags().StringVarP(&warpScanAccount, "account", "a", "p1", "warp account used for handshakes")
+	commandWarpScan.Flags().StringSliceVar(&warpScanPrefixes, "prefix", nil, "ip ranges to scan (default: all Warp ranges)")
+	commandWarpScan.Flags().UintSliceVarP(&warpScanPorts, "port", "p", nil, "ports to scan (default: all Warp ports)")
+	commandWarpScan.Flags().BoolVar(&warpScanIPv6, "ipv6", false, "scan IPv6 ranges too")
+	commandWarpScan.Flags().IntVarP(&warpScanConcurrency, "concurrency", "c", warpscan.DefaultConcurrency, "parallel handshakes")
+	commandWarpScan.Flags().DurationVar(&warpScanMaxRTT, "max-rtt", warpscan.DefaultMaxRTT, "ignore endpoints slower than this")
+	commandWarpScan.Flags().DurationVar(&warpScanTimeout, "timeout", warpscan.DefaultTimeout, "handshake timeout")
+	commandWarpScan.Flags().IntVarP(&warpScanLimit, "limit", "l", warpscan.DefaultLimit, "max endpoints to probe")
+	commandWarpScan.Flags().IntVarP(&warpScanCount, "count", "n", warpscan.DefaultCount, "stop after this many good endpoints")
+	commandWarpScan.Flags().BoolVar(&warpScanNoSave, "no-save", false, "only print results")
+
+	commandWarp.AddCommand(commandWarpScan)
+}
+
+func warpScan() error {
+	wgConfig, err := config.ResolveWarpAccount(warpScanAccount, nil)
+	if err != nil {
+		return err
+	}
+	var prefixes []netip.Prefix
+	for _, value := range warpScanPrefixes {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	var ports []uint16
+	for _, port := range warpScanPorts {
+		ports = append(ports, uint16(port))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	results, err := warpscan.Scan(ctx, warpscan.Options{
+		PrivateKey:    wgConfig.PrivateKey,
+		PeerPublicKey: wgConfig.PeerPublicKey,
+		Reserved:      wgConfig.Reserved(),
+		Prefixes:      prefixes,
+		Ports:         ports,
+		IPv4:          true,
+		IPv6:          warpScanIPv6,
+		Concurrency:   warpScanConcurrency,
+		MaxRTT:        warpScanMaxRTT,
+		Timeout:       warpScanTimeout,
+		Limit:         warpScanLimit,
+		Count:         warpScanCount,
+	}, func(progress warpscan.Progress) {
+		if progress.Err == nil {
+			fmt.Printf("[%d/%d] %s %d ms\n", progress.Probed, progress.Total, progress.Last.Endpoint, progress.Last.RTT.Milliseconds())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no reachable warp endpoints found")
+	}
+	fmt.Println("best endpoints:")
+	for _, result := range results {
+		fmt.Printf("  %s %d ms\n", result.Endpoint, result.RTT.Milliseconds())
+	}
+	if warpScanNoSave {
+		return nil
+	}
+	if err := config.SaveWarpScanResults(results); err != nil {
+		return err
+	}
+	fmt.Println("saved, warp outbounds without clean-ip will use", results[0].Endpoint)
+	return nil
+}
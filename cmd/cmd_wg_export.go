@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/sagernet/sing-box/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	wgExportTag       string
+	wgExportOutputDir string
+)
+
+var commandWireGuardExport = &cobra.Command{
+	Use:   "wg-export",
+	Short: "export WireGuard outbounds of a profile as wg-quick .conf files",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := wireGuardExport(args[0]); err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+func init() {
+	commandWireGuardExport.Flags().StringVarP(&wgExportTag, "tag", "t", "", "export only the outbound with this tag")
+	commandWireGuardExport.Flags().StringVarP(&wgExportOutputDir, "output", "o", "", "write <tag>.conf files to directory instead of stdout")
+
+	mainCommand.AddCommand(commandWireGuardExport)
+}
+
+func wireGuardExport(path string) error {
+	if workingDir != "" {
+		path = filepath.Join(workingDir, path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	confs, err := config.ExportWireGuardConfs(string(content), nil)
+	if err != nil {
+		return err
+	}
+	tags := make([]string, 0, len(confs))
+	for tag := range confs {
+		if wgExportTag == "" || tag == wgExportTag {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return fmt.Errorf("wireguard outbound %s not found", wgExportTag)
+	}
+	sort.Strings(tags)
+	if wgExportOutputDir != "" {
+		if err := os.MkdirAll(filepath.Join(workingDir, wgExportOutputDir), 0o755); err != nil {
+			return err
+		}
+	}
+	for i, tag := range tags {
+		if wgExportOutputDir == "" {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Print(confs[tag])
+			continue
+		}
+		fileName := strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(tag) + ".conf"
+		if err := writeWireGuardConf(filepath.Join(wgExportOutputDir, fileName), confs[tag]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
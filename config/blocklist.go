@@ -0,0 +1,605 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/blocklist"
+	"github.com/sagernet/sing-box/common/srs"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const (
+	BlocklistFormatHosts   = "hosts"
+	BlocklistFormatABP     = "abp"
+	BlocklistFormatDomains = "domains"
+
+	// BlocklistDir — скомпилированные списки, относительно рабочей директории ядра
+	// (SetWorkingDir)
+	BlocklistDir       = "blocklists"
+	BlocklistTagPrefix = "blocklist-"
+
+	defaultBlocklistUpdateInterval = 24 * time.Hour
+	blocklistFetchTimeout          = 30 * time.Second
+	blocklistMaxSize               = 64 << 20
+)
+
+// blocklistHTTPClient — клиент для скачивания списков, в тестах подменяется.
+var blocklistHTTPClient = &http.Client{Timeout: blocklistFetchTimeout}
+
+// BlocklistStatus — состояние списка после последней сборки или обновления.
+type BlocklistStatus struct {
+	Name      string
+	Source    string
+	Enabled   bool
+	Entries   int
+	Skipped   int // строки, которые не удалось перевести в правило
+	UpdatedAt time.Time
+	LastError string
+}
+
+var (
+	blocklistAccess   sync.RWMutex
+	blocklistStatuses []*BlocklistStatus
+
+	workingDir string
+)
+
+// SetWorkingDir задаёт рабочую директорию ядра. Пути к скачанным спискам
+// в конфиге абсолютные: ядро и sing-box могут сменить текущую директорию
+// уже после сборки. Пустая — текущая на момент сборки.
+func SetWorkingDir(dir string) {
+	workingDir = dir
+}
+
+func blocklistDir() string {
+	dir := filepath.Join(workingDir, BlocklistDir)
+	if absolute, err := filepath.Abs(dir); err == nil {
+		return absolute
+	}
+	return dir
+}
+
+// Blocklists — списки последней сборки конфига в порядке настроек.
+func Blocklists() []BlocklistStatus {
+	blocklistAccess.RLock()
+	defer blocklistAccess.RUnlock()
+	result := make([]BlocklistStatus, 0, len(blocklistStatuses))
+	for _, status := range blocklistStatuses {
+		result = append(result, *status)
+	}
+	return result
+}
+
+func updateBlocklistStatus(name string, update func(status *BlocklistStatus)) {
+	blocklistAccess.Lock()
+	defer blocklistAccess.Unlock()
+	for _, status := range blocklistStatuses {
+		if status.Name == name {
+			update(status)
+			return
+		}
+	}
+}
+
+// BlocklistTag — тег rule-set'а списка, его outbound'а и DNS-сервера: по нему
+// же считаются срабатывания (blocklist.Hits).
+func BlocklistTag(name string) string {
+	return BlocklistTagPrefix + blocklistFileName(name)
+}
+
+// BlocklistPath — скомпилированный .srs списка.
+func BlocklistPath(name string) string {
+	return filepath.Join(blocklistDir(), blocklistFileName(name)+".srs")
+}
+
+func blocklistMetaPath(name string) string {
+	return filepath.Join(blocklistDir(), blocklistFileName(name)+".json")
+}
+
+// blocklistFileName — имя списка, пригодное для файла и тега.
+func blocklistFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return '_'
+	}, strings.TrimSpace(name))
+}
+
+func blocklistUpdateInterval(list BlocklistOptions) time.Duration {
+	if list.UpdateInterval > 0 {
+		return list.UpdateInterval.Duration()
+	}
+	return defaultBlocklistUpdateInterval
+}
+
+// blocklistEntries — результат разбора: точные домены, суффиксы (домен вместе
+// с поддоменами, с точкой в начале — только поддомены) и исключения ABP (@@).
+type blocklistEntries struct {
+	domains    []string
+	suffixes   []string
+	exceptions []string
+	skipped    int
+}
+
+func (e *blocklistEntries) count() int {
+	return len(e.domains) + len(e.suffixes)
+}
+
+// ParseBlocklist разбирает список в формате hosts («0.0.0.0 домен» — только
+// сам домен), Adblock Plus («||домен^», исключения «@@||домен^»; правила с
+// путями и модификаторами, кроме $important/$all/$document, пропускаются) или
+// просто доменов по строке (домен вместе с поддоменами, *.домен — только
+// поддомены). Пустой format — определить по содержимому.
+func ParseBlocklist(content []byte, format string) (option.PlainRuleSet, int, int, error) {
+	if format == "" {
+		format = detectBlocklistFormat(content)
+	}
+	entries := &blocklistEntries{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		switch format {
+		case BlocklistFormatHosts:
+			parseHostsBlocklistLine(line, entries)
+		case BlocklistFormatABP:
+			parseABPLine(line, entries)
+		case BlocklistFormatDomains:
+			parseDomainsLine(line, entries)
+		default:
+			return option.PlainRuleSet{}, 0, 0, fmt.Errorf("unknown blocklist format: %s", format)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return option.PlainRuleSet{}, 0, 0, err
+	}
+	return entries.ruleSet(), entries.count(), entries.skipped, nil
+}
+
+func detectBlocklistFormat(content []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lines := 0; scanner.Scan() && lines < 200; lines++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[Adblock") || strings.HasPrefix(line, "||") || strings.HasPrefix(line, "@@") || strings.HasPrefix(line, "!") {
+			return BlocklistFormatABP
+		}
+		if fields := strings.Fields(line); len(fields) >= 2 {
+			if _, err := netip.ParseAddr(fields[0]); err == nil {
+				return BlocklistFormatHosts
+			}
+		}
+		return BlocklistFormatDomains
+	}
+	return BlocklistFormatDomains
+}
+
+func stripBlocklistComment(line string) string {
+	if i := strings.IndexByte(line, '#'); i >= 0 {
+		line = line[:i]
+	}
+	return strings.TrimSpace(line)
+}
+
+func parseHostsBlocklistLine(line string, entries *blocklistEntries) {
+	fields := strings.Fields(stripBlocklistComment(line))
+	if len(fields) == 0 {
+		return
+	}
+	if _, err := netip.ParseAddr(fields[0]); err != nil || len(fields) < 2 {
+		entries.skipped++
+		return
+	}
+	for _, name := range fields[1:] {
+		domain := normalizeHostsDomain(name)
+		// localhost, broadcasthost и прочие служебные имена из шапки hosts
+		if !strings.Contains(domain, ".") || domain == "localhost.localdomain" {
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(domain, "*."); ok && isBlocklistDomain(suffix) {
+			entries.suffixes = append(entries.suffixes, "."+suffix)
+		} else if isBlocklistDomain(domain) {
+			entries.domains = append(entries.domains, domain)
+		} else {
+			entries.skipped++
+		}
+	}
+}
+
+var abpAllowedModifiers = map[string]bool{"important": true, "all": true, "document": true, "doc": true}
+
+func parseABPLine(line string, entries *blocklistEntries) {
+	if strings.HasPrefix(line, "!") || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "#") {
+		return
+	}
+	exception := false
+	if rest, ok := strings.CutPrefix(line, "@@"); ok {
+		exception, line = true, rest
+	}
+	rest, ok := strings.CutPrefix(line, "||")
+	if !ok {
+		entries.skipped++
+		return
+	}
+	pattern, modifiers, _ := strings.Cut(rest, "$")
+	if modifiers != "" {
+		for _, modifier := range strings.Split(modifiers, ",") {
+			if !abpAllowedModifiers[strings.TrimSpace(modifier)] {
+				entries.skipped++
+				return
+			}
+		}
+	}
+	pattern = strings.TrimSuffix(strings.TrimSuffix(pattern, "|"), "^")
+	domain := normalizeHostsDomain(pattern)
+	suffix := domain
+	if trimmed, ok := strings.CutPrefix(domain, "*."); ok {
+		domain, suffix = trimmed, "."+trimmed
+	}
+	if !isBlocklistDomain(domain) {
+		entries.skipped++
+		return
+	}
+	if exception {
+		entries.exceptions = append(entries.exceptions, suffix)
+	} else {
+		entries.suffixes = append(entries.suffixes, suffix)
+	}
+}
+
+func parseDomainsLine(line string, entries *blocklistEntries) {
+	if strings.HasPrefix(line, "!") {
+		return
+	}
+	line = stripBlocklistComment(line)
+	if line == "" {
+		return
+	}
+	domain := normalizeHostsDomain(line)
+	suffix := domain
+	if trimmed, ok := strings.CutPrefix(domain, "*."); ok {
+		domain, suffix = trimmed, "."+trimmed
+	}
+	if !isBlocklistDomain(domain) {
+		entries.skipped++
+		return
+	}
+	entries.suffixes = append(entries.suffixes, suffix)
+}
+
+func isBlocklistDomain(domain string) bool {
+	if len(domain) == 0 || len(domain) > 253 || !strings.Contains(domain, ".") ||
+		strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return false
+	}
+	if _, err := netip.ParseAddr(domain); err == nil {
+		return false
+	}
+	for _, r := range domain {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func uniqueSorted(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	sort.Strings(list)
+	result := list[:1]
+	for _, item := range list[1:] {
+		if item != result[len(result)-1] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// ruleSet — пустой список даёт rule-set без правил: он ничего не матчит,
+// но файл остаётся валидным для sing-box.
+func (e *blocklistEntries) ruleSet() option.PlainRuleSet {
+	e.domains = uniqueSorted(e.domains)
+	e.suffixes = uniqueSorted(e.suffixes)
+	e.exceptions = uniqueSorted(e.exceptions)
+	if e.count() == 0 {
+		return option.PlainRuleSet{}
+	}
+	block := option.HeadlessRule{
+		Type: C.RuleTypeDefault,
+		DefaultOptions: option.DefaultHeadlessRule{
+			Domain:       e.domains,
+			DomainSuffix: e.suffixes,
+		},
+	}
+	if len(e.exceptions) == 0 {
+		return option.PlainRuleSet{Rules: []option.HeadlessRule{block}}
+	}
+	return option.PlainRuleSet{Rules: []option.HeadlessRule{{
+		Type: C.RuleTypeLogical,
+		LogicalOptions: option.LogicalHeadlessRule{
+			Mode: C.LogicalTypeAnd,
+			Rules: []option.HeadlessRule{block, {
+				Type: C.RuleTypeDefault,
+				DefaultOptions: option.DefaultHeadlessRule{
+					DomainSuffix: e.exceptions,
+					Invert:       true,
+				},
+			}},
+		},
+	}}}
+}
+
+// remoteBlocklist — список качается по сети; локальный файл читается сразу.
+func remoteBlocklist(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+// BlocklistInline — встраивается ли список в конфиг. Удалённые списки при
+// сборке не качаются, поэтому всегда идут через .srs и фоновый апдейтер.
+func BlocklistInline(list BlocklistOptions) bool {
+	return list.Inline && !remoteBlocklist(list.Source)
+}
+
+func fetchBlocklist(ctx context.Context, source string) ([]byte, error) {
+	if !remoteBlocklist(source) {
+		return os.ReadFile(os.ExpandEnv(source))
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := blocklistHTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", response.Status)
+	}
+	content, err := io.ReadAll(io.LimitReader(response.Body, blocklistMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > blocklistMaxSize {
+		return nil, fmt.Errorf("blocklist is larger than %d bytes", blocklistMaxSize)
+	}
+	return content, nil
+}
+
+// CompileBlocklist скачивает (или читает) список и переводит его в rule-set.
+func CompileBlocklist(ctx context.Context, list BlocklistOptions) (option.PlainRuleSet, int, int, error) {
+	content, err := fetchBlocklist(ctx, list.Source)
+	if err != nil {
+		return option.PlainRuleSet{}, 0, 0, err
+	}
+	return ParseBlocklist(content, list.Format)
+}
+
+// blocklistMeta лежит рядом с .srs: по нему видно, когда список обновлялся
+// и не поменялся ли источник.
+type blocklistMeta struct {
+	Source    string    `json:"source"`
+	Format    string    `json:"format,omitempty"`
+	Entries   int       `json:"entries"`
+	Skipped   int       `json:"skipped"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func readBlocklistMeta(name string) (blocklistMeta, error) {
+	var meta blocklistMeta
+	content, err := os.ReadFile(blocklistMetaPath(name))
+	if err != nil {
+		return meta, err
+	}
+	err = json.Unmarshal(content, &meta)
+	return meta, err
+}
+
+// BlocklistDue — пора ли перекачать список: источник поменялся, файла нет
+// или прошёл интервал обновления.
+func BlocklistDue(list BlocklistOptions) bool {
+	meta, err := readBlocklistMeta(list.Name)
+	if err != nil || meta.Source != list.Source || meta.Format != list.Format {
+		return true
+	}
+	if _, err := os.Stat(BlocklistPath(list.Name)); err != nil {
+		return true
+	}
+	return time.Since(meta.UpdatedAt) >= blocklistUpdateInterval(list)
+}
+
+func writeFileAtomic(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	temp := path + ".tmp"
+	if err := os.WriteFile(temp, content, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(temp, path)
+}
+
+func writeBlocklist(list BlocklistOptions, ruleSet option.PlainRuleSet, meta blocklistMeta) error {
+	var buffer bytes.Buffer
+	if err := srs.Write(&buffer, ruleSet, C.RuleSetVersionCurrent); err != nil {
+		return err
+	}
+	// сначала meta: sing-box перечитает .srs сразу после записи
+	content, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(blocklistMetaPath(list.Name), content); err != nil {
+		return err
+	}
+	return writeFileAtomic(BlocklistPath(list.Name), buffer.Bytes())
+}
+
+// UpdateBlocklist перекомпилирует список в BlocklistPath. sing-box следит за
+// файлом локального rule-set'а и подхватывает новую версию без перезапуска.
+func UpdateBlocklist(ctx context.Context, list BlocklistOptions) error {
+	ruleSet, entries, skipped, err := CompileBlocklist(ctx, list)
+	if err == nil {
+		meta := blocklistMeta{Source: list.Source, Format: list.Format, Entries: entries, Skipped: skipped, UpdatedAt: time.Now()}
+		err = writeBlocklist(list, ruleSet, meta)
+	}
+	updateBlocklistStatus(list.Name, func(status *BlocklistStatus) {
+		if err != nil {
+			status.LastError = err.Error()
+			return
+		}
+		status.Entries, status.Skipped = entries, skipped
+		status.UpdatedAt, status.LastError = time.Now(), ""
+	})
+	return err
+}
+
+// prepareBlocklist готовит rule-set к старту, не ходя в сеть. Скачанный ранее
+// .srs берётся как есть (обновит фоновый апдейтер), локальный файл
+// компилируется сейчас. Иначе пишется пустой rule-set с нулевой датой: конфиг
+// собирается сразу, а апдейтер скачает список при первом же проходе.
+func prepareBlocklist(list BlocklistOptions, status *BlocklistStatus) (option.RuleSet, error) {
+	tag := BlocklistTag(list.Name)
+	if BlocklistInline(list) {
+		ruleSet, entries, skipped, err := CompileBlocklist(context.Background(), list)
+		if err != nil {
+			return option.RuleSet{}, err
+		}
+		status.Entries, status.Skipped, status.UpdatedAt = entries, skipped, time.Now()
+		// inline rule-set без правил sing-box не принимает
+		if len(ruleSet.Rules) == 0 {
+			return option.RuleSet{}, fmt.Errorf("blocklist is empty")
+		}
+		return option.RuleSet{
+			Type:          C.RuleSetTypeInline,
+			Tag:           tag,
+			InlineOptions: option.PlainRuleSet{Rules: ruleSet.Rules},
+		}, nil
+	}
+
+	localRuleSet := option.RuleSet{
+		Type:         C.RuleSetTypeLocal,
+		Tag:          tag,
+		Format:       C.RuleSetFormatBinary,
+		LocalOptions: option.LocalRuleSet{Path: BlocklistPath(list.Name)},
+	}
+	meta, metaErr := readBlocklistMeta(list.Name)
+	_, fileErr := os.Stat(BlocklistPath(list.Name))
+	if metaErr == nil && fileErr == nil && meta.Source == list.Source && meta.Format == list.Format {
+		status.Entries, status.Skipped, status.UpdatedAt = meta.Entries, meta.Skipped, meta.UpdatedAt
+		return localRuleSet, nil
+	}
+	ruleSet, meta := option.PlainRuleSet{}, blocklistMeta{Source: list.Source, Format: list.Format}
+	if !remoteBlocklist(list.Source) {
+		compiled, entries, skipped, err := CompileBlocklist(context.Background(), list)
+		if err != nil {
+			status.LastError = err.Error()
+		} else {
+			ruleSet = compiled
+			meta.Entries, meta.Skipped, meta.UpdatedAt = entries, skipped, time.Now()
+			status.Entries, status.Skipped, status.UpdatedAt = entries, skipped, meta.UpdatedAt
+		}
+	}
+	if err := writeBlocklist(list, ruleSet, meta); err != nil {
+		return option.RuleSet{}, err
+	}
+	return localRuleSet, nil
+}
+
+// blocklistConfig — что включённые списки добавляют в конфиг.
+type blocklistConfig struct {
+	ruleSets   []option.RuleSet
+	outbounds  []option.Outbound
+	dnsServers []option.DNSServerOptions
+	routeRules []option.Rule
+	dnsRules   []option.DNSRule
+}
+
+// blocklistRules — rule-set'ы и правила блокировки включённых списков.
+// Правило каждого списка ведёт в свои outbound и DNS-сервер типа blocklist:
+// они блокируют так же, как block и predefined, и считают срабатывания.
+func blocklistRules(opt *RostovVPNOptions, report *BuildReport) (blocklistConfig, error) {
+	var result blocklistConfig
+	statuses := make([]*BlocklistStatus, 0, len(opt.Blocklists))
+	seen := make(map[string]bool)
+	var tags []string
+	for i, list := range opt.Blocklists {
+		name := blocklistFileName(list.Name)
+		if name == "" {
+			return result, fmt.Errorf("blocklists[%d]: missing name", i)
+		}
+		if seen[name] {
+			return result, fmt.Errorf("blocklists[%s]: duplicate name", list.Name)
+		}
+		seen[name] = true
+		if strings.TrimSpace(list.Source) == "" {
+			return result, fmt.Errorf("blocklists[%s]: missing source", list.Name)
+		}
+		switch list.Format {
+		case "", BlocklistFormatHosts, BlocklistFormatABP, BlocklistFormatDomains:
+		default:
+			return result, fmt.Errorf("blocklists[%s]: unknown format %q", list.Name, list.Format)
+		}
+		status := &BlocklistStatus{Name: list.Name, Source: list.Source, Enabled: list.Enable}
+		statuses = append(statuses, status)
+		if !list.Enable {
+			continue
+		}
+		if list.Inline && !BlocklistInline(list) {
+			report.ignore(fmt.Sprintf("blocklists[%d].inline", i), list.Name, "remote list is not downloaded while building, it is attached as .srs and updated in background")
+		}
+		ruleSet, err := prepareBlocklist(list, status)
+		if err != nil {
+			// недоступный список не должен мешать подключению
+			status.LastError = err.Error()
+			report.ignore(fmt.Sprintf("blocklists[%d]", i), list.Name, "list is skipped: "+err.Error())
+			continue
+		}
+		result.ruleSets = append(result.ruleSets, ruleSet)
+		tags = append(tags, ruleSet.Tag)
+	}
+	blocklistAccess.Lock()
+	blocklistStatuses = statuses
+	blocklistAccess.Unlock()
+
+	for _, tag := range tags {
+		result.outbounds = append(result.outbounds, option.Outbound{Type: blocklist.Type, Tag: tag, Options: &option.StubOptions{}})
+		result.dnsServers = append(result.dnsServers, option.DNSServerOptions{Type: blocklist.Type, Tag: tag, Options: &option.StubOptions{}})
+		result.routeRules = append(result.routeRules, newRouteRule(option.RawDefaultRule{RuleSet: []string{tag}}, tag))
+		// без кэша: повторный запрос тоже доходит до сервера и считается
+		result.dnsRules = append(result.dnsRules, newDNSRouteRule(option.DefaultDNSRule{
+			RawDefaultDNSRule: option.RawDefaultDNSRule{RuleSet: []string{tag}},
+			DNSRuleAction: option.DNSRuleAction{
+				RouteOptions: option.DNSRouteActionOptions{DisableCache: true},
+			},
+		}, tag))
+	}
+	return result, nil
+}
@@ -0,0 +1,251 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/blocklist"
+	"github.com/sagernet/sing-box/common/srs"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestParseBlocklist(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		format   string
+		domains  []string
+		suffixes []string
+		except   []string
+		skipped  int
+	}{
+		{
+			name:    "hosts",
+			content: "# header\n127.0.0.1 localhost\n0.0.0.0 0.0.0.0\n0.0.0.0 ads.example.com tracker.example.net # comment\n0.0.0.0 *.metrics.test\n",
+			format:  BlocklistFormatHosts,
+			domains: []string{"ads.example.com", "tracker.example.net"}, suffixes: []string{".metrics.test"}, skipped: 1,
+		},
+		{
+			name:     "abp",
+			content:  "[Adblock Plus 2.0]\n! comment\n||ads.example.com^\n||track.example.org^$important\n||cdn.example.org^$third-party\n/banner/*\n@@||good.ads.example.com^\n",
+			format:   BlocklistFormatABP,
+			suffixes: []string{"ads.example.com", "track.example.org"}, except: []string{"good.ads.example.com"}, skipped: 2,
+		},
+		{
+			name:     "domains",
+			content:  "# list\nads.example.com\n*.tracker.test\nnot a domain\n",
+			format:   BlocklistFormatDomains,
+			suffixes: []string{".tracker.test", "ads.example.com"}, skipped: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if format := detectBlocklistFormat([]byte(test.content)); format != test.format {
+				t.Errorf("detected format %q", format)
+			}
+			ruleSet, entries, skipped, err := ParseBlocklist([]byte(test.content), "")
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if entries != len(test.domains)+len(test.suffixes) || skipped != test.skipped {
+				t.Errorf("entries = %d, skipped = %d", entries, skipped)
+			}
+			if len(ruleSet.Rules) != 1 {
+				t.Fatalf("rules: %+v", ruleSet.Rules)
+			}
+			block := ruleSet.Rules[0].DefaultOptions
+			if len(test.except) > 0 {
+				logical := ruleSet.Rules[0].LogicalOptions
+				if ruleSet.Rules[0].Type != C.RuleTypeLogical || len(logical.Rules) != 2 {
+					t.Fatalf("expected logical rule with exceptions: %+v", ruleSet.Rules[0])
+				}
+				block = logical.Rules[0].DefaultOptions
+				exception := logical.Rules[1].DefaultOptions
+				if !exception.Invert || strings.Join(exception.DomainSuffix, ",") != strings.Join(test.except, ",") {
+					t.Errorf("exceptions: %+v", exception)
+				}
+			}
+			if strings.Join(block.Domain, ",") != strings.Join(test.domains, ",") {
+				t.Errorf("domains = %v", block.Domain)
+			}
+			if strings.Join(block.DomainSuffix, ",") != strings.Join(test.suffixes, ",") {
+				t.Errorf("suffixes = %v", block.DomainSuffix)
+			}
+		})
+	}
+}
+
+func TestBuildConfigBlocklists(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	// как в ядре: после сборки текущая директория может смениться
+	SetWorkingDir(dir)
+	t.Cleanup(func() { SetWorkingDir("") })
+	if err := os.WriteFile("ads.txt", []byte("0.0.0.0 ads.example.com\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	opt := DefaultRostovVPNOptions()
+	opt.Blocklists = []BlocklistOptions{
+		{Name: "My Ads", Source: "ads.txt", Enable: true},
+		{Name: "inline", Source: "ads.txt", Enable: true, Inline: true},
+		{Name: "off", Source: "ads.txt"},
+		{Name: "missing", Source: "missing.txt", Enable: true},
+		{Name: "inline missing", Source: "missing.txt", Enable: true, Inline: true},
+	}
+	options, report, err := BuildConfigWithReport(*opt, option.Options{})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	// путь абсолютный: sing-box читает его уже после смены директории
+	t.Chdir(t.TempDir())
+
+	ruleSets := make(map[string]option.RuleSet)
+	for _, ruleSet := range options.Route.RuleSet {
+		ruleSets[ruleSet.Tag] = ruleSet
+	}
+	local, ok := ruleSets["blocklist-my_ads"]
+	if !ok || local.Type != C.RuleSetTypeLocal || local.LocalOptions.Path != filepath.Join(dir, "blocklists", "my_ads.srs") {
+		t.Fatalf("local rule-set: %+v", local)
+	}
+	file, err := os.Open(local.LocalOptions.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := srs.Read(file, false); err != nil {
+		t.Errorf("compiled rule-set: %v", err)
+	}
+	if inline := ruleSets["blocklist-inline"]; inline.Type != C.RuleSetTypeInline || len(inline.InlineOptions.Rules) != 1 {
+		t.Errorf("inline rule-set: %+v", inline)
+	}
+	if _, ok := ruleSets["blocklist-off"]; ok {
+		t.Errorf("disabled list must not be attached")
+	}
+	// недоступный источник — пустой rule-set, который апдейтер перекачает
+	if _, ok := ruleSets["blocklist-missing"]; !ok || !BlocklistDue(opt.Blocklists[3]) {
+		t.Errorf("missing list must be attached as empty and due for update")
+	}
+	if BlocklistDue(opt.Blocklists[0]) {
+		t.Errorf("fresh list must not be due")
+	}
+
+	// правило каждого списка ведёт в его счётчики: outbound и DNS-сервер с тегом rule-set'а
+	outbounds := make(map[string]string)
+	for _, outbound := range options.Outbounds {
+		outbounds[outbound.Tag] = outbound.Type
+	}
+	servers := make(map[string]string)
+	for _, server := range options.DNS.Servers {
+		servers[server.Tag] = server.Type
+	}
+	blocked := 0
+	for _, rule := range options.Route.Rules {
+		if len(rule.DefaultOptions.RuleSet) == 1 && strings.HasPrefix(rule.DefaultOptions.RuleSet[0], BlocklistTagPrefix) {
+			tag := rule.DefaultOptions.RuleSet[0]
+			if rule.DefaultOptions.RouteOptions.Outbound != tag || outbounds[tag] != blocklist.Type {
+				t.Errorf("blocklist rule must block: %+v", rule.DefaultOptions)
+			}
+			blocked++
+		}
+	}
+	if blocked != 3 {
+		t.Errorf("blocklist route rules: %d", blocked)
+	}
+	blockedDNS := 0
+	for _, rule := range options.DNS.Rules {
+		if len(rule.DefaultOptions.RuleSet) == 1 && strings.HasPrefix(rule.DefaultOptions.RuleSet[0], BlocklistTagPrefix) {
+			tag, action := rule.DefaultOptions.RuleSet[0], rule.DefaultOptions.DNSRuleAction
+			if action.RouteOptions.Server != tag || !action.RouteOptions.DisableCache || servers[tag] != blocklist.Type {
+				t.Errorf("blocklist dns rule must block uncached: %+v", action)
+			}
+			blockedDNS++
+		}
+	}
+	if blockedDNS != 3 {
+		t.Errorf("blocklist dns rules: %d", blockedDNS)
+	}
+
+	statuses := Blocklists()
+	if len(statuses) != 5 || statuses[0].Entries != 1 || statuses[2].Enabled || statuses[3].LastError == "" || statuses[4].LastError == "" {
+		t.Errorf("statuses: %+v", statuses)
+	}
+	// inline-список без источника пропускается, но попадает в отчёт
+	if len(report.Ignored) != 1 || report.Ignored[0].Option != "blocklists[4]" || report.Ignored[0].Target != "inline missing" {
+		t.Errorf("ignored: %+v", report.Ignored)
+	}
+	if _, ok := ruleSets["blocklist-inline_missing"]; ok {
+		t.Errorf("skipped list must not be attached")
+	}
+
+	opt.Blocklists = append(opt.Blocklists, BlocklistOptions{Name: "my ads", Source: "ads.txt"})
+	if _, err := BuildConfig(*opt, option.Options{}); err == nil {
+		t.Errorf("expected error for duplicate name")
+	}
+}
+
+func TestBlocklistWorkingDir(t *testing.T) {
+	dir := t.TempDir()
+	SetWorkingDir(dir)
+	t.Cleanup(func() { SetWorkingDir("") })
+	t.Chdir(t.TempDir())
+	if path := BlocklistPath("My Ads"); path != filepath.Join(dir, "blocklists", "my_ads.srs") {
+		t.Errorf("path = %s, want it under the working dir %s", path, dir)
+	}
+}
+
+func TestBuildConfigRemoteBlocklistsOffline(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte("0.0.0.0 ads.example.com\n"))
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	t.Chdir(dir)
+	SetWorkingDir(dir)
+	t.Cleanup(func() { SetWorkingDir("") })
+
+	opt := DefaultRostovVPNOptions()
+	opt.Blocklists = []BlocklistOptions{
+		{Name: "remote", Source: server.URL + "/ads.txt", Enable: true},
+		{Name: "remote inline", Source: server.URL + "/ads.txt", Enable: true, Inline: true},
+	}
+	options, report, err := BuildConfigWithReport(*opt, option.Options{})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	// сборка в сеть не ходит: списки скачает апдейтер
+	if requests.Load() != 0 {
+		t.Errorf("build downloaded %d lists", requests.Load())
+	}
+	ruleSets := make(map[string]option.RuleSet)
+	for _, ruleSet := range options.Route.RuleSet {
+		ruleSets[ruleSet.Tag] = ruleSet
+	}
+	for i, tag := range []string{"blocklist-remote", "blocklist-remote_inline"} {
+		if ruleSets[tag].Type != C.RuleSetTypeLocal || !BlocklistDue(opt.Blocklists[i]) {
+			t.Errorf("%s: %+v must be an empty .srs due for update", tag, ruleSets[tag])
+		}
+		if _, err := os.Stat(ruleSets[tag].LocalOptions.Path); err != nil {
+			t.Errorf("%s: %v", tag, err)
+		}
+	}
+	ignored := false
+	for _, item := range report.Ignored {
+		ignored = ignored || item.Option == "blocklists[1].inline"
+	}
+	if !ignored {
+		t.Errorf("remote inline list is not reported: %+v", report.Ignored)
+	}
+
+	if err := UpdateBlocklist(context.Background(), opt.Blocklists[0]); err != nil || BlocklistDue(opt.Blocklists[0]) {
+		t.Errorf("update: %v", err)
+	}
+}
@@ -0,0 +1,338 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+// откуда взялся элемент собранного конфига
+const (
+	SourceBuiltin   = "builtin"
+	SourceProfile   = "profile" // правила самого профиля (full-config, raw-конфиг)
+	SourceUser      = "user"
+	SourceRegion    = "region"
+	SourceAds       = "ads"
+	SourceBlocklist = "blocklist"
+	SourceHosts     = "hosts"
+	SourceDNSRules  = "dns-rules"
+)
+
+// BuildReport — что и почему попало в собранный конфиг. Списки идут в том же
+// порядке, что Inbounds, DNS.Servers, Outbounds, Route.Rules и DNS.Rules.
+type BuildReport struct {
+	Inbounds   []TaggedOrigin  `json:"inbounds"`
+	DNSServers []TaggedOrigin  `json:"dns-servers"`
+	Outbounds  []TaggedOrigin  `json:"outbounds"`
+	RouteRules []Origin        `json:"route-rules"`
+	DNSRules   []Origin        `json:"dns-rules"`
+	Ignored    []IgnoredOption `json:"ignored,omitempty"`
+	// страны серверов по GeoIP (только IP и статические адреса), по тегу outbound'а
+	OutboundCountries map[string]string `json:"outbound-countries,omitempty"`
+}
+
+// Origin — источник и настройка или ветка кода, которая добавила элемент:
+// rules[2], block-ads, android dot.
+type Origin struct {
+	Source string `json:"source"`
+	Option string `json:"option,omitempty"`
+}
+
+type TaggedOrigin struct {
+	Tag string `json:"tag"`
+	Origin
+}
+
+// IgnoredOption — запрошенная настройка, которую сборка не применила.
+type IgnoredOption struct {
+	Option string `json:"option"`
+	Target string `json:"target,omitempty"` // протокол, тег или секция, к которой она не применилась
+	Reason string `json:"reason"`
+}
+
+func newOrigin(source string, option string) Origin {
+	return Origin{Source: source, Option: option}
+}
+
+func repeatOrigin(origin Origin, n int) []Origin {
+	origins := make([]Origin, n)
+	for i := range origins {
+		origins[i] = origin
+	}
+	return origins
+}
+
+func (r *BuildReport) RouteRuleOrigin(index int) Origin {
+	if r == nil || index < 0 || index >= len(r.RouteRules) {
+		return newOrigin(SourceProfile, "")
+	}
+	return r.RouteRules[index]
+}
+
+func (r *BuildReport) DNSRuleOrigin(index int) Origin {
+	if r == nil || index < 0 || index >= len(r.DNSRules) {
+		return newOrigin(SourceProfile, "")
+	}
+	return r.DNSRules[index]
+}
+
+func (r *BuildReport) ignore(option string, target string, reason string) {
+	r.Ignored = append(r.Ignored, IgnoredOption{Option: option, Target: target, Reason: reason})
+}
+
+// dnsAppended помечает правила, дописанные в конец DNS.Rules после before.
+func (r *BuildReport) dnsAppended(options *option.Options, before int, origin Origin) {
+	if options.DNS == nil || len(options.DNS.Rules) <= before {
+		return
+	}
+	r.DNSRules = append(r.DNSRules, repeatOrigin(origin, len(options.DNS.Rules)-before)...)
+}
+
+// dnsPrepended — то же для правил, вставленных в начало.
+func (r *BuildReport) dnsPrepended(options *option.Options, before int, origin Origin) {
+	if options.DNS == nil || len(options.DNS.Rules) <= before {
+		return
+	}
+	r.DNSRules = append(repeatOrigin(origin, len(options.DNS.Rules)-before), r.DNSRules...)
+}
+
+func dnsRuleCount(options *option.Options) int {
+	if options.DNS == nil {
+		return 0
+	}
+	return len(options.DNS.Rules)
+}
+
+// blocklistRuleOrigin — правило списка блокировки помечается тегом его rule-set'а.
+func blocklistRuleOrigin(ruleSets []string) Origin {
+	if len(ruleSets) == 0 {
+		return newOrigin(SourceBlocklist, "")
+	}
+	return newOrigin(SourceBlocklist, ruleSets[0])
+}
+
+// annotateItems размечает inbound'ы, DNS-серверы и outbound'ы по тегам: теги
+// встроенных элементов фиксированы, пользовательские берутся из настроек,
+// всё остальное пришло из профиля.
+func (r *BuildReport) annotateItems(options *option.Options, input *option.Options, opt *RostovVPNOptions) {
+	r.Inbounds = nil
+	for _, inbound := range options.Inbounds {
+		origin := newOrigin(SourceProfile, "")
+		switch inbound.Tag {
+		case InboundTUNTag:
+			origin = newOrigin(SourceBuiltin, "enable-tun")
+		case InboundMixedTag:
+			origin = newOrigin(SourceBuiltin, "mixed-port")
+		case InboundDNSTag:
+			origin = newOrigin(SourceBuiltin, "local-dns-port")
+		}
+		r.Inbounds = append(r.Inbounds, TaggedOrigin{Tag: inbound.Tag, Origin: origin})
+	}
+
+	r.DNSServers = nil
+	if options.DNS != nil {
+		user := make(map[string]Origin)
+		for i, server := range opt.DNSServers {
+			user[server.Tag] = newOrigin(SourceUser, fmt.Sprintf("dns-servers[%d]", i))
+		}
+		for i, group := range opt.DNSGroups {
+			user[group.Tag] = newOrigin(SourceUser, fmt.Sprintf("dns-groups[%d]", i))
+		}
+		for _, server := range options.DNS.Servers {
+			origin, ok := user[server.Tag]
+			if !ok {
+				origin = builtinDNSServerOrigin(server.Tag)
+			}
+			r.DNSServers = append(r.DNSServers, TaggedOrigin{Tag: server.Tag, Origin: origin})
+		}
+	}
+
+	r.Outbounds = nil
+	profile := make(map[string]int, len(input.Outbounds))
+	for i, outbound := range input.Outbounds {
+		profile[outbound.Tag] = i
+	}
+	for _, outbound := range options.Outbounds {
+		r.Outbounds = append(r.Outbounds, TaggedOrigin{Tag: outbound.Tag, Origin: outboundOrigin(outbound.Tag, opt, profile)})
+	}
+}
+
+func builtinDNSServerOrigin(tag string) Origin {
+	switch tag {
+	case DNSBootstrapTag:
+		return newOrigin(SourceBuiltin, "direct-dns-address")
+	case DNSRemoteTag, DNSRemoteUpstreamTag:
+		return newOrigin(SourceBuiltin, "remote-dns-address")
+	case DNSLocalTag:
+		return newOrigin(SourceBuiltin, "local resolver")
+	case DNSTricksDirectTag:
+		return newOrigin(SourceBuiltin, "doh bootstrap")
+	case DNSWarpHostsTag:
+		return newOrigin(SourceBuiltin, "static hosts")
+	case DNSFakeTag:
+		return newOrigin(SourceBuiltin, "enable-fake-dns")
+	}
+	if strings.HasPrefix(tag, BlocklistTagPrefix) {
+		return newOrigin(SourceBlocklist, tag)
+	}
+	return newOrigin(SourceProfile, "")
+}
+
+func outboundOrigin(tag string, opt *RostovVPNOptions, profile map[string]int) Origin {
+	switch tag {
+	case OutboundSelectTag:
+		return newOrigin(SourceBuiltin, "select")
+	case OutboundURLTestTag:
+		return newOrigin(SourceBuiltin, "connection-test-url")
+	case OutboundDNSTag, OutboundDirectTag, OutboundBypassTag, OutboundBlockTag:
+		return newOrigin(SourceBuiltin, "base outbounds")
+	case OutboundWarpTag:
+		if _, ok := profile[tag]; !ok {
+			return newOrigin(SourceBuiltin, "warp.mode")
+		}
+	}
+	for i, group := range opt.OutboundGroups {
+		if group.Name == tag {
+			return newOrigin(SourceUser, fmt.Sprintf("outbound-groups[%d]", i))
+		}
+	}
+	for i, chain := range opt.Chains {
+		if chain.Name == tag || strings.HasPrefix(tag, chain.Name+"/") {
+			return newOrigin(SourceUser, fmt.Sprintf("chains[%d]", i))
+		}
+	}
+	if i, ok := profile[tag]; ok {
+		return newOrigin(SourceProfile, fmt.Sprintf("outbounds[%d]", i))
+	}
+	if strings.HasPrefix(tag, BlocklistTagPrefix) {
+		return newOrigin(SourceBlocklist, tag)
+	}
+	return newOrigin(SourceProfile, "")
+}
+
+// ignoreTLSTricks: сборка не патчит outbound'ы профиля трюками TLS, а
+// DNS-серверы их не поддерживают — запрошенные трюки отмечаются по протоколам.
+func (r *BuildReport) ignoreTLSTricks(opt *RostovVPNOptions, outbounds []option.Outbound) {
+	var requested []string
+	if opt.TLSTricks.EnableFragment {
+		requested = append(requested, "tls-tricks.enable-fragment")
+	}
+	if opt.TLSTricks.MixedSNICase {
+		requested = append(requested, "tls-tricks.mixed-sni-case")
+	}
+	if opt.TLSTricks.EnablePadding {
+		requested = append(requested, "tls-tricks.enable-padding")
+	}
+	if len(requested) == 0 {
+		return
+	}
+	protocols := make(map[string]bool)
+	for _, outbound := range outbounds {
+		obj, err := outboundToMap(outbound)
+		if err != nil {
+			continue
+		}
+		if tlsMap, ok := obj.nestedMap("tls"); ok && tlsMap.bool("enabled") {
+			protocols[outbound.Type] = true
+		}
+	}
+	targets := make([]string, 0, len(protocols))
+	for protocol := range protocols {
+		targets = append(targets, protocol)
+	}
+	sort.Strings(targets)
+	option := strings.Join(requested, ", ")
+	for _, protocol := range targets {
+		r.ignore(option, protocol, "tls tricks are not patched into "+protocol+" outbounds")
+	}
+	r.ignore(option, "dns", "dns servers do not support tls tricks")
+}
+
+// Explain — текстовый отчёт для build --explain.
+func (r *BuildReport) Explain(options *option.Options) string {
+	var b strings.Builder
+	writeTagged := func(title string, items []TaggedOrigin, kinds map[string]string) {
+		if len(items) == 0 {
+			return
+		}
+		b.WriteString(title + ":\n")
+		for _, item := range items {
+			fmt.Fprintf(&b, "  %s (%s): %s\n", item.Tag, kinds[item.Tag], formatOrigin(item.Origin))
+		}
+	}
+	kinds := make(map[string]string)
+	for _, inbound := range options.Inbounds {
+		kinds[inbound.Tag] = inbound.Type
+	}
+	writeTagged("inbounds", r.Inbounds, kinds)
+	kinds = make(map[string]string)
+	if options.DNS != nil {
+		for _, server := range options.DNS.Servers {
+			kinds[server.Tag] = server.Type
+		}
+	}
+	writeTagged("dns servers", r.DNSServers, kinds)
+	kinds = make(map[string]string)
+	for _, outbound := range options.Outbounds {
+		kinds[outbound.Tag] = outbound.Type
+	}
+	writeTagged("outbounds", r.Outbounds, kinds)
+
+	if options.Route != nil && len(options.Route.Rules) > 0 {
+		b.WriteString("route rules:\n")
+		for i, rule := range options.Route.Rules {
+			fmt.Fprintf(&b, "  [%d] %s -> %s\n", i, formatOrigin(r.RouteRuleOrigin(i)), routeRuleTarget(rule))
+		}
+		fmt.Fprintf(&b, "  final -> %s\n", options.Route.Final)
+	}
+	if options.DNS != nil && len(options.DNS.Rules) > 0 {
+		b.WriteString("dns rules:\n")
+		for i, rule := range options.DNS.Rules {
+			fmt.Fprintf(&b, "  [%d] %s -> %s\n", i, formatOrigin(r.DNSRuleOrigin(i)), dnsRuleTarget(rule))
+		}
+		fmt.Fprintf(&b, "  final -> %s\n", options.DNS.Final)
+	}
+	if len(r.Ignored) > 0 {
+		b.WriteString("ignored:\n")
+		for _, ignored := range r.Ignored {
+			b.WriteString("  " + ignored.Option)
+			if ignored.Target != "" {
+				b.WriteString(" [" + ignored.Target + "]")
+			}
+			b.WriteString(": " + ignored.Reason + "\n")
+		}
+	}
+	return b.String()
+}
+
+func formatOrigin(origin Origin) string {
+	if origin.Option == "" {
+		return origin.Source
+	}
+	return origin.Source + " " + origin.Option
+}
+
+func routeRuleTarget(rule option.Rule) string {
+	action := rule.DefaultOptions.RuleAction
+	if rule.Type == C.RuleTypeLogical {
+		action = rule.LogicalOptions.RuleAction
+	}
+	if action.Action == "" || action.Action == C.RuleActionTypeRoute {
+		return action.RouteOptions.Outbound
+	}
+	return action.Action
+}
+
+func dnsRuleTarget(rule option.DNSRule) string {
+	action := rule.DefaultOptions.DNSRuleAction
+	if rule.Type == C.RuleTypeLogical {
+		action = rule.LogicalOptions.DNSRuleAction
+	}
+	if action.Action == "" || action.Action == C.RuleActionTypeRoute {
+		return action.RouteOptions.Server
+	}
+	return action.Action
+}
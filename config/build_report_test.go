@@ -0,0 +1,83 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestBuildReportItems(t *testing.T) {
+	opt := DefaultRostovVPNOptions()
+	opt.TLSTricks.EnableFragment = true
+	opt.Mux.Enable = true
+	opt.Rules = []Rule{{Domains: "domain:example.org", Outbound: "bypass"}}
+	opt.DNSServers = []DNSServerConfig{{Tag: "corp", Address: "10.0.0.53"}}
+	opt.OutboundGroups = []OutboundGroupOptions{{Name: "trojans", Type: GroupTypeSelector, Protocols: []string{C.TypeTrojan}}}
+	input := option.Options{
+		Outbounds: []option.Outbound{
+			{Type: C.TypeSelector, Tag: "profile-select", Options: &option.SelectorOutboundOptions{Outbounds: []string{"tls"}}},
+			{Type: C.TypeTrojan, Tag: "tls", Options: &option.TrojanOutboundOptions{
+				ServerOptions: option.ServerOptions{Server: "1.1.1.1", ServerPort: 443},
+				Password:      "x",
+				OutboundTLSOptionsContainer: option.OutboundTLSOptionsContainer{
+					TLS: &option.OutboundTLSOptions{Enabled: true, ServerName: "example.com"},
+				},
+			}},
+		},
+		Route: &option.RouteOptions{Final: "tls"},
+	}
+	options, report, err := BuildConfigWithReport(*opt, input)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(report.Inbounds) != len(options.Inbounds) || len(report.DNSServers) != len(options.DNS.Servers) || len(report.Outbounds) != len(options.Outbounds) {
+		t.Fatalf("report is not aligned: %+v", report)
+	}
+
+	origins := make(map[string]Origin)
+	for _, items := range [][]TaggedOrigin{report.Inbounds, report.DNSServers, report.Outbounds} {
+		for _, item := range items {
+			origins[item.Tag] = item.Origin
+		}
+	}
+	for tag, want := range map[string]Origin{
+		InboundMixedTag:    newOrigin(SourceBuiltin, "mixed-port"),
+		DNSRemoteTag:       newOrigin(SourceBuiltin, "remote-dns-address"),
+		"corp":             newOrigin(SourceUser, "dns-servers[0]"),
+		OutboundSelectTag:  newOrigin(SourceBuiltin, "select"),
+		OutboundBypassTag:  newOrigin(SourceBuiltin, "base outbounds"),
+		"trojans":          newOrigin(SourceUser, "outbound-groups[0]"),
+		"tls":              newOrigin(SourceProfile, "outbounds[1]"),
+		OutboundURLTestTag: newOrigin(SourceBuiltin, "connection-test-url"),
+	} {
+		if origins[tag] != want {
+			t.Errorf("%s = %+v, want %+v", tag, origins[tag], want)
+		}
+	}
+
+	ignored := make(map[string]string)
+	for _, entry := range report.Ignored {
+		ignored[entry.Option+"|"+entry.Target] = entry.Reason
+	}
+	for _, key := range []string{
+		"tls-tricks.enable-fragment|trojan",
+		"tls-tricks.enable-fragment|dns",
+		"mux.enable|",
+		"outbounds|profile-select",
+		"enable-full-config|route",
+		"rules[0]|dns",
+	} {
+		if _, ok := ignored[key]; !ok {
+			t.Errorf("%s must be reported as ignored: %+v", key, report.Ignored)
+		}
+	}
+
+	explain := report.Explain(options)
+	for _, want := range []string{"inbounds:\n", "  tls (trojan): profile outbounds[1]\n", "route rules:\n", "ignored:\n"} {
+		if !strings.Contains(explain, want) {
+			t.Errorf("explain has no %q:\n%s", want, explain)
+		}
+	}
+}
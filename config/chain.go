@@ -0,0 +1,222 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/balancer"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	singjson "github.com/sagernet/sing/common/json"
+)
+
+// ChainOptions — цепочка прокси: трафик идёт через Outbounds по порядку,
+// выходит в интернет через последний. Первым звеном может быть группа
+// или другая цепочка, остальные — только конкретные серверы.
+type ChainOptions struct {
+	Name      string   `json:"name"`
+	Outbounds []string `json:"outbounds"`
+}
+
+func (opt *RostovVPNOptions) findChain(name string) *ChainOptions {
+	if name == "" {
+		return nil
+	}
+	for i := range opt.Chains {
+		if opt.Chains[i].Name == name {
+			return &opt.Chains[i]
+		}
+	}
+	return nil
+}
+
+// isUserOutbound — имя пользовательской группы или цепочки, на которое можно сослаться в правиле.
+func (opt *RostovVPNOptions) isUserOutbound(name string) bool {
+	return opt.findOutboundGroup(name) != nil || opt.findChain(name) != nil
+}
+
+// buildChains разворачивает цепочки в копии серверов с detour на предыдущее звено.
+// Последнее звено получает тег цепочки, промежуточные — «цепочка/сервер».
+func buildChains(opt *RostovVPNOptions, proxies []option.Outbound, groups []option.Outbound, reserved map[string]bool) ([]option.Outbound, error) {
+	byTag := make(map[string]option.Outbound, len(proxies))
+	for _, outbound := range proxies {
+		byTag[outbound.Tag] = outbound
+	}
+	firstHop := make(map[string]bool, len(groups)+len(opt.Chains))
+	for _, group := range groups {
+		firstHop[group.Tag] = true
+	}
+	for _, chain := range opt.Chains {
+		firstHop[chain.Name] = true
+	}
+
+	var chains []option.Outbound
+	for _, chain := range opt.Chains {
+		if chain.Name == "" {
+			return nil, fmt.Errorf("chain without name")
+		}
+		if reserved[chain.Name] {
+			return nil, fmt.Errorf("chain %s: tag already in use", chain.Name)
+		}
+		reserved[chain.Name] = true
+		if len(chain.Outbounds) < 2 {
+			return nil, fmt.Errorf("chain %s: at least two outbounds required", chain.Name)
+		}
+		seen := make(map[string]bool, len(chain.Outbounds))
+		for _, hop := range chain.Outbounds {
+			if seen[hop] {
+				return nil, fmt.Errorf("chain %s: outbound %s used twice", chain.Name, hop)
+			}
+			seen[hop] = true
+		}
+
+		previous := chain.Outbounds[0]
+		if _, ok := byTag[previous]; !ok && !firstHop[previous] {
+			return nil, fmt.Errorf("chain %s: outbound %s not found", chain.Name, previous)
+		}
+		if previous == chain.Name {
+			return nil, fmt.Errorf("chain %s: references itself", chain.Name)
+		}
+		for i, hop := range chain.Outbounds[1:] {
+			base, ok := byTag[hop]
+			if !ok {
+				if firstHop[hop] {
+					return nil, fmt.Errorf("chain %s: group or chain %s can only be the first outbound", chain.Name, hop)
+				}
+				return nil, fmt.Errorf("chain %s: outbound %s not found", chain.Name, hop)
+			}
+			tag := chain.Name
+			if i+2 < len(chain.Outbounds) {
+				tag = chain.Name + "/" + hop
+				if reserved[tag] {
+					return nil, fmt.Errorf("chain %s: tag %s already in use", chain.Name, tag)
+				}
+				reserved[tag] = true
+			}
+			link, err := detouredOutbound(base, tag, previous)
+			if err != nil {
+				return nil, fmt.Errorf("chain %s: %w", chain.Name, err)
+			}
+			chains = append(chains, link)
+			previous = tag
+		}
+	}
+	return chains, nil
+}
+
+// detouredOutbound копирует сервер под новым тегом и пускает его через detour.
+func detouredOutbound(base option.Outbound, tag string, detour string) (option.Outbound, error) {
+	switch strings.ToLower(base.Type) {
+	case C.TypeDirect, C.TypeBlock, C.TypeDNS, C.TypeSelector, C.TypeURLTest, balancer.TypeLoadBalance, balancer.TypeFallback:
+		return option.Outbound{}, fmt.Errorf("outbound %s: protocol %s cannot be chained", base.Tag, base.Type)
+	}
+	clone, err := cloneOutbound(base)
+	if err != nil {
+		return option.Outbound{}, fmt.Errorf("outbound %s: %w", base.Tag, err)
+	}
+	dialer, ok := clone.Options.(option.DialerOptionsWrapper)
+	if !ok {
+		return option.Outbound{}, fmt.Errorf("outbound %s: protocol %s cannot be chained", base.Tag, base.Type)
+	}
+	dialerOptions := dialer.TakeDialerOptions()
+	if dialerOptions.Detour != "" {
+		return option.Outbound{}, fmt.Errorf("outbound %s: already has detour %s", base.Tag, dialerOptions.Detour)
+	}
+	dialerOptions.Detour = detour
+	dialer.ReplaceDialerOptions(dialerOptions)
+	clone.Tag = tag
+	return clone, nil
+}
+
+// detouredEndpoint — копия эндпоинта (wireguard из .conf), подключающаяся через detour.
+func detouredEndpoint(base option.Endpoint, detour string) (option.Endpoint, error) {
+	ctx := decodeContext()
+	raw, err := singjson.MarshalContext(ctx, &base)
+	if err != nil {
+		return option.Endpoint{}, fmt.Errorf("endpoint %s: %w", base.Tag, err)
+	}
+	clone, err := singjson.UnmarshalExtendedContext[option.Endpoint](ctx, raw)
+	if err != nil {
+		return option.Endpoint{}, fmt.Errorf("endpoint %s: %w", base.Tag, err)
+	}
+	dialer, ok := clone.Options.(option.DialerOptionsWrapper)
+	if !ok {
+		return option.Endpoint{}, fmt.Errorf("endpoint %s: protocol %s cannot be chained", base.Tag, base.Type)
+	}
+	dialerOptions := dialer.TakeDialerOptions()
+	if dialerOptions.Detour != "" {
+		return option.Endpoint{}, fmt.Errorf("endpoint %s: already has detour %s", base.Tag, dialerOptions.Detour)
+	}
+	dialerOptions.Detour = detour
+	dialer.ReplaceDialerOptions(dialerOptions)
+	return clone, nil
+}
+
+// cloneOutbound — глубокая копия через JSON: Options у аутбаунда — указатель,
+// правка копии иначе задела бы исходный сервер.
+func cloneOutbound(outbound option.Outbound) (option.Outbound, error) {
+	ctx := decodeContext()
+	raw, err := singjson.MarshalContext(ctx, &outbound)
+	if err != nil {
+		return option.Outbound{}, err
+	}
+	return singjson.UnmarshalExtendedContext[option.Outbound](ctx, raw)
+}
+
+// checkOutboundCycles ищет циклы по detour и участникам групп: sing-box
+// на них не стартует, а понятная ошибка при сборке полезнее.
+func checkOutboundCycles(outbounds []option.Outbound) error {
+	edges := make(map[string][]string, len(outbounds))
+	for _, outbound := range outbounds {
+		switch options := outbound.Options.(type) {
+		case *option.SelectorOutboundOptions:
+			edges[outbound.Tag] = options.Outbounds
+		case *option.URLTestOutboundOptions:
+			edges[outbound.Tag] = options.Outbounds
+		case *balancer.Options:
+			edges[outbound.Tag] = options.Outbounds
+		case option.DialerOptionsWrapper:
+			if detour := options.TakeDialerOptions().Detour; detour != "" {
+				edges[outbound.Tag] = []string{detour}
+			}
+		}
+	}
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(edges))
+	var path []string
+	var visit func(tag string) error
+	visit = func(tag string) error {
+		switch state[tag] {
+		case visiting:
+			start := 0
+			for i, item := range path {
+				if item == tag {
+					start = i
+				}
+			}
+			return fmt.Errorf("outbound cycle: %s -> %s", strings.Join(path[start:], " -> "), tag)
+		case done:
+			return nil
+		}
+		state[tag] = visiting
+		path = append(path, tag)
+		for _, next := range edges[tag] {
+			if err := visit(next); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[tag] = done
+		return nil
+	}
+	for _, outbound := range outbounds {
+		if err := visit(outbound.Tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
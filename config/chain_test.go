@@ -0,0 +1,117 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+func chainTestProxies() []option.Outbound {
+	return []option.Outbound{
+		countryTestOutbound("a", "1.1.1.1"),
+		countryTestOutbound("b", "2.2.2.2"),
+		countryTestOutbound("c", "3.3.3.3"),
+		{Type: C.TypeSOCKS, Tag: "via", Options: &option.SOCKSOutboundOptions{
+			DialerOptions: option.DialerOptions{Detour: "a"},
+			ServerOptions: option.ServerOptions{Server: "4.4.4.4", ServerPort: 1080},
+		}},
+	}
+}
+
+func TestBuildChains(t *testing.T) {
+	proxies := chainTestProxies()
+	groups := []option.Outbound{{Type: C.TypeSelector, Tag: "group", Options: &option.SelectorOutboundOptions{Outbounds: []string{"a", "b"}}}}
+	opt := DefaultRostovVPNOptions()
+	opt.Chains = []ChainOptions{
+		{Name: "triple", Outbounds: []string{"a", "b", "c"}},
+		{Name: "from-group", Outbounds: []string{"group", "c"}},
+		{Name: "from-chain", Outbounds: []string{"triple", "a"}},
+	}
+	chains, err := buildChains(opt, proxies, groups, map[string]bool{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ tag, detour string }{
+		{"triple/b", "a"},
+		{"triple", "triple/b"},
+		{"from-group", "group"},
+		{"from-chain", "triple"},
+	}
+	if len(chains) != len(want) {
+		t.Fatalf("chains: %+v", chains)
+	}
+	for i, link := range want {
+		if chains[i].Tag != link.tag || outboundDetour(&chains[i]) != link.detour {
+			t.Errorf("link %d = %s via %s, want %s via %s", i, chains[i].Tag, outboundDetour(&chains[i]), link.tag, link.detour)
+		}
+	}
+	// звенья — копии, исходные серверы без detour
+	if outboundDetour(&proxies[1]) != "" {
+		t.Error("source outbound modified")
+	}
+	if err := checkOutboundCycles(append(append(proxies, groups...), chains...)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuildChainsErrors(t *testing.T) {
+	groups := []option.Outbound{{Type: C.TypeSelector, Tag: "group", Options: &option.SelectorOutboundOptions{Outbounds: []string{"a"}}}}
+	tests := []struct {
+		name     string
+		chains   []ChainOptions
+		reserved string
+		err      string
+	}{
+		{"group later", []ChainOptions{{Name: "x", Outbounds: []string{"a", "group"}}}, "", "group or chain group can only be the first outbound"},
+		{"chain later", []ChainOptions{{Name: "x", Outbounds: []string{"a", "b"}}, {Name: "y", Outbounds: []string{"c", "x"}}}, "", "group or chain x can only be the first outbound"},
+		{"duplicate hop", []ChainOptions{{Name: "x", Outbounds: []string{"a", "b", "a"}}}, "", "outbound a used twice"},
+		{"existing detour", []ChainOptions{{Name: "x", Outbounds: []string{"b", "via"}}}, "", "already has detour a"},
+		{"unknown", []ChainOptions{{Name: "x", Outbounds: []string{"a", "missing"}}}, "", "outbound missing not found"},
+		{"single hop", []ChainOptions{{Name: "x", Outbounds: []string{"a"}}}, "", "at least two outbounds required"},
+		{"self", []ChainOptions{{Name: "x", Outbounds: []string{"x", "a"}}}, "", "references itself"},
+		{"tag in use", []ChainOptions{{Name: "a", Outbounds: []string{"b", "c"}}}, "a", "tag already in use"},
+		{"duplicate chain", []ChainOptions{{Name: "x", Outbounds: []string{"a", "b"}}, {Name: "x", Outbounds: []string{"b", "c"}}}, "", "tag already in use"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opt := DefaultRostovVPNOptions()
+			opt.Chains = test.chains
+			reserved := map[string]bool{}
+			if test.reserved != "" {
+				reserved[test.reserved] = true
+			}
+			_, err := buildChains(opt, chainTestProxies(), groups, reserved)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("err = %v, want %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestCheckOutboundCycles(t *testing.T) {
+	// две цепочки, начинающиеся друг с друга, строятся, но sing-box на них не стартует
+	proxies := chainTestProxies()
+	opt := DefaultRostovVPNOptions()
+	opt.Chains = []ChainOptions{
+		{Name: "x", Outbounds: []string{"y", "a"}},
+		{Name: "y", Outbounds: []string{"x", "b"}},
+	}
+	chains, err := buildChains(opt, proxies, nil, map[string]bool{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkOutboundCycles(append(proxies, chains...))
+	if err == nil || !strings.Contains(err.Error(), "outbound cycle: x -> y -> x") {
+		t.Fatalf("err = %v", err)
+	}
+
+	// цикл через участника группы
+	group := option.Outbound{Type: C.TypeURLTest, Tag: "auto", Options: &option.URLTestOutboundOptions{Outbounds: []string{"a", "loop"}}}
+	loop := option.Outbound{Type: C.TypeSOCKS, Tag: "loop", Options: &option.SOCKSOutboundOptions{DialerOptions: option.DialerOptions{Detour: "auto"}}}
+	err = checkOutboundCycles(append(chainTestProxies(), group, loop))
+	if err == nil || !strings.Contains(err.Error(), "auto -> loop -> auto") {
+		t.Fatalf("err = %v", err)
+	}
+}
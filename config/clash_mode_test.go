@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestClashModeRulesAfterDoHBootstrap(t *testing.T) {
+	options, err := BuildConfig(*DefaultRostovVPNOptions(), explainTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	global, bootstrap := -1, -1
+	for i, rule := range options.Route.Rules {
+		if rule.DefaultOptions.ClashMode == ClashModeGlobal {
+			global = i
+		}
+		for _, domain := range rule.DefaultOptions.Domain {
+			if domain == "sky.rethinkdns.com" {
+				bootstrap = i
+			}
+		}
+	}
+	// в Global DoH-бутстрап всё равно должен идти напрямую
+	if global < 0 || bootstrap < 0 || global < bootstrap {
+		t.Errorf("global rule %d, doh bootstrap rule %d: %+v", global, bootstrap, options.Route.Rules)
+	}
+	if NormalizeClashMode(" global ") != ClashModeGlobal || NormalizeClashMode("unknown") != ClashModeRule {
+		t.Errorf("NormalizeClashMode")
+	}
+}
+
+func TestClashServerGatedByOptions(t *testing.T) {
+	hasClashRules := func(options *option.Options) bool {
+		for _, rule := range options.Route.Rules {
+			if rule.DefaultOptions.ClashMode != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	opt := DefaultRostovVPNOptions()
+	opt.EnableClashApi = false
+	opt.ClashMode = ClashModeGlobal
+	options, report, err := BuildConfigWithReport(*opt, explainTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	// без enable-clash-api и clash-mode-switch ни сервера, ни clash.db
+	if options.Experimental != nil && (options.Experimental.ClashAPI != nil || options.Experimental.CacheFile != nil) {
+		t.Errorf("experimental = %+v", options.Experimental)
+	}
+	if hasClashRules(options) {
+		t.Errorf("clash_mode rules without clash server")
+	}
+	ignored := false
+	for _, item := range report.Ignored {
+		ignored = ignored || item.Option == "clash-mode"
+	}
+	if !ignored {
+		t.Errorf("clash-mode is not reported: %+v", report.Ignored)
+	}
+
+	opt.ClashModeSwitch = true
+	options, err = BuildConfig(*opt, explainTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	clashAPI := options.Experimental.ClashAPI
+	if clashAPI == nil || clashAPI.ExternalController != "" || clashAPI.DefaultMode != ClashModeGlobal || !hasClashRules(options) {
+		t.Errorf("clash-mode-switch: %+v", clashAPI)
+	}
+}
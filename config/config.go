@@ -31,6 +31,8 @@ const (
 	DNSBootstrapTag    = "dns-bootstrap"
 	DNSTricksDirectTag = "dns-trick-direct"
 	DNSWarpHostsTag    = "dns-warp-hosts"
+	// dns-remote уходит под этот тег, когда его место занимает racing-группа
+	DNSRemoteUpstreamTag = "dns-remote-upstream"
 
 	OutboundDirectTag         = "direct"
 	OutboundBypassTag         = "bypass"
@@ -39,12 +41,20 @@ const (
 	OutboundURLTestTag        = "auto"
 	OutboundDNSTag            = "dns-out"
 	OutboundDirectFragmentTag = "direct-fragment"
+	OutboundWarpTag           = "warp-front"
 
 	InboundTUNTag   = "tun-in"
 	InboundMixedTag = "mixed-in"
 	InboundDNSTag   = "dns-in"
 )
 
+// trace Cloudflare по чистому IP — без DNS; отдаёт ip, colo и loc выхода.
+// Первый служит URL-тестом групп auto, оба — проверкой выхода.
+const (
+	TraceURL   = "http://1.1.1.1/cdn-cgi/trace"
+	TraceURLv6 = "http://[2606:4700:4700::1111]/cdn-cgi/trace"
+)
+
 const defaultTunInterfaceName = "RostovVPNTunnel"
 
 func DefaultTunInterfaceName() string {
@@ -54,6 +64,7 @@ func DefaultTunInterfaceName() string {
 	return defaultTunInterfaceName
 }
 
+// OutboundMainProxyTag — главный прокси без режимов Warp; сборка берёт тег из mainProxyTag.
 var OutboundMainProxyTag = OutboundSelectTag
 
 func normalizeDNSAddress(addr string) string {
@@ -90,9 +101,9 @@ func normalizeDNSAddress(addr string) string {
 		switch {
 		case strings.HasPrefix(low, "udp://"), strings.HasPrefix(low, "tcp://"):
 			u.Host = host + ":53"
-		case strings.HasPrefix(low, "tls://"):
+		case strings.HasPrefix(low, "tls://"), strings.HasPrefix(low, "quic://"):
 			u.Host = host + ":853"
-		default: // https/h3/quic
+		default: // https/h3
 			u.Host = host + ":443"
 		}
 	}
@@ -109,7 +120,11 @@ func BuildConfigJson(configOpt RostovVPNOptions, input option.Options) (string,
 	if err != nil {
 		return "", err
 	}
+	return OptionsJson(options)
+}
 
+// OptionsJson — собранный конфиг в виде JSON с отступами.
+func OptionsJson(options *option.Options) (string, error) {
 	// ВАЖНО: кодируем через singjson, чтобы корректно инлайнить варианты Options
 	raw, err := singjson.Marshal(options)
 	if err != nil {
@@ -127,18 +142,40 @@ func BuildConfigJson(configOpt RostovVPNOptions, input option.Options) (string,
 
 // TODO include selectors
 func BuildConfig(opt RostovVPNOptions, input option.Options) (*option.Options, error) {
+	options, _, err := BuildConfigWithReport(opt, input)
+	return options, err
+}
 
+// BuildConfigWithReport — BuildConfig плюс отчёт о том, откуда взялись правила.
+func BuildConfigWithReport(opt RostovVPNOptions, input option.Options) (*option.Options, *BuildReport, error) {
+	report := &BuildReport{}
 	var options option.Options
 	if opt.EnableFullConfig {
 		options.Inbounds = input.Inbounds
 		options.DNS = input.DNS
 		options.Route = input.Route
 		options.Experimental = input.Experimental
+		// DNS профиля setDns всё равно заменяет целиком
+		if options.Route != nil {
+			report.RouteRules = repeatOrigin(newOrigin(SourceProfile, ""), len(options.Route.Rules))
+		}
+		if input.DNS != nil && len(input.DNS.Servers)+len(input.DNS.Rules) > 0 {
+			report.ignore("enable-full-config", "dns", "profile dns section is replaced by the built one")
+		}
 	} else {
 		// даже если не full, всё равно уважим experimental из входного файла
 		if input.Experimental != nil {
 			options.Experimental = input.Experimental
 		}
+		if len(input.Inbounds) > 0 {
+			report.ignore("enable-full-config", "inbounds", "profile inbounds are dropped, enable-full-config is off")
+		}
+		if input.DNS != nil && len(input.DNS.Servers)+len(input.DNS.Rules) > 0 {
+			report.ignore("enable-full-config", "dns", "profile dns section is dropped, enable-full-config is off")
+		}
+		if input.Route != nil && (len(input.Route.Rules)+len(input.Route.RuleSet) > 0 || input.Route.Final != "") {
+			report.ignore("enable-full-config", "route", "profile route section is dropped, enable-full-config is off")
+		}
 	}
 
 	if !opt.EnableClashApi {
@@ -146,18 +183,28 @@ func BuildConfig(opt RostovVPNOptions, input option.Options) (*option.Options, e
 			options.Experimental.ClashAPI = nil
 		}
 	}
-	setClashAPI(&options, &opt)
+	setClashAPI(&options, &opt, report)
 	setLog(&options, &opt)
-	setInbound(&options, &opt)
-	setDns(&options, &opt)
-	setRoutingOptions(&options, &opt)
-	setFakeDns(&options, &opt)
-	err := setOutbounds(&options, &input, &opt)
+	setInbound(&options, &opt, report)
+	setDns(&options, &opt, report)
+	if err := setRoutingOptions(&options, &opt, report); err != nil {
+		return nil, nil, err
+	}
+	setFakeDns(&options, &opt, report)
+	if err := setCustomDNS(&options, &opt, report); err != nil {
+		return nil, nil, err
+	}
+	err := setOutbounds(&options, &input, &opt, report)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	// после setOutbounds: свои hosts должны стоять раньше правил force-direct
+	if err := setHosts(&options, &opt, report); err != nil {
+		return nil, nil, err
 	}
+	report.annotateItems(&options, &input, &opt)
 
-	return &options, nil
+	return &options, report, nil
 }
 
 func addForceDirect(options *option.Options, opt *RostovVPNOptions, directDNSDomains map[string]bool) {
@@ -200,12 +247,11 @@ func addForceDirect(options *option.Options, opt *RostovVPNOptions, directDNSDom
 	// Препендим, чтобы оно сработало раньше общих правил
 	options.DNS.Rules = append([]option.DNSRule{{Type: C.RuleTypeDefault, DefaultOptions: dnsRule}}, options.DNS.Rules...)
 }
-func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPNOptions) error {
+func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPNOptions, report *BuildReport) error {
 	directDNSDomains := make(map[string]bool)
 	staticIPs := make(map[string][]string)
 	var outbounds []option.Outbound
 	var tags []string
-	OutboundMainProxyTag = OutboundSelectTag
 
 	// --- главный цикл по входным аутбаундам БЕЗ map/struct-раунда ---
 	for _, base := range input.Outbounds {
@@ -228,6 +274,7 @@ func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPN
 			strings.ToLower(C.TypeDNS),
 			strings.ToLower(C.TypeSelector),
 			strings.ToLower(C.TypeURLTest):
+			report.ignore("outbounds", upd.Tag, "profile "+upd.Type+" outbound is replaced by the built-in ones")
 			continue
 		}
 
@@ -242,6 +289,37 @@ func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPN
 		outbounds = append(outbounds, *upd)
 	}
 
+	// эндпоинты профиля (wireguard из .conf) работают как обычные серверы
+	var endpoints []option.Endpoint
+	for _, endpoint := range input.Endpoints {
+		if endpoint.Tag == "" {
+			endpoint.Tag = fmt.Sprintf("endpoint-%d", len(endpoints))
+		}
+		if wgOptions, ok := endpoint.Options.(*option.WireGuardEndpointOptions); ok && wgOptions != nil {
+			for _, peer := range wgOptions.Peers {
+				if peer.Address != "" && net.ParseIP(peer.Address) == nil {
+					directDNSDomains[peer.Address] = true
+				}
+			}
+		}
+		if !strings.Contains(strings.ToLower(endpoint.Tag), "hide") {
+			tags = append(tags, endpoint.Tag)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	report.ignoreTLSTricks(opt, outbounds)
+	if opt.Mux.Enable {
+		report.ignore("mux.enable", "", "multiplex is not patched into profile outbounds")
+	}
+	outbounds, endpoints, err := applyWarpMode(opt, outbounds, endpoints, staticIPs, report)
+	if err != nil {
+		return err
+	}
+	options.Endpoints = endpoints
+	// страны для групп и UI: без DNS, остальное досчитывается после старта
+	report.OutboundCountries = staticOutboundCountries(opt, outbounds, staticIPs)
+
 	urlTest := option.Outbound{
 		Type: C.TypeURLTest,
 		Tag:  OutboundURLTestTag,
@@ -255,11 +333,36 @@ func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPN
 		},
 	}
 
-	url := opt.ConnectionTestUrl
-	if strings.HasPrefix(url, "http://cp.cloudflare.com") || url == "" {
-		url = "http://1.1.1.1/cdn-cgi/trace" // чистый IP — без DNS
+	urlTest.Options.(*option.URLTestOutboundOptions).URL = urlTestURL(opt)
+
+	reserved := map[string]bool{
+		OutboundSelectTag: true, OutboundURLTestTag: true, OutboundDNSTag: true,
+		OutboundDirectTag: true, OutboundBypassTag: true, OutboundBlockTag: true,
+	}
+	for _, outbound := range outbounds {
+		reserved[outbound.Tag] = true
+	}
+	for _, outbound := range options.Outbounds {
+		reserved[outbound.Tag] = true
+	}
+	for _, endpoint := range endpoints {
+		reserved[endpoint.Tag] = true
+	}
+	groups, err := buildOutboundGroups(opt, outbounds, report.OutboundCountries, reserved)
+	if err != nil {
+		return err
+	}
+	chains, err := buildChains(opt, outbounds, groups, reserved)
+	if err != nil {
+		return err
+	}
+	groupTags := make([]string, 0, len(groups)+len(opt.Chains))
+	for _, group := range groups {
+		groupTags = append(groupTags, group.Tag)
+	}
+	for _, chain := range opt.Chains {
+		groupTags = append(groupTags, chain.Name)
 	}
-	urlTest.Options.(*option.URLTestOutboundOptions).URL = url
 
 	defaultSelect := urlTest.Tag
 	if len(tags) > 0 {
@@ -275,13 +378,16 @@ func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPN
 		Type: C.TypeSelector,
 		Tag:  OutboundSelectTag,
 		Options: &option.SelectorOutboundOptions{
-			Outbounds:                 append([]string{urlTest.Tag}, tags...),
+			Outbounds:                 append(append([]string{urlTest.Tag}, groupTags...), tags...),
 			Default:                   defaultSelect,
 			InterruptExistConnections: true,
 		},
 	}
 
-	outbounds = append([]option.Outbound{selector, urlTest}, outbounds...)
+	outbounds = append(append(append([]option.Outbound{selector, urlTest}, groups...), chains...), outbounds...)
+	if err := checkOutboundCycles(outbounds); err != nil {
+		return err
+	}
 
 	// Базовые аутбаунды — без несуществующих Options (nil это нормально)
 	baseOutbounds := []option.Outbound{
@@ -291,10 +397,15 @@ func setOutbounds(options *option.Options, input *option.Options, opt *RostovVPN
 		{Type: C.TypeDirect, Tag: OutboundBypassTag, Options: &option.DirectOutboundOptions{}},
 		{Type: C.TypeBlock, Tag: OutboundBlockTag, Options: &option.StubOptions{}},
 	}
-	options.Outbounds = append(outbounds, baseOutbounds...)
+	// options.Outbounds — уже добавленные сборкой служебные (списки блокировки)
+	options.Outbounds = append(append(outbounds, baseOutbounds...), options.Outbounds...)
 
+	before := dnsRuleCount(options)
 	addForceDirect(options, opt, directDNSDomains)
+	report.dnsPrepended(options, before, newOrigin(SourceBuiltin, "server domains"))
+	before = dnsRuleCount(options)
 	applyStaticIPHosts(options, staticIPs)
+	report.dnsAppended(options, before, newOrigin(SourceBuiltin, "server static ips"))
 	return nil
 }
 func patchOutboundSafe(base option.Outbound, opt RostovVPNOptions, staticIPs map[string][]string) (*option.Outbound, string, error) {
@@ -324,7 +435,13 @@ func patchOutboundSafe(base option.Outbound, opt RostovVPNOptions, staticIPs map
 
 	return &o, serverDomain, nil
 }
-func setClashAPI(options *option.Options, opt *RostovVPNOptions) {
+// clashServerEnabled — поднимается ли clash-сервер: без него правила clash_mode не срабатывают.
+func clashServerEnabled(opt *RostovVPNOptions) bool {
+	return opt.EnableClashApi || opt.ClashModeSwitch
+}
+
+func setClashAPI(options *option.Options, opt *RostovVPNOptions, report *BuildReport) {
+	mode := NormalizeClashMode(opt.ClashMode)
 	if opt.EnableClashApi {
 		if opt.ClashApiSecret == "" {
 			opt.ClashApiSecret = generateRandomString(16)
@@ -333,6 +450,7 @@ func setClashAPI(options *option.Options, opt *RostovVPNOptions) {
 			ClashAPI: &option.ClashAPIOptions{
 				ExternalController: fmt.Sprintf("%s:%d", "127.0.0.1", opt.ClashApiPort),
 				Secret:             opt.ClashApiSecret,
+				DefaultMode:        mode,
 			},
 
 			CacheFile: &option.CacheFileOptions{
@@ -340,6 +458,25 @@ func setClashAPI(options *option.Options, opt *RostovVPNOptions) {
 				Path:    "clash.db",
 			},
 		}
+		return
+	}
+	if !opt.ClashModeSwitch {
+		if mode != ClashModeRule {
+			report.ignore("clash-mode", mode, "clash server is off, enable enable-clash-api or clash-mode-switch")
+		}
+		return
+	}
+	// Внешний контроллер не нужен, но режимы переключаются только через
+	// clash-сервер, а выбранный режим сохраняется в cache file.
+	if options.Experimental == nil {
+		options.Experimental = &option.ExperimentalOptions{}
+	}
+	options.Experimental.ClashAPI = &option.ClashAPIOptions{DefaultMode: mode}
+	if options.Experimental.CacheFile == nil {
+		options.Experimental.CacheFile = &option.CacheFileOptions{
+			Enabled: true,
+			Path:    "clash.db",
+		}
 	}
 }
 
@@ -357,7 +494,7 @@ func setLog(options *option.Options, opt *RostovVPNOptions) {
 	}
 }
 
-func setInbound(options *option.Options, opt *RostovVPNOptions) {
+func setInbound(options *option.Options, opt *RostovVPNOptions, report *BuildReport) {
 	var inboundDomainStrategy option.DomainStrategy
 	if !opt.ResolveDestination {
 		inboundDomainStrategy = option.DomainStrategy(dns.DomainStrategyAsIS)
@@ -367,6 +504,9 @@ func setInbound(options *option.Options, opt *RostovVPNOptions) {
 
 	// TUN поднимаем только при EnableTun
 	if opt.EnableTun {
+		if opt.MTU > 2000 {
+			report.ignore("mtu", InboundTUNTag, fmt.Sprintf("%d is above 2000, 1450 is used", opt.MTU))
+		}
 		if opt.MTU == 0 || opt.MTU > 2000 {
 			opt.MTU = 1450
 		}
@@ -446,7 +586,7 @@ func setInbound(options *option.Options, opt *RostovVPNOptions) {
 	}
 }
 
-func setDns(options *option.Options, opt *RostovVPNOptions) {
+func setDns(options *option.Options, opt *RostovVPNOptions, report *BuildReport) {
 	dnsOptions := &option.DNSOptions{}
 	dnsOptions.Final = DNSRemoteTag
 	dnsOptions.DNSClientOptions = option.DNSClientOptions{
@@ -481,10 +621,12 @@ func setDns(options *option.Options, opt *RostovVPNOptions) {
 		)
 	}
 	options.DNS = dnsOptions
+	report.DNSRules = nil
 
 	warnIfTLSTricksRequestedButUnsupported(opt)
 
 	// Гарантируем IP для sky.rethinkdns.com даже если системный DNS мёртв на старте.
+	before := dnsRuleCount(options)
 	{
 		applyStaticIPHosts(options, map[string][]string{
 			"sky.rethinkdns.com": {"104.17.147.22", "104.17.148.22", "104.18.0.48", "104.18.1.48"},
@@ -493,8 +635,9 @@ func setDns(options *option.Options, opt *RostovVPNOptions) {
 	{
 		applyStaticIPHosts(options, map[string][]string{"cloudflare-dns.com": []string{"1.1.1.1", "1.0.0.1", "1.1.1.2", "1.0.0.2"}})
 	}
+	report.dnsAppended(options, before, newOrigin(SourceBuiltin, "doh bootstrap hosts"))
 }
-func setFakeDns(options *option.Options, opt *RostovVPNOptions) {
+func setFakeDns(options *option.Options, opt *RostovVPNOptions, report *BuildReport) {
 	if options.DNS == nil || !opt.EnableFakeDNS {
 		return
 	}
@@ -523,8 +666,9 @@ func setFakeDns(options *option.Options, opt *RostovVPNOptions) {
 	}
 
 	options.DNS.Rules = append(options.DNS.Rules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
+	report.DNSRules = append(report.DNSRules, newOrigin(SourceBuiltin, "enable-fake-dns"))
 }
-func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
+func setRoutingOptions(options *option.Options, opt *RostovVPNOptions, report *BuildReport) error {
 	if options.DNS == nil {
 		options.DNS = &option.DNSOptions{}
 	}
@@ -532,14 +676,26 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 	dnsRules := make([]option.DNSRule, 0)
 	routeRules := make([]option.Rule, 0)
 	rulesets := make([]option.RuleSet, 0)
+	// источники правил — параллельно routeRules и dnsRules
+	var routeOrigins, dnsOrigins []Origin
+	addRoute := func(origin Origin, rules ...option.Rule) {
+		routeRules = append(routeRules, rules...)
+		routeOrigins = append(routeOrigins, repeatOrigin(origin, len(rules))...)
+	}
+	addDNS := func(origin Origin, rules ...option.DNSRule) {
+		dnsRules = append(dnsRules, rules...)
+		dnsOrigins = append(dnsOrigins, repeatOrigin(origin, len(rules))...)
+	}
 
-	routeRules = append(routeRules, newRouteRule(option.RawDefaultRule{Inbound: []string{InboundDNSTag}}, OutboundDNSTag))
-	routeRules = append(routeRules, newRouteRule(option.RawDefaultRule{Port: []uint16{53}}, OutboundDNSTag))
+	addRoute(newOrigin(SourceBuiltin, "dns hijack"),
+		newRouteRule(option.RawDefaultRule{Inbound: []string{InboundDNSTag}}, OutboundDNSTag),
+		newRouteRule(option.RawDefaultRule{Port: []uint16{53}}, OutboundDNSTag),
+	)
 	// ANDROID: Разрешаем DoT (853) к приватным IP (в т.ч. 172.19.0.2 — peer TUN DNS),
 	// чтобы системный Private DNS не ломал старт, а всё остальное по 853 блокируем.
 	if runtime.GOOS == "android" {
 		// 1) allow: 853 к приватным адресам (RFC1918, сюда попадает 172.19.0.2)
-		routeRules = append(routeRules, newRouteRule(
+		addRoute(newOrigin(SourceBuiltin, "android dot"), newRouteRule(
 			option.RawDefaultRule{
 				IPIsPrivate: true,
 				Port:        []uint16{853},
@@ -547,19 +703,19 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 			OutboundDirectTag,
 		))
 		// 2) block: все остальные 853
-		routeRules = append(routeRules, newRouteRule(
+		addRoute(newOrigin(SourceBuiltin, "android dot"), newRouteRule(
 			option.RawDefaultRule{Port: []uint16{853}},
 			OutboundBlockTag,
 		))
 	}
 
 	if opt.BypassLAN {
-		routeRules = append(routeRules, newRouteRule(option.RawDefaultRule{IPIsPrivate: true}, OutboundBypassTag))
+		addRoute(newOrigin(SourceBuiltin, "bypass-lan"), newRouteRule(option.RawDefaultRule{IPIsPrivate: true}, OutboundBypassTag))
 	}
 
 	// В режиме TUN-сервиса не уводим ничего в direct.
 	// ВСЕГДА: трафик к DoH-хосту идёт напрямую, чтобы бутстрап не зависел от прокси
-	routeRules = append(routeRules, newRouteRule(
+	addRoute(newOrigin(SourceBuiltin, "doh bootstrap"), newRouteRule(
 		option.RawDefaultRule{Domain: []string{"sky.rethinkdns.com"}},
 		OutboundDirectTag,
 	))
@@ -568,14 +724,53 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 	// пусть DoH может идти через прокси. Иначе при блокировке CF DoH ломается весь DNS.
 	// Не форсим прямой доступ к cloudflare-dns.com на Android/TUN — пусть DoH идёт через прокси.
 	if !opt.EnableTunService && !(runtime.GOOS == "android" && (opt.EnableTun || opt.EnableTunService)) {
-		routeRules = append(routeRules, newRouteRule(
+		addRoute(newOrigin(SourceBuiltin, "doh bootstrap"), newRouteRule(
 			option.RawDefaultRule{Domain: []string{"cloudflare-dns.com"}},
 			OutboundDirectTag,
 		))
 	}
 
-	for _, rule := range opt.Rules {
+	// Режимы Global/Direct переключаются на лету через clash-сервер и
+	// перекрывают все последующие правила; в режиме Rule эти правила не срабатывают.
+	// Ставятся после DoH-бутстрапа: в Global он всё равно должен идти напрямую.
+	if clashServerEnabled(opt) {
+		addRoute(newOrigin(SourceBuiltin, "clash-mode"),
+			newRouteRule(option.RawDefaultRule{ClashMode: ClashModeGlobal}, mainProxyTag(opt)),
+			newRouteRule(option.RawDefaultRule{ClashMode: ClashModeDirect}, OutboundDirectTag),
+		)
+		// DNS-правила режимов ставим после статических hosts, но до пользовательских.
+		options.DNS.Rules = append(options.DNS.Rules,
+			newDNSRouteRule(option.DefaultDNSRule{RawDefaultDNSRule: option.RawDefaultDNSRule{ClashMode: ClashModeGlobal}}, DNSRemoteTag),
+			newDNSRouteRule(option.DefaultDNSRule{RawDefaultDNSRule: option.RawDefaultDNSRule{ClashMode: ClashModeDirect}}, DNSBootstrapTag),
+		)
+		report.DNSRules = append(report.DNSRules, repeatOrigin(newOrigin(SourceBuiltin, "clash-mode"), 2)...)
+	}
+
+	ruleSetURLs := make(map[string]bool)
+	for i, rule := range opt.Rules {
+		userOrigin := newOrigin(SourceUser, fmt.Sprintf("rules[%d]", i))
 		routeRule := rule.MakeRule()
+		var dnsRuleSets []string
+		for _, list := range []struct {
+			urls string
+			ip   bool
+		}{{rule.RuleSetUrl, false}, {rule.IPRuleSetUrl, true}} {
+			for _, ruleSetURL := range strings.Split(list.urls, ",") {
+				ruleSetURL = strings.TrimSpace(ruleSetURL)
+				if ruleSetURL == "" {
+					continue
+				}
+				tag := ruleSetURLTag("rule-", ruleSetURL)
+				if !ruleSetURLs[tag] {
+					ruleSetURLs[tag] = true
+					rulesets = append(rulesets, newRemoteRuleSet(tag, ruleSetURL))
+				}
+				routeRule.RuleSet = append(routeRule.RuleSet, tag)
+				if !list.ip {
+					dnsRuleSets = append(dnsRuleSets, tag)
+				}
+			}
+		}
 		var outbound string
 		switch rule.Outbound {
 		case "bypass":
@@ -583,7 +778,12 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 		case "block":
 			outbound = OutboundBlockTag
 		case "proxy":
-			outbound = OutboundMainProxyTag
+			outbound = mainProxyTag(opt)
+		default:
+			// правило может вести в пользовательскую группу или цепочку по имени
+			if opt.isUserOutbound(rule.Outbound) {
+				outbound = rule.Outbound
+			}
 		}
 		if outbound != "" {
 			routeRule.RuleAction = option.RuleAction{
@@ -591,11 +791,16 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 				RouteOptions: option.RouteActionOptions{Outbound: outbound},
 			}
 			if routeRule.IsValid() {
-				routeRules = append(routeRules, option.Rule{Type: C.RuleTypeDefault, DefaultOptions: routeRule})
+				addRoute(userOrigin, option.Rule{Type: C.RuleTypeDefault, DefaultOptions: routeRule})
 			}
 		}
 
 		dnsRule := rule.MakeDNSRule()
+		dnsRule.RuleSet = dnsRuleSets
+		// правило только по IP/портам: DNS-правило без условий совпало бы со всеми запросами
+		if !dnsRule.IsValid() {
+			continue
+		}
 		routeOpts := dnsRule.DNSRuleAction.RouteOptions
 		var server string
 		switch rule.Outbound {
@@ -610,12 +815,16 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 						Rcode: &rc,
 					},
 				}
-				dnsRules = append(dnsRules, option.DNSRule{
+				addDNS(userOrigin, option.DNSRule{
 					Type:           C.RuleTypeDefault,
 					DefaultOptions: dnsRule,
 				})
 			}
 			continue
+		default:
+			if opt.isUserOutbound(rule.Outbound) {
+				server = DNSRemoteTag
+			}
 		case "proxy":
 			server = DNSRemoteTag
 			if opt.EnableFakeDNS {
@@ -628,7 +837,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 						DisableCache: true,
 					},
 				}
-				dnsRules = append(dnsRules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: fakeRule})
+				addDNS(userOrigin, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: fakeRule})
 			}
 		}
 		if server != "" {
@@ -637,7 +846,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 				Action:       C.RuleActionTypeRoute,
 				RouteOptions: routeOpts,
 			}
-			dnsRules = append(dnsRules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
+			addDNS(userOrigin, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
 		}
 	}
 
@@ -653,7 +862,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 				},
 			},
 		}
-		dnsRules = append(dnsRules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
+		addDNS(newOrigin(SourceBuiltin, "connection-test-url"), option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
 	}
 	if opt.BlockAds {
 		blockRuleSets := []struct {
@@ -672,7 +881,24 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 			ruleSetTags = append(ruleSetTags, rs.Tag)
 			rulesets = append(rulesets, newRemoteRuleSet(rs.Tag, rs.URL))
 		}
-		routeRules = append(routeRules, newRouteRule(option.RawDefaultRule{RuleSet: ruleSetTags}, OutboundBlockTag))
+		addRoute(newOrigin(SourceAds, "block-ads"), newRouteRule(option.RawDefaultRule{RuleSet: ruleSetTags}, OutboundBlockTag))
+	}
+	// свои списки — там же, где block-ads: после пользовательских правил, до региональных
+	blocklists, err := blocklistRules(opt, report)
+	if err != nil {
+		return err
+	}
+	rulesets = append(rulesets, blocklists.ruleSets...)
+	// outbound'ы списков setOutbounds оставляет рядом с базовыми
+	options.Outbounds = append(options.Outbounds, blocklists.outbounds...)
+	options.DNS.Servers = append(options.DNS.Servers, blocklists.dnsServers...)
+	var blockDNSOrigins []Origin
+	for _, rule := range blocklists.routeRules {
+		addRoute(blocklistRuleOrigin(rule.DefaultOptions.RuleSet), rule)
+	}
+	for _, rule := range blocklists.dnsRules {
+		addDNS(blocklistRuleOrigin(rule.DefaultOptions.RuleSet), rule)
+		blockDNSOrigins = append(blockDNSOrigins, blocklistRuleOrigin(rule.DefaultOptions.RuleSet))
 	}
 
 	if opt.Region != "other" {
@@ -683,6 +909,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 			{"geoip-" + opt.Region, "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/country/geoip-" + opt.Region + ".srs"},
 			{"geosite-" + opt.Region, "https://raw.githubusercontent.com/hiddify/hiddify-geo/rule-set/country/geosite-" + opt.Region + ".srs"},
 		}
+		regionOrigin := newOrigin(SourceRegion, "region")
 		regionTags := make([]string, 0, len(regionRuleSets))
 		for _, rs := range regionRuleSets {
 			regionTags = append(regionTags, rs.Tag)
@@ -691,7 +918,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 		
 		if opt.EnableTunService || runtime.GOOS == "android" {
 			// 1) По умолчанию (без флагов) оставляем как есть: DNS для RU через прокси-DoH
-			dnsRules = append(dnsRules, newDNSRouteRule(
+			addDNS(regionOrigin, newDNSRouteRule(
 				option.DefaultDNSRule{
 					RawDefaultDNSRule: option.RawDefaultDNSRule{
 						RuleSet: regionTags,
@@ -700,13 +927,13 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 				DNSRemoteTag,
 			))
 
-			routeRules = append(routeRules, newRouteRule(
+			addRoute(regionOrigin, newRouteRule(
 				option.RawDefaultRule{RuleSet: regionTags},
 				OutboundDirectTag,
 			))
 		} else {
 			// Старое поведение вне TUN
-			dnsRules = append(dnsRules, newDNSRouteRule(
+			addDNS(regionOrigin, newDNSRouteRule(
 				option.DefaultDNSRule{
 					RawDefaultDNSRule: option.RawDefaultDNSRule{
 						DomainSuffix: []string{"." + opt.Region},
@@ -715,8 +942,8 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 				DNSBootstrapTag,
 			))
 
-			routeRules = append(routeRules, newRouteRule(option.RawDefaultRule{RuleSet: regionTags}, OutboundDirectTag))
-			dnsRules = append(dnsRules, newDNSRouteRule(
+			addRoute(regionOrigin, newRouteRule(option.RawDefaultRule{RuleSet: regionTags}, OutboundDirectTag))
+			addDNS(regionOrigin, newDNSRouteRule(
 				option.DefaultDNSRule{RawDefaultDNSRule: option.RawDefaultDNSRule{RuleSet: regionTags}},
 				DNSBootstrapTag,
 			))
@@ -727,7 +954,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 	shouldAutoDetect := !(runtime.GOOS == "android" && (opt.EnableTun || opt.EnableTunService))
 	if options.Route == nil {
 		options.Route = &option.RouteOptions{
-			Final:               OutboundMainProxyTag,
+			Final:               mainProxyTag(opt),
 			AutoDetectInterface: shouldAutoDetect,
 			OverrideAndroidVPN:  runtime.GOOS == "android" && opt.PerAppProxyMode == "off",
 			DefaultDomainResolver: &option.DomainResolveOptions{
@@ -736,7 +963,7 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 		}
 	} else {
 		if options.Route.Final == "" {
-			options.Route.Final = OutboundMainProxyTag
+			options.Route.Final = mainProxyTag(opt)
 		}
 		options.Route.AutoDetectInterface = shouldAutoDetect
 		options.Route.OverrideAndroidVPN = runtime.GOOS == "android" && opt.PerAppProxyMode == "off"
@@ -786,11 +1013,25 @@ func setRoutingOptions(options *option.Options, opt *RostovVPNOptions) {
 
 	options.Route.Rules = append(options.Route.Rules, routeRules...)
 	options.Route.RuleSet = append(options.Route.RuleSet, rulesets...)
+	report.RouteRules = append(report.RouteRules, routeOrigins...)
 
 	if opt.EnableDNSRouting {
 
 		options.DNS.Rules = append(options.DNS.Rules, dnsRules...)
+		report.DNSRules = append(report.DNSRules, dnsOrigins...)
+	} else {
+		// списки блокировки работают и без DNS-маршрутизации
+		options.DNS.Rules = append(options.DNS.Rules, blocklists.dnsRules...)
+		report.DNSRules = append(report.DNSRules, blockDNSOrigins...)
+		skipped := make(map[string]bool)
+		for _, origin := range dnsOrigins {
+			if origin.Source == SourceUser && !skipped[origin.Option] {
+				skipped[origin.Option] = true
+				report.ignore(origin.Option, "dns", "enable-dns-routing is off, only the connection rule is added")
+			}
+		}
 	}
+	return nil
 }
 
 // ПРИМЕЧАНИЕ: проверь точные токены в твоей версии sing-box.
@@ -925,7 +1166,28 @@ func applyStaticIPHosts(options *option.Options, records map[string][]string) {
 		return
 	}
 	sort.Strings(domains)
+	putStaticHosts(options, normalized)
 
+	dnsRule := option.DefaultDNSRule{
+		RawDefaultDNSRule: option.RawDefaultDNSRule{
+			Domain: domains,
+		},
+		DNSRuleAction: option.DNSRuleAction{
+			Action: C.RuleActionTypeRoute,
+			RouteOptions: option.DNSRouteActionOptions{
+				Server:       DNSWarpHostsTag,
+				DisableCache: true,
+			},
+		},
+	}
+	// fmt.Println("[applyStaticIPHosts] !!! dnsRule\n", dnsRule, "\n !!! [applyStaticIPHosts]")
+
+	options.DNS.Rules = append(options.DNS.Rules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
+}
+
+// putStaticHosts кладёт записи в predefined hosts-сервера dns-warp-hosts
+// (создаёт его при необходимости); записи с тем же доменом заменяются.
+func putStaticHosts(options *option.Options, normalized map[string][]netip.Addr) {
 	updated := false
 	for i := range options.DNS.Servers {
 		if options.DNS.Servers[i].Tag != DNSWarpHostsTag {
@@ -963,22 +1225,6 @@ func applyStaticIPHosts(options *option.Options, records map[string][]string) {
 			Options: hosts,
 		})
 	}
-
-	dnsRule := option.DefaultDNSRule{
-		RawDefaultDNSRule: option.RawDefaultDNSRule{
-			Domain: domains,
-		},
-		DNSRuleAction: option.DNSRuleAction{
-			Action: C.RuleActionTypeRoute,
-			RouteOptions: option.DNSRouteActionOptions{
-				Server:       DNSWarpHostsTag,
-				DisableCache: true,
-			},
-		},
-	}
-	// fmt.Println("[applyStaticIPHosts] !!! dnsRule\n", dnsRule, "\n !!! [applyStaticIPHosts]")
-
-	options.DNS.Rules = append(options.DNS.Rules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: dnsRule})
 }
 
 func addrPtr(value string) *badoption.Addr {
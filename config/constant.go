@@ -1,6 +1,27 @@
 package config
 
+import "strings"
+
 const (
 	WarpOverProxy = "warp_over_proxy"
 	ProxyOverWarp = "proxy_over_warp"
 )
+
+// Режимы clash-сервера: значения совпадают с предопределённым списком sing-box.
+const (
+	ClashModeRule   = "Rule"
+	ClashModeGlobal = "Global"
+	ClashModeDirect = "Direct"
+)
+
+var ClashModes = []string{ClashModeRule, ClashModeGlobal, ClashModeDirect}
+
+// NormalizeClashMode приводит режим к каноническому виду; неизвестные значения дают Rule.
+func NormalizeClashMode(mode string) string {
+	for _, m := range ClashModes {
+		if strings.EqualFold(m, strings.TrimSpace(mode)) {
+			return m
+		}
+	}
+	return ClashModeRule
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sagernet/sing-box/experimental/libbox"
+)
+
+// registrars добавляют в реестры собственные типы ядра (load-balance,
+// fallback, racing DNS, fakeip); подключает их v2 через RegisterTypes.
+var registrars []func(ctx context.Context)
+
+// decodeContext нужен только для (де)сериализации при сборке: реестры после
+// создания не меняются, поэтому контекст строится один раз, а не на каждый outbound.
+var decodeContext = sync.OnceValue(BaseContext)
+
+// RegisterTypes подключает регистрацию собственных типов. Вызывается до первой
+// сборки конфига, обычно из init.
+func RegisterTypes(register func(ctx context.Context)) {
+	registrars = append(registrars, register)
+}
+
+// BaseContext — libbox.BaseContext плюс собственные типы ядра.
+// Используйте его везде, где конфиг декодируется или запускается, иначе
+// sing-box не узнает эти типы. box.New регистрирует сервисы в переданном
+// контексте, поэтому каждому экземпляру нужен свой.
+func BaseContext() context.Context {
+	ctx := libbox.BaseContext(nil)
+	for _, register := range registrars {
+		register(ctx)
+	}
+	return ctx
+}
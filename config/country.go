@@ -0,0 +1,237 @@
+package config
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/Darkmen203/rostovvpn-core/config/geoip"
+	"github.com/sagernet/sing-box/option"
+)
+
+const (
+	serverResolveTimeout     = 3 * time.Second
+	serverResolveConcurrency = 16
+)
+
+var (
+	geoIPAccess     sync.Mutex
+	geoIPReaderPath string
+	geoIPReaderFile os.FileInfo
+	geoIPReader     *geoip.Reader
+)
+
+// ISO 3166-1 alpha-2, которые встречаются в тегах серверов отдельным словом.
+var countryCodes = map[string]bool{}
+
+func init() {
+	for _, code := range strings.Fields(`AD AE AF AG AL AM AO AR AT AU AZ BA BB BD BE BF BG BH BI BJ BN BO BR BS BT BW BY BZ
+		CA CD CF CG CH CI CL CM CN CO CR CU CV CY CZ DE DJ DK DM DO DZ EC EE EG ER ES ET FI FJ FM FR GA GB GD GE GH GM GN
+		GQ GR GT GW GY HK HN HR HT HU ID IE IL IN IQ IR IS IT JM JO JP KE KG KH KI KM KN KP KR KW KZ LA LB LC LI LK LR LS
+		LT LU LV LY MA MC MD ME MG MH MK ML MM MN MO MR MT MU MV MW MX MY MZ NA NE NG NI NL NO NP NR NZ OM PA PE PG PH PK
+		PL PT PW PY QA RO RS RU RW SA SB SC SD SE SG SI SK SL SM SN SO SR SS ST SV SY SZ TD TG TH TJ TL TM TN TO TR TT TV
+		TW TZ UA UG US UY UZ VA VC VE VN VU WS YE ZA ZM ZW`) {
+		countryCodes[code] = true
+	}
+}
+
+// OutboundCountry — страна outbound'а: из GeoIP (countries по тегу), иначе по
+// тегу: флаг-эмодзи или отдельное слово с ISO-кодом («DE-1», «[NL] fast»).
+// Пустая строка — не определено.
+func OutboundCountry(countries map[string]string, tag string) string {
+	if country := countries[tag]; country != "" {
+		return country
+	}
+	return countryFromTag(tag)
+}
+
+// loadGeoIP открывает базу и держит её, пока файл не поменялся; без базы страны
+// берутся только из тегов. Неудачное открытие не запоминается: базу могут скачать позже.
+func loadGeoIP(path string) *geoip.Reader {
+	if path == "" {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+	geoIPAccess.Lock()
+	defer geoIPAccess.Unlock()
+	if geoIPReaderPath == path && geoIPReaderFile != nil &&
+		info.ModTime().Equal(geoIPReaderFile.ModTime()) && info.Size() == geoIPReaderFile.Size() {
+		return geoIPReader
+	}
+	reader, err := geoip.Open(path)
+	if err != nil {
+		return nil
+	}
+	geoIPReaderPath, geoIPReaderFile, geoIPReader = path, info, reader
+	return reader
+}
+
+// staticServerAddrs — адреса сервера без DNS: IP как есть или статические
+// адреса (те же, что уходят в applyStaticIPHosts).
+func staticServerAddrs(server string, staticIPs map[string][]string) []netip.Addr {
+	if addr, err := netip.ParseAddr(server); err == nil {
+		return []netip.Addr{addr}
+	}
+	var addrs []netip.Addr
+	for _, ip := range staticIPs[server] {
+		if addr, err := netip.ParseAddr(ip); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// resolveServerAddrs: адреса без DNS, и только без них — системный DNS.
+func resolveServerAddrs(ctx context.Context, server string, staticIPs map[string][]string) []netip.Addr {
+	if server == "" {
+		return nil
+	}
+	if addrs := staticServerAddrs(server, staticIPs); len(addrs) > 0 {
+		return addrs
+	}
+	addrs, _ := net.DefaultResolver.LookupNetIP(ctx, "ip", server)
+	return addrs
+}
+
+func lookupServerCountry(ctx context.Context, reader *geoip.Reader, server string, staticIPs map[string][]string) string {
+	if reader == nil {
+		return ""
+	}
+	return addrsCountry(reader, resolveServerAddrs(ctx, server, staticIPs))
+}
+
+func addrsCountry(reader *geoip.Reader, addrs []netip.Addr) string {
+	for _, addr := range addrs {
+		if country, err := reader.Country(addr); err == nil && country != "" {
+			return country
+		}
+	}
+	return ""
+}
+
+// lookupServerCountries резолвит серверы параллельно с общим таймаутом и
+// возвращает страны по адресу сервера; неопределённые в карту не попадают.
+func lookupServerCountries(ctx context.Context, reader *geoip.Reader, servers []string, staticIPs map[string][]string) map[string]string {
+	countries := make(map[string]string)
+	if reader == nil || len(servers) == 0 {
+		return countries
+	}
+	ctx, cancel := context.WithTimeout(ctx, serverResolveTimeout)
+	defer cancel()
+	var (
+		access    sync.Mutex
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, serverResolveConcurrency)
+		seen      = make(map[string]bool)
+	)
+	for _, server := range servers {
+		if server == "" || seen[server] {
+			continue
+		}
+		seen[server] = true
+		wg.Add(1)
+		go func(server string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			if country := lookupServerCountry(ctx, reader, server, staticIPs); country != "" {
+				access.Lock()
+				countries[server] = country
+				access.Unlock()
+			}
+		}(server)
+	}
+	wg.Wait()
+	return countries
+}
+
+// outboundServers — адрес сервера каждого outbound'а, у которого он есть.
+func outboundServers(outbounds []option.Outbound) map[string]string {
+	servers := make(map[string]string, len(outbounds))
+	for _, outbound := range outbounds {
+		obj, err := outboundToMap(outbound)
+		if err != nil || obj.string("server") == "" {
+			continue
+		}
+		servers[outbound.Tag] = obj.string("server")
+	}
+	return servers
+}
+
+// staticOutboundCountries — страны outbound'ов по GeoIP для сборки: только IP
+// и статические адреса, без DNS. Остальные досчитывает ResolveOutboundCountries
+// уже после старта ядра.
+func staticOutboundCountries(opt *RostovVPNOptions, outbounds []option.Outbound, staticIPs map[string][]string) map[string]string {
+	countries := make(map[string]string)
+	reader := loadGeoIP(opt.GeoIPPath)
+	if reader == nil {
+		return countries
+	}
+	for tag, server := range outboundServers(outbounds) {
+		if country := addrsCountry(reader, staticServerAddrs(server, staticIPs)); country != "" {
+			countries[tag] = country
+		}
+	}
+	return countries
+}
+
+// ResolveOutboundCountries резолвит через DNS серверы outbound'ов, чьих стран
+// нет в known, и возвращает найденные страны по тегу. Для UI: сборка конфига
+// ради этого не ждёт DNS.
+func ResolveOutboundCountries(ctx context.Context, geoIPPath string, outbounds []option.Outbound, known map[string]string) map[string]string {
+	reader := loadGeoIP(geoIPPath)
+	if reader == nil {
+		return nil
+	}
+	servers := outboundServers(outbounds)
+	var list []string
+	for tag, server := range servers {
+		if known[tag] == "" {
+			list = append(list, server)
+		}
+	}
+	byServer := lookupServerCountries(ctx, reader, list, nil)
+	countries := make(map[string]string)
+	for tag, server := range servers {
+		if country := byServer[server]; country != "" && known[tag] == "" {
+			countries[tag] = country
+		}
+	}
+	return countries
+}
+
+func countryFromTag(tag string) string {
+	runes := []rune(tag)
+	for i := 0; i+1 < len(runes); i++ {
+		if isRegionalIndicator(runes[i]) && isRegionalIndicator(runes[i+1]) {
+			code := string([]rune{runes[i] - 0x1F1E6 + 'A', runes[i+1] - 0x1F1E6 + 'A'})
+			if code == "UK" {
+				code = "GB"
+			}
+			return code
+		}
+	}
+	for _, word := range strings.FieldsFunc(tag, func(r rune) bool {
+		return !unicode.IsLetter(r) || r > unicode.MaxASCII
+	}) {
+		if word == "UK" {
+			return "GB"
+		}
+		if len(word) == 2 && countryCodes[word] {
+			return word
+		}
+	}
+	return ""
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+// writeTestGeoIP пишет sing-geoip базу из одного узла: 0.0.0.0/1 — country,
+// остальное не найдено.
+func writeTestGeoIP(t *testing.T, path string, country string, modTime time.Time) {
+	t.Helper()
+	field := func(value string) []byte { return append([]byte{byte(2<<5 | len(value))}, value...) }
+	uint32Field := func(value uint32) []byte {
+		return []byte{6<<5 | 4, byte(value >> 24), byte(value >> 16), byte(value >> 8), byte(value)}
+	}
+	// узел: левая запись ведёт в данные (node_count + 16), правая — «не найдено»
+	database := []byte{0, 0, 17, 0, 0, 1}
+	database = append(database, make([]byte, 16)...)
+	database = append(database, field(country)...)
+	database = append(database, "\xAB\xCD\xEFMaxMind.com"...)
+	database = append(database, 7<<5|4)
+	for _, pair := range [][]byte{
+		field("node_count"), uint32Field(1),
+		field("record_size"), uint32Field(24),
+		field("ip_version"), uint32Field(4),
+		field("database_type"), field("test"),
+	} {
+		database = append(database, pair...)
+	}
+	if err := os.WriteFile(path, database, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func countryTestOutbound(tag string, server string) option.Outbound {
+	return option.Outbound{Type: C.TypeSOCKS, Tag: tag, Options: &option.SOCKSOutboundOptions{
+		ServerOptions: option.ServerOptions{Server: server, ServerPort: 1080},
+	}}
+}
+
+func TestOutboundCountries(t *testing.T) {
+	opt := DefaultRostovVPNOptions()
+	opt.GeoIPPath = filepath.Join(t.TempDir(), "geoip.db")
+	writeTestGeoIP(t, opt.GeoIPPath, "de", time.Now().Add(-time.Hour))
+	outbounds := []option.Outbound{
+		countryTestOutbound("ip", "1.2.3.4"),
+		countryTestOutbound("static", "static.example"),
+		countryTestOutbound("domain", "localhost"),
+		countryTestOutbound("[NL] not found", "200.1.1.1"),
+	}
+	// при сборке — только IP и статические адреса, без DNS
+	countries := staticOutboundCountries(opt, outbounds, map[string][]string{"static.example": {"1.1.1.1"}})
+	if countries["ip"] != "DE" || countries["static"] != "DE" || countries["domain"] != "" || len(countries) != 2 {
+		t.Errorf("static countries = %v", countries)
+	}
+	if country := OutboundCountry(countries, "[NL] not found"); country != "NL" {
+		t.Errorf("country from tag = %q", country)
+	}
+	resolved := ResolveOutboundCountries(context.Background(), opt.GeoIPPath, outbounds, countries)
+	if len(resolved) != 1 || resolved["domain"] != "DE" {
+		t.Errorf("resolved countries = %v", resolved)
+	}
+
+	// база перечитывается, когда файл поменялся
+	writeTestGeoIP(t, opt.GeoIPPath, "nl", time.Now())
+	if countries := staticOutboundCountries(opt, outbounds[:1], nil); countries["ip"] != "NL" {
+		t.Errorf("reloaded countries = %v", countries)
+	}
+}
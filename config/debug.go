@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 	"runtime/debug"
 
-	"github.com/sagernet/sing-box/experimental/libbox"
 	"github.com/sagernet/sing-box/option"
 	singjson "github.com/sagernet/sing/common/json"
 )
@@ -26,7 +25,7 @@ import (
 //		return os.WriteFile(p, []byte(cfg), 0644)
 //	}
 func SaveCurrentConfig(path string, opts option.Options) error {
-	ctx := libbox.BaseContext(nil)
+	ctx := BaseContext()
 
 	// сериализация с учётом полиморфных полей sing-box
 	b, err := singjson.MarshalContext(ctx, opts)
@@ -49,7 +48,7 @@ func SaveCurrentConfig(path string, opts option.Options) error {
 }
 
 func ToJson(opt option.Options) (string, error) {
-	ctx := libbox.BaseContext(nil)
+	ctx := BaseContext()
 
 	// Правильный маршалинг polymorphic-полей sing-box
 	b, err := singjson.MarshalContext(ctx, opt)
@@ -1,12 +1,18 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/url"
+	"path"
 	"strings"
+	"time"
 
+	"github.com/Darkmen203/rostovvpn-core/protocol/dnsgroup"
 	C "github.com/sagernet/sing-box/constant"
 	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/json/badoption"
 	M "github.com/sagernet/sing/common/metadata"
 )
 
@@ -132,7 +138,7 @@ func newDNSServer(tag, address, resolver string, strategy option.DomainStrategy,
 		// fmt.Println("[newDNSServer] return tcp or udp =\n\n\n\n", option.DNSServerOptions{Type: scheme, Tag: tag, Options: opts}, "\n\n\n [newDNSServer]")
 		return option.DNSServerOptions{Type: scheme, Tag: tag, Options: opts}
 
-	case C.DNSTypeTLS:
+	case C.DNSTypeTLS, C.DNSTypeQUIC:
 		def := uint16(853)
 		server, port := parseHostPort(host, def)
 		obj["server"] = server
@@ -156,7 +162,7 @@ func newDNSServer(tag, address, resolver string, strategy option.DomainStrategy,
 			}
 		}
 		return option.DNSServerOptions{
-			Type:    C.DNSTypeTLS,
+			Type:    scheme,
 			Tag:     tag,
 			Options: tlsOptions,
 		}
@@ -292,3 +298,196 @@ func BuildDNSServers(inputs []DNSInput) ([]option.DNSServerOptions, error) {
 
 	return out, nil
 }
+
+// ---- Пользовательские серверы и правила из RostovVPNOptions ----
+
+// setCustomDNS добавляет именованные серверы из dns-servers, группы dns-groups
+// и правила dns-rules.
+// Правила встают в начало: корпоративный домен должен уйти во внутренний
+// резолвер раньше общих правил. Вызывается после setRoutingOptions — тот
+// заводит options.Route, куда складываются rule-set'ы по URL.
+func setCustomDNS(options *option.Options, opt *RostovVPNOptions, report *BuildReport) error {
+	if len(opt.DNSServers) == 0 && len(opt.DNSRules) == 0 && len(opt.DNSGroups) == 0 {
+		return nil
+	}
+	if options.DNS == nil {
+		options.DNS = &option.DNSOptions{}
+	}
+	known := make(map[string]bool)
+	for _, server := range options.DNS.Servers {
+		known[server.Tag] = true
+	}
+
+	inputs := make([]DNSInput, 0, len(opt.DNSServers))
+	for _, server := range opt.DNSServers {
+		if known[server.Tag] {
+			return fmt.Errorf("dns[%s]: tag already in use", server.Tag)
+		}
+		detour := server.Detour
+		switch detour {
+		case "", "direct":
+			detour = OutboundDirectTag
+		case "proxy":
+			detour = OutboundSelectTag
+		}
+		resolver := server.Resolver
+		if resolver == "" {
+			resolver = DNSBootstrapTag
+		}
+		inputs = append(inputs, DNSInput{
+			Tag:      server.Tag,
+			Address:  normalizeDNSAddress(server.Address),
+			Resolver: resolver,
+			Strategy: server.Strategy,
+			Detour:   detour,
+		})
+	}
+	servers, err := BuildDNSServers(inputs)
+	if err != nil {
+		return err
+	}
+	for _, server := range servers {
+		known[server.Tag] = true
+	}
+	for _, input := range inputs {
+		if !known[input.Resolver] {
+			return fmt.Errorf("dns[%s]: unknown resolver %s", input.Tag, input.Resolver)
+		}
+	}
+	options.DNS.Servers = append(options.DNS.Servers, servers...)
+	if err := addDNSGroups(options, opt, known); err != nil {
+		return err
+	}
+
+	ruleSets := make(map[string]bool)
+	if options.Route != nil {
+		for _, ruleSet := range options.Route.RuleSet {
+			ruleSets[ruleSet.Tag] = true
+		}
+	}
+	rules := make([]option.DNSRule, 0, len(opt.DNSRules))
+	origins := make([]Origin, 0, len(opt.DNSRules))
+	for i, ruleConfig := range opt.DNSRules {
+		origins = append(origins, newOrigin(SourceDNSRules, fmt.Sprintf("dns-rules[%d]", i)))
+		rule := (&Rule{Domains: dnsRuleDomains(ruleConfig.Domains)}).MakeDNSRule()
+		rule.Inbound = ruleConfig.Inbounds
+		for _, ruleSet := range ruleConfig.RuleSets {
+			tag := ruleSet
+			if strings.HasPrefix(ruleSet, "http://") || strings.HasPrefix(ruleSet, "https://") {
+				tag = dnsRuleSetTag(ruleSet)
+				if !ruleSets[tag] {
+					if options.Route == nil {
+						options.Route = &option.RouteOptions{}
+					}
+					options.Route.RuleSet = append(options.Route.RuleSet, newRemoteRuleSet(tag, ruleSet))
+					ruleSets[tag] = true
+				}
+			} else if !ruleSets[tag] {
+				return fmt.Errorf("dns-rules[%d]: unknown rule-set %s", i, ruleSet)
+			}
+			rule.RuleSet = append(rule.RuleSet, tag)
+		}
+		if len(rule.Domain)+len(rule.DomainSuffix)+len(rule.DomainKeyword)+len(rule.DomainRegex)+
+			len(rule.Geosite)+len(rule.RuleSet)+len(rule.Inbound) == 0 {
+			return fmt.Errorf("dns-rules[%d]: no domains, rule-sets or inbounds", i)
+		}
+		if ruleConfig.Server == "block" {
+			rcode := option.DNSRCode(0)
+			rule.DNSRuleAction = option.DNSRuleAction{
+				Action:            C.RuleActionTypePredefined,
+				PredefinedOptions: option.DNSRouteActionPredefined{Rcode: &rcode},
+			}
+			rules = append(rules, option.DNSRule{Type: C.RuleTypeDefault, DefaultOptions: rule})
+			continue
+		}
+		if !known[ruleConfig.Server] {
+			return fmt.Errorf("dns-rules[%d]: unknown server %s", i, ruleConfig.Server)
+		}
+		rules = append(rules, newDNSRouteRule(rule, ruleConfig.Server))
+	}
+	options.DNS.Rules = append(rules, options.DNS.Rules...)
+	report.DNSRules = append(origins, report.DNSRules...)
+	return nil
+}
+
+// addDNSGroups добавляет racing-группы. Группа dns-remote подменяет встроенный
+// сервер: тот переименовывается в dns-remote-upstream, и все правила,
+// final и резолверы, ссылавшиеся на dns-remote, попадают в группу.
+func addDNSGroups(options *option.Options, opt *RostovVPNOptions, known map[string]bool) error {
+	for _, group := range opt.DNSGroups {
+		members := group.Servers
+		if group.Tag == DNSRemoteTag {
+			for i := range options.DNS.Servers {
+				if options.DNS.Servers[i].Tag == DNSRemoteTag {
+					options.DNS.Servers[i].Tag = DNSRemoteUpstreamTag
+				}
+			}
+			known[DNSRemoteUpstreamTag] = true
+			members = make([]string, len(group.Servers))
+			for i, member := range group.Servers {
+				if member == DNSRemoteTag {
+					member = DNSRemoteUpstreamTag
+				}
+				members[i] = member
+			}
+		} else if group.Tag == "" || known[group.Tag] {
+			return fmt.Errorf("dns-groups[%s]: empty or duplicate tag", group.Tag)
+		}
+		if len(members) == 0 {
+			return fmt.Errorf("dns-groups[%s]: no servers", group.Tag)
+		}
+		for _, member := range members {
+			if !known[member] || member == group.Tag {
+				return fmt.Errorf("dns-groups[%s]: unknown server %s", group.Tag, member)
+			}
+		}
+		options.DNS.Servers = append(options.DNS.Servers, option.DNSServerOptions{
+			Type: dnsgroup.TypeRacing,
+			Tag:  group.Tag,
+			Options: &dnsgroup.Options{
+				Servers:     members,
+				Mode:        group.Mode,
+				Stagger:     badoption.Duration(time.Duration(group.StaggerMs) * time.Millisecond),
+				MaxFailures: group.MaxFailures,
+				Cooldown:    badoption.Duration(group.Cooldown.Duration()),
+			},
+		})
+		known[group.Tag] = true
+	}
+	return nil
+}
+
+// dnsRuleSetTag — стабильный тег для rule-set по URL с префиксом "dns-".
+func dnsRuleSetTag(ruleSetURL string) string {
+	return ruleSetURLTag("dns-", ruleSetURL)
+}
+
+// ruleSetURLTag — префикс, имя файла без расширения (для читаемости логов) и
+// хэш полного URL: одноимённые файлы с разных адресов (…/block/ads.srs и
+// …/mirror/ads.srs) не склеиваются в один rule-set.
+func ruleSetURLTag(prefix string, ruleSetURL string) string {
+	name := ruleSetURL
+	if u, err := url.Parse(ruleSetURL); err == nil && u.Path != "" {
+		name = path.Base(u.Path)
+	}
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".srs"), ".json")
+	sum := sha256.Sum256([]byte(ruleSetURL))
+	return prefix + name + "-" + hex.EncodeToString(sum[:4])
+}
+
+// dnsRuleDomains — домен без префикса ("corp.example.com") считается domain:,
+// остальное в формате правил (full:, regexp:, geosite:, ...).
+func dnsRuleDomains(domains string) string {
+	var items []string
+	for _, item := range strings.Split(domains, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, ":") {
+			item = "domain:" + item
+		}
+		items = append(items, item)
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,152 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+// customDNSTestOptions — встроенные серверы и правило, как после setDns.
+func customDNSTestOptions() *option.Options {
+	return &option.Options{
+		DNS: &option.DNSOptions{
+			RawDNSOptions: option.RawDNSOptions{
+				Servers: []option.DNSServerOptions{
+					newDNSServer(DNSBootstrapTag, "1.1.1.1", "", 0, ""),
+					newDNSServer(DNSRemoteTag, "tls://8.8.8.8", DNSBootstrapTag, 0, OutboundSelectTag),
+				},
+				Rules: []option.DNSRule{
+					newDNSRouteRule(option.DefaultDNSRule{RawDefaultDNSRule: option.RawDefaultDNSRule{Domain: []string{"builtin.example"}}}, DNSBootstrapTag),
+				},
+			},
+		},
+		Route: &option.RouteOptions{RuleSet: []option.RuleSet{{Type: C.RuleSetTypeLocal, Tag: "geosite-ads"}}},
+	}
+}
+
+func dnsServerDetour(server option.DNSServerOptions) string {
+	switch options := server.Options.(type) {
+	case *option.RemoteDNSServerOptions:
+		return options.Detour
+	case *option.RemoteTLSDNSServerOptions:
+		return options.Detour
+	}
+	return "?"
+}
+
+func TestSetCustomDNS(t *testing.T) {
+	tests := []struct {
+		name    string
+		servers []DNSServerConfig
+		rules   []DNSRuleConfig
+		err     string
+		check   func(t *testing.T, options *option.Options, report *BuildReport)
+	}{
+		{
+			name: "detour",
+			servers: []DNSServerConfig{
+				{Tag: "default", Address: "1.0.0.1"},
+				{Tag: "direct", Address: "udp://1.0.0.2", Detour: "direct"},
+				{Tag: "proxy", Address: "udp://1.0.0.3", Detour: "proxy"},
+				{Tag: "tagged", Address: "tcp://1.0.0.4", Detour: "my-proxy"},
+			},
+			check: func(t *testing.T, options *option.Options, report *BuildReport) {
+				want := map[string]string{"default": "", "direct": "", "proxy": OutboundSelectTag, "tagged": "my-proxy"}
+				for _, server := range options.DNS.Servers {
+					if detour, ok := want[server.Tag]; ok && dnsServerDetour(server) != detour {
+						t.Errorf("%s detour = %q, want %q", server.Tag, dnsServerDetour(server), detour)
+					}
+				}
+			},
+		},
+		{
+			name:    "quic",
+			servers: []DNSServerConfig{{Tag: "quic", Address: "quic://dns.example", Resolver: DNSBootstrapTag}},
+			check: func(t *testing.T, options *option.Options, report *BuildReport) {
+				server := options.DNS.Servers[len(options.DNS.Servers)-1]
+				quic, ok := server.Options.(*option.RemoteTLSDNSServerOptions)
+				if server.Type != C.DNSTypeQUIC || !ok || quic.Server != "dns.example" || quic.ServerPort != 853 {
+					t.Errorf("quic server = %s %+v", server.Type, server.Options)
+				}
+			},
+		},
+		{
+			name:    "rules ahead of built-in",
+			servers: []DNSServerConfig{{Tag: "corp", Address: "10.0.0.53"}},
+			rules: []DNSRuleConfig{
+				{Domains: "domain:corp.example", Server: "corp"},
+				{RuleSets: []string{"geosite-ads"}, Server: "block"},
+				{Inbounds: []string{"tun-in"}, Server: DNSRemoteTag},
+			},
+			check: func(t *testing.T, options *option.Options, report *BuildReport) {
+				rules := options.DNS.Rules
+				if len(rules) != 4 || len(report.DNSRules) != 4 {
+					t.Fatalf("rules = %d, origins = %d", len(rules), len(report.DNSRules))
+				}
+				if rule := rules[0].DefaultOptions; rule.RouteOptions.Server != "corp" || len(rule.DomainSuffix) != 1 {
+					t.Errorf("first rule = %+v", rule)
+				}
+				// block — predefined без ответа
+				block := rules[1].DefaultOptions
+				if block.Action != C.RuleActionTypePredefined || block.PredefinedOptions.Rcode == nil || block.RuleSet[0] != "geosite-ads" {
+					t.Errorf("block rule = %+v", block)
+				}
+				if rules[2].DefaultOptions.Inbound[0] != "tun-in" || rules[2].DefaultOptions.RouteOptions.Server != DNSRemoteTag {
+					t.Errorf("inbound rule = %+v", rules[2].DefaultOptions)
+				}
+				if rules[3].DefaultOptions.Domain[0] != "builtin.example" {
+					t.Errorf("built-in rule moved: %+v", rules[3].DefaultOptions)
+				}
+				if report.DNSRules[0].Source != SourceDNSRules || report.DNSRules[0].Option != "dns-rules[0]" {
+					t.Errorf("origin = %+v", report.DNSRules[0])
+				}
+			},
+		},
+		{
+			name:    "unknown resolver",
+			servers: []DNSServerConfig{{Tag: "doh", Address: "https://1.1.1.1/dns-query", Resolver: "missing"}},
+			err:     "unknown resolver missing",
+		},
+		{
+			name:    "duplicate tag",
+			servers: []DNSServerConfig{{Tag: DNSRemoteTag, Address: "1.1.1.1"}},
+			err:     "tag already in use",
+		},
+		{
+			name:  "unknown server",
+			rules: []DNSRuleConfig{{Domains: "domain:example.com", Server: "missing"}},
+			err:   "unknown server missing",
+		},
+		{
+			name:  "unknown rule-set",
+			rules: []DNSRuleConfig{{RuleSets: []string{"geosite-missing"}, Server: DNSRemoteTag}},
+			err:   "unknown rule-set geosite-missing",
+		},
+		{
+			name:  "empty rule",
+			rules: []DNSRuleConfig{{Server: DNSRemoteTag}},
+			err:   "no domains, rule-sets or inbounds",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := customDNSTestOptions()
+			report := &BuildReport{DNSRules: repeatOrigin(newOrigin(SourceBuiltin, "builtin"), len(options.DNS.Rules))}
+			opt := DefaultRostovVPNOptions()
+			opt.DNSServers, opt.DNSRules = test.servers, test.rules
+			err := setCustomDNS(options, opt, report)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("err = %v, want %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.check(t, options, report)
+		})
+	}
+}
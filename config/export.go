@@ -0,0 +1,440 @@
+package config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	singjson "github.com/sagernet/sing/common/json"
+	"github.com/sagernet/sing/common/json/badoption"
+)
+
+// ExportResult — результат обратной конвертации. Outbound'ы, которые нельзя
+// выразить ссылкой (selector, direct, wireguard и т.п.), попадают в Skipped
+// в виде "tag: причина".
+type ExportResult struct {
+	Content string
+	Links   []string
+	Skipped []string
+}
+
+const (
+	ExportFormatLinks        = "links"
+	ExportFormatSubscription = "subscription"
+	ExportFormatClash        = "clash"
+)
+
+// ExportOutbounds конвертирует outbound'ы в ссылки, base64-подписку или Clash YAML.
+func ExportOutbounds(outbounds []option.Outbound, format string) (ExportResult, error) {
+	switch format {
+	case ExportFormatLinks, "":
+		return ExportLinks(outbounds), nil
+	case ExportFormatSubscription:
+		return ExportSubscription(outbounds), nil
+	case ExportFormatClash:
+		return ExportClash(outbounds)
+	}
+	return ExportResult{}, fmt.Errorf("unknown export format: %s", format)
+}
+
+// ParseOutbounds разбирает профиль любого поддерживаемого формата и возвращает
+// его outbound'ы как типизированные опции sing-box.
+func ParseOutbounds(content string, configOpt *RostovVPNOptions) ([]option.Outbound, error) {
+	parsed, err := ParseConfigContent(content, false, configOpt, false)
+	if err != nil {
+		return nil, err
+	}
+	options, err := singjson.UnmarshalExtendedContext[option.Options](BaseContext(), parsed)
+	if err != nil {
+		return nil, err
+	}
+	return options.Outbounds, nil
+}
+
+// FilterOutboundsByTag оставляет только outbound'ы с указанными тегами (пустой список — все).
+func FilterOutboundsByTag(outbounds []option.Outbound, tags []string) []option.Outbound {
+	if len(tags) == 0 {
+		return outbounds
+	}
+	wanted := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		wanted[tag] = true
+	}
+	var filtered []option.Outbound
+	for _, outbound := range outbounds {
+		if wanted[outbound.Tag] {
+			filtered = append(filtered, outbound)
+		}
+	}
+	return filtered
+}
+
+// ExportLinks — по ссылке на outbound, Content — ссылки построчно.
+func ExportLinks(outbounds []option.Outbound) ExportResult {
+	var result ExportResult
+	for _, outbound := range outbounds {
+		link, err := OutboundToLink(outbound)
+		if err != nil {
+			result.Skipped = append(result.Skipped, outbound.Tag+": "+err.Error())
+			continue
+		}
+		result.Links = append(result.Links, link)
+	}
+	result.Content = strings.Join(result.Links, "\n")
+	return result
+}
+
+// ExportSubscription — те же ссылки в виде base64-тела подписки.
+func ExportSubscription(outbounds []option.Outbound) ExportResult {
+	result := ExportLinks(outbounds)
+	result.Content = base64.StdEncoding.EncodeToString([]byte(result.Content))
+	return result
+}
+
+// OutboundToLink строит share-ссылку в том виде, в каком её разбирает ray2sing.
+func OutboundToLink(outbound option.Outbound) (string, error) {
+	switch options := outbound.Options.(type) {
+	case *option.VLESSOutboundOptions:
+		return vlessLink(outbound.Tag, options)
+	case *option.VMessOutboundOptions:
+		return vmessLink(outbound.Tag, options)
+	case *option.TrojanOutboundOptions:
+		return trojanLink(outbound.Tag, options)
+	case *option.ShadowsocksOutboundOptions:
+		return shadowsocksLink(outbound.Tag, options)
+	case *option.Hysteria2OutboundOptions:
+		return hysteria2Link(outbound.Tag, options)
+	case *option.TUICOutboundOptions:
+		return tuicLink(outbound.Tag, options)
+	}
+	return "", fmt.Errorf("%s is not supported", outbound.Type)
+}
+
+func shareURL(scheme string, user *url.Userinfo, server option.ServerOptions, query url.Values, tag string) (string, error) {
+	if server.Server == "" || server.ServerPort == 0 {
+		return "", fmt.Errorf("missing server address")
+	}
+	link := url.URL{
+		Scheme:   scheme,
+		User:     user,
+		Host:     net.JoinHostPort(server.Server, strconv.Itoa(int(server.ServerPort))),
+		RawQuery: query.Encode(),
+		Fragment: tag,
+	}
+	return link.String(), nil
+}
+
+// linkTransport — поля транспорта в терминах v2rayN (type/host/path).
+type linkTransport struct {
+	network    string
+	headerType string
+	host       string
+	path       string
+}
+
+func transportToLink(transport *option.V2RayTransportOptions) (linkTransport, error) {
+	if transport == nil || transport.Type == "" {
+		return linkTransport{network: "tcp"}, nil
+	}
+	switch transport.Type {
+	case C.V2RayTransportTypeWebsocket:
+		ws := transport.WebsocketOptions
+		path := ws.Path
+		if ws.MaxEarlyData > 0 {
+			path = appendPathQuery(path, "ed", strconv.Itoa(int(ws.MaxEarlyData)))
+		}
+		return linkTransport{network: "ws", host: headerValue(ws.Headers, "Host"), path: path}, nil
+	case C.V2RayTransportTypeHTTPUpgrade:
+		upgrade := transport.HTTPUpgradeOptions
+		host := upgrade.Host
+		if host == "" {
+			host = headerValue(upgrade.Headers, "Host")
+		}
+		return linkTransport{network: "httpupgrade", host: host, path: upgrade.Path}, nil
+	case C.V2RayTransportTypeGRPC:
+		return linkTransport{network: "grpc", path: transport.GRPCOptions.ServiceName}, nil
+	case C.V2RayTransportTypeHTTP:
+		http := transport.HTTPOptions
+		return linkTransport{network: "tcp", headerType: "http", host: strings.Join(http.Host, ","), path: http.Path}, nil
+	case C.V2RayTransportTypeQUIC:
+		return linkTransport{network: "quic"}, nil
+	}
+	return linkTransport{}, fmt.Errorf("transport %s is not supported", transport.Type)
+}
+
+func headerValue(headers badoption.HTTPHeader, key string) string {
+	for name, values := range headers {
+		if strings.EqualFold(name, key) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func appendPathQuery(path string, key string, value string) string {
+	if strings.Contains(path, "?") {
+		return path + "&" + key + "=" + value
+	}
+	return path + "?" + key + "=" + value
+}
+
+func (t linkTransport) setQuery(query url.Values) {
+	query.Set("type", t.network)
+	if t.headerType != "" {
+		query.Set("headerType", t.headerType)
+	}
+	if t.host != "" {
+		query.Set("host", t.host)
+	}
+	if t.path != "" {
+		if t.network == "grpc" {
+			query.Set("serviceName", t.path)
+		} else {
+			query.Set("path", t.path)
+		}
+	}
+}
+
+func setTLSQuery(query url.Values, tls *option.OutboundTLSOptions) {
+	if tls == nil || !tls.Enabled {
+		query.Set("security", "none")
+		return
+	}
+	query.Set("security", "tls")
+	if tls.Reality != nil && tls.Reality.Enabled {
+		query.Set("security", "reality")
+		query.Set("pbk", tls.Reality.PublicKey)
+		if tls.Reality.ShortID != "" {
+			query.Set("sid", tls.Reality.ShortID)
+		}
+	}
+	if tls.ServerName != "" {
+		query.Set("sni", tls.ServerName)
+	}
+	if tls.UTLS != nil && tls.UTLS.Enabled && tls.UTLS.Fingerprint != "" {
+		query.Set("fp", tls.UTLS.Fingerprint)
+	}
+	if len(tls.ALPN) > 0 {
+		query.Set("alpn", strings.Join(tls.ALPN, ","))
+	}
+	if tls.Insecure {
+		query.Set("insecure", "1")
+	}
+	if tls.ECH != nil && tls.ECH.Enabled {
+		query.Set("ech", "1")
+	}
+}
+
+func setMuxQuery(query url.Values, mux *option.OutboundMultiplexOptions) {
+	if mux == nil || !mux.Enabled {
+		return
+	}
+	protocol := mux.Protocol
+	if protocol == "" {
+		protocol = "h2mux"
+	}
+	query.Set("muxtype", protocol)
+	if mux.MaxConnections > 0 {
+		query.Set("muxmaxc", strconv.Itoa(mux.MaxConnections))
+	}
+	if mux.MaxStreams > 0 {
+		query.Set("muxsmax", strconv.Itoa(mux.MaxStreams))
+	}
+	if mux.MinStreams > 0 {
+		query.Set("mux", strconv.Itoa(mux.MinStreams))
+	}
+	if mux.Padding {
+		query.Set("muxpad", "true")
+	}
+	if mux.Brutal != nil && mux.Brutal.Enabled {
+		query.Set("muxup", strconv.Itoa(mux.Brutal.UpMbps))
+		query.Set("muxdown", strconv.Itoa(mux.Brutal.DownMbps))
+	}
+}
+
+func vlessLink(tag string, options *option.VLESSOutboundOptions) (string, error) {
+	transport, err := transportToLink(options.Transport)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("encryption", "none")
+	transport.setQuery(query)
+	setTLSQuery(query, options.TLS)
+	if options.Flow != "" {
+		query.Set("flow", options.Flow)
+	}
+	if options.PacketEncoding != nil && *options.PacketEncoding != "" {
+		query.Set("packetEncoding", *options.PacketEncoding)
+	}
+	setMuxQuery(query, options.Multiplex)
+	return shareURL("vless", url.User(options.UUID), options.ServerOptions, query, tag)
+}
+
+func trojanLink(tag string, options *option.TrojanOutboundOptions) (string, error) {
+	transport, err := transportToLink(options.Transport)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	transport.setQuery(query)
+	setTLSQuery(query, options.TLS)
+	setMuxQuery(query, options.Multiplex)
+	return shareURL("trojan", url.User(options.Password), options.ServerOptions, query, tag)
+}
+
+// vmessLink — формат v2rayN: base64 от JSON с полями v/ps/add/port/id/...
+func vmessLink(tag string, options *option.VMessOutboundOptions) (string, error) {
+	if options.Server == "" || options.ServerPort == 0 {
+		return "", fmt.Errorf("missing server address")
+	}
+	transport, err := transportToLink(options.Transport)
+	if err != nil {
+		return "", err
+	}
+	headerType := transport.headerType
+	if headerType == "" {
+		headerType = "none"
+	}
+	security := options.Security
+	if security == "" {
+		security = "auto"
+	}
+	data := map[string]string{
+		"v":    "2",
+		"ps":   tag,
+		"add":  options.Server,
+		"port": strconv.Itoa(int(options.ServerPort)),
+		"id":   options.UUID,
+		"aid":  strconv.Itoa(options.AlterId),
+		"scy":  security,
+		"net":  transport.network,
+		"type": headerType,
+		"host": transport.host,
+		"path": transport.path,
+		"tls":  "",
+	}
+	if tls := options.TLS; tls != nil && tls.Enabled {
+		data["tls"] = "tls"
+		data["sni"] = tls.ServerName
+		if len(tls.ALPN) > 0 {
+			data["alpn"] = strings.Join(tls.ALPN, ",")
+		}
+		if tls.UTLS != nil && tls.UTLS.Enabled {
+			data["fp"] = tls.UTLS.Fingerprint
+		}
+		if tls.Insecure {
+			data["allowInsecure"] = "1"
+			data["insecure"] = "1" // ray2sing смотрит только на это поле
+		}
+	}
+	if options.PacketEncoding != "" {
+		data["packetEncoding"] = options.PacketEncoding
+	}
+	content, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return "vmess://" + base64.StdEncoding.EncodeToString(content), nil
+}
+
+// shadowsocksLink — SIP002: "method:password" в userinfo кодируется URL-safe
+// base64 без паддинга. Плагин передаётся в plugin/plugin_opts, как ждёт ray2sing.
+func shadowsocksLink(tag string, options *option.ShadowsocksOutboundOptions) (string, error) {
+	query := url.Values{}
+	if options.Plugin != "" {
+		query.Set("plugin", options.Plugin)
+		if options.PluginOptions != "" {
+			query.Set("plugin_opts", options.PluginOptions)
+		}
+	}
+	userInfo := base64.RawURLEncoding.EncodeToString([]byte(options.Method + ":" + options.Password))
+	return shareURL("ss", url.User(userInfo), options.ServerOptions, query, tag)
+}
+
+func hysteria2Link(tag string, options *option.Hysteria2OutboundOptions) (string, error) {
+	query := url.Values{}
+	if tls := options.TLS; tls != nil {
+		if tls.ServerName != "" {
+			query.Set("sni", tls.ServerName)
+		}
+		if tls.Insecure {
+			query.Set("insecure", "1")
+		}
+	}
+	if options.Obfs != nil && options.Obfs.Type != "" {
+		query.Set("obfs", options.Obfs.Type)
+		query.Set("obfs-password", options.Obfs.Password)
+	}
+	server := options.ServerOptions
+	if len(options.ServerPorts) > 0 {
+		ports, first, err := hysteria2PortRanges(options.ServerPorts)
+		if err != nil {
+			return "", err
+		}
+		query.Set("mport", ports)
+		// в адресе ссылки нужен хоть один порт
+		if server.ServerPort == 0 {
+			server.ServerPort = first
+		}
+	}
+	return shareURL("hy2", url.User(options.Password), server, query, tag)
+}
+
+// hysteria2PortRanges переводит server_ports («20000:30000») в mport
+// («20000-30000,443»), как его понимают v2rayN и NekoBox.
+func hysteria2PortRanges(serverPorts []string) (string, uint16, error) {
+	ranges := make([]string, 0, len(serverPorts))
+	var first uint16
+	for _, item := range serverPorts {
+		from, to, isRange := strings.Cut(strings.TrimSpace(item), ":")
+		start, err := strconv.ParseUint(from, 10, 16)
+		if err != nil || start == 0 {
+			return "", 0, fmt.Errorf("invalid server port %q", item)
+		}
+		if first == 0 {
+			first = uint16(start)
+		}
+		if !isRange {
+			ranges = append(ranges, from)
+			continue
+		}
+		end, err := strconv.ParseUint(to, 10, 16)
+		if err != nil || end < start {
+			return "", 0, fmt.Errorf("invalid server port range %q", item)
+		}
+		ranges = append(ranges, from+"-"+to)
+	}
+	return strings.Join(ranges, ","), first, nil
+}
+
+func tuicLink(tag string, options *option.TUICOutboundOptions) (string, error) {
+	query := url.Values{}
+	if options.CongestionControl != "" {
+		query.Set("congestion_control", options.CongestionControl)
+	}
+	if options.UDPRelayMode != "" {
+		query.Set("udp_relay_mode", options.UDPRelayMode)
+	}
+	if tls := options.TLS; tls != nil {
+		if tls.ServerName != "" {
+			query.Set("sni", tls.ServerName)
+		}
+		if len(tls.ALPN) > 0 {
+			query.Set("alpn", strings.Join(tls.ALPN, ","))
+		}
+		if tls.Insecure {
+			query.Set("allow_insecure", "1")
+		}
+		if tls.ECH != nil && tls.ECH.Enabled {
+			query.Set("ech", "1")
+		}
+	}
+	return shareURL("tuic", url.UserPassword(options.UUID, options.Password), options.ServerOptions, query, tag)
+}
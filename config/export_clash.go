@@ -0,0 +1,315 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"gopkg.in/yaml.v3"
+)
+
+// clashProxy — элемент proxies: в формате Clash Meta (mihomo). Поля с omitempty,
+// чтобы в документ попадало только то, что задано у outbound'а.
+type clashProxy struct {
+	Name              string            `yaml:"name"`
+	Type              string            `yaml:"type"`
+	Server            string            `yaml:"server"`
+	Port              uint16            `yaml:"port"`
+	UUID              string            `yaml:"uuid,omitempty"`
+	AlterID           int               `yaml:"alterId,omitempty"`
+	Cipher            string            `yaml:"cipher,omitempty"`
+	Password          string            `yaml:"password,omitempty"`
+	UDP               bool              `yaml:"udp,omitempty"`
+	TLS               bool              `yaml:"tls,omitempty"`
+	ServerName        string            `yaml:"servername,omitempty"`
+	SNI               string            `yaml:"sni,omitempty"`
+	ALPN              []string          `yaml:"alpn,omitempty"`
+	SkipCertVerify    bool              `yaml:"skip-cert-verify,omitempty"`
+	ClientFingerprint string            `yaml:"client-fingerprint,omitempty"`
+	Flow              string            `yaml:"flow,omitempty"`
+	PacketEncoding    string            `yaml:"packet-encoding,omitempty"`
+	Network           string            `yaml:"network,omitempty"`
+	WSOpts            *clashWSOpts      `yaml:"ws-opts,omitempty"`
+	H2Opts            *clashH2Opts      `yaml:"h2-opts,omitempty"`
+	HTTPOpts          *clashHTTPOpts    `yaml:"http-opts,omitempty"`
+	GRPCOpts          *clashGRPCOpts    `yaml:"grpc-opts,omitempty"`
+	RealityOpts       *clashRealityOpts `yaml:"reality-opts,omitempty"`
+	Plugin            string            `yaml:"plugin,omitempty"`
+	PluginOpts        map[string]any    `yaml:"plugin-opts,omitempty"`
+	Obfs              string            `yaml:"obfs,omitempty"`
+	ObfsPassword      string            `yaml:"obfs-password,omitempty"`
+	Up                string            `yaml:"up,omitempty"`
+	Down              string            `yaml:"down,omitempty"`
+	CongestionControl string            `yaml:"congestion-controller,omitempty"`
+	UDPRelayMode      string            `yaml:"udp-relay-mode,omitempty"`
+	ReduceRTT         bool              `yaml:"reduce-rtt,omitempty"`
+	Smux              *clashSmux        `yaml:"smux,omitempty"`
+}
+
+type clashWSOpts struct {
+	Path                string            `yaml:"path,omitempty"`
+	Headers             map[string]string `yaml:"headers,omitempty"`
+	MaxEarlyData        uint32            `yaml:"max-early-data,omitempty"`
+	EarlyDataHeaderName string            `yaml:"early-data-header-name,omitempty"`
+}
+
+type clashH2Opts struct {
+	Host []string `yaml:"host,omitempty"`
+	Path string   `yaml:"path,omitempty"`
+}
+
+type clashHTTPOpts struct {
+	Method  string              `yaml:"method,omitempty"`
+	Path    []string            `yaml:"path,omitempty"`
+	Headers map[string][]string `yaml:"headers,omitempty"`
+}
+
+type clashGRPCOpts struct {
+	ServiceName string `yaml:"grpc-service-name,omitempty"`
+}
+
+type clashRealityOpts struct {
+	PublicKey string `yaml:"public-key"`
+	ShortID   string `yaml:"short-id,omitempty"`
+}
+
+type clashSmux struct {
+	Enabled        bool   `yaml:"enabled"`
+	Protocol       string `yaml:"protocol,omitempty"`
+	MaxConnections int    `yaml:"max-connections,omitempty"`
+	MinStreams     int    `yaml:"min-streams,omitempty"`
+	MaxStreams     int    `yaml:"max-streams,omitempty"`
+	Padding        bool   `yaml:"padding,omitempty"`
+}
+
+// ExportClash собирает YAML-документ с одним разделом proxies:.
+func ExportClash(outbounds []option.Outbound) (ExportResult, error) {
+	var (
+		result  ExportResult
+		proxies []clashProxy
+	)
+	for _, outbound := range outbounds {
+		proxy, err := outboundToClash(outbound)
+		if err != nil {
+			result.Skipped = append(result.Skipped, outbound.Tag+": "+err.Error())
+			continue
+		}
+		proxies = append(proxies, *proxy)
+	}
+	content, err := yaml.Marshal(struct {
+		Proxies []clashProxy `yaml:"proxies"`
+	}{proxies})
+	if err != nil {
+		return result, err
+	}
+	result.Content = string(content)
+	return result, nil
+}
+
+func outboundToClash(outbound option.Outbound) (*clashProxy, error) {
+	proxy := &clashProxy{Name: outbound.Tag}
+	var err error
+	switch options := outbound.Options.(type) {
+	case *option.VLESSOutboundOptions:
+		proxy.Type = "vless"
+		setClashServer(proxy, options.ServerOptions)
+		proxy.UUID = options.UUID
+		proxy.Flow = options.Flow
+		proxy.UDP = !tcpOnly(options.Network)
+		if options.PacketEncoding != nil {
+			proxy.PacketEncoding = *options.PacketEncoding
+		}
+		setClashTLS(proxy, options.TLS, false)
+		err = setClashTransport(proxy, options.Transport)
+		setClashSmux(proxy, options.Multiplex)
+	case *option.VMessOutboundOptions:
+		proxy.Type = "vmess"
+		setClashServer(proxy, options.ServerOptions)
+		proxy.UUID = options.UUID
+		proxy.AlterID = options.AlterId
+		proxy.Cipher = options.Security
+		if proxy.Cipher == "" {
+			proxy.Cipher = "auto"
+		}
+		proxy.UDP = !tcpOnly(options.Network)
+		setClashTLS(proxy, options.TLS, false)
+		err = setClashTransport(proxy, options.Transport)
+		setClashSmux(proxy, options.Multiplex)
+	case *option.TrojanOutboundOptions:
+		proxy.Type = "trojan"
+		setClashServer(proxy, options.ServerOptions)
+		proxy.Password = options.Password
+		proxy.UDP = !tcpOnly(options.Network)
+		setClashTLS(proxy, options.TLS, true)
+		err = setClashTransport(proxy, options.Transport)
+		setClashSmux(proxy, options.Multiplex)
+	case *option.ShadowsocksOutboundOptions:
+		proxy.Type = "ss"
+		setClashServer(proxy, options.ServerOptions)
+		proxy.Cipher = options.Method
+		proxy.Password = options.Password
+		proxy.UDP = !tcpOnly(options.Network)
+		err = setClashPlugin(proxy, options.Plugin, options.PluginOptions)
+		setClashSmux(proxy, options.Multiplex)
+	case *option.Hysteria2OutboundOptions:
+		proxy.Type = "hysteria2"
+		setClashServer(proxy, options.ServerOptions)
+		proxy.Password = options.Password
+		if options.UpMbps > 0 {
+			proxy.Up = fmt.Sprintf("%d Mbps", options.UpMbps)
+		}
+		if options.DownMbps > 0 {
+			proxy.Down = fmt.Sprintf("%d Mbps", options.DownMbps)
+		}
+		if options.Obfs != nil && options.Obfs.Type != "" {
+			proxy.Obfs = options.Obfs.Type
+			proxy.ObfsPassword = options.Obfs.Password
+		}
+		setClashTLS(proxy, options.TLS, true)
+	case *option.TUICOutboundOptions:
+		proxy.Type = "tuic"
+		setClashServer(proxy, options.ServerOptions)
+		proxy.UUID = options.UUID
+		proxy.Password = options.Password
+		proxy.CongestionControl = options.CongestionControl
+		proxy.UDPRelayMode = options.UDPRelayMode
+		proxy.ReduceRTT = options.ZeroRTTHandshake
+		setClashTLS(proxy, options.TLS, true)
+	default:
+		return nil, fmt.Errorf("%s is not supported", outbound.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if proxy.Server == "" || proxy.Port == 0 {
+		return nil, fmt.Errorf("missing server address")
+	}
+	return proxy, nil
+}
+
+func setClashServer(proxy *clashProxy, server option.ServerOptions) {
+	proxy.Server = server.Server
+	proxy.Port = server.ServerPort
+}
+
+func tcpOnly(network option.NetworkList) bool {
+	return string(network) == "tcp"
+}
+
+// setClashTLS: у vmess/vless имя сервера идёт в servername и нужен tls: true,
+// у trojan/hysteria2/tuic TLS всегда включён, а имя — в sni.
+func setClashTLS(proxy *clashProxy, tls *option.OutboundTLSOptions, alwaysTLS bool) {
+	if tls == nil || !tls.Enabled {
+		return
+	}
+	if alwaysTLS {
+		proxy.SNI = tls.ServerName
+	} else {
+		proxy.TLS = true
+		proxy.ServerName = tls.ServerName
+	}
+	proxy.ALPN = tls.ALPN
+	proxy.SkipCertVerify = tls.Insecure
+	if tls.UTLS != nil && tls.UTLS.Enabled {
+		proxy.ClientFingerprint = tls.UTLS.Fingerprint
+	}
+	if tls.Reality != nil && tls.Reality.Enabled {
+		proxy.RealityOpts = &clashRealityOpts{
+			PublicKey: tls.Reality.PublicKey,
+			ShortID:   tls.Reality.ShortID,
+		}
+	}
+}
+
+func setClashTransport(proxy *clashProxy, transport *option.V2RayTransportOptions) error {
+	if transport == nil || transport.Type == "" {
+		return nil
+	}
+	switch transport.Type {
+	case C.V2RayTransportTypeWebsocket:
+		ws := transport.WebsocketOptions
+		proxy.Network = "ws"
+		proxy.WSOpts = &clashWSOpts{
+			Path:                ws.Path,
+			MaxEarlyData:        ws.MaxEarlyData,
+			EarlyDataHeaderName: ws.EarlyDataHeaderName,
+		}
+		if host := headerValue(ws.Headers, "Host"); host != "" {
+			proxy.WSOpts.Headers = map[string]string{"Host": host}
+		}
+	case C.V2RayTransportTypeGRPC:
+		proxy.Network = "grpc"
+		proxy.GRPCOpts = &clashGRPCOpts{ServiceName: transport.GRPCOptions.ServiceName}
+	case C.V2RayTransportTypeHTTP:
+		http := transport.HTTPOptions
+		if http.Method != "" {
+			proxy.Network = "http"
+			proxy.HTTPOpts = &clashHTTPOpts{Method: http.Method}
+			if http.Path != "" {
+				proxy.HTTPOpts.Path = []string{http.Path}
+			}
+			if len(http.Host) > 0 {
+				proxy.HTTPOpts.Headers = map[string][]string{"Host": http.Host}
+			}
+		} else {
+			proxy.Network = "h2"
+			proxy.H2Opts = &clashH2Opts{Host: http.Host, Path: http.Path}
+		}
+	default:
+		return fmt.Errorf("transport %s is not supported by clash", transport.Type)
+	}
+	return nil
+}
+
+// setClashPlugin переводит plugin_opts sing-box ("obfs=http;obfs-host=x")
+// в plugin-opts Clash.
+func setClashPlugin(proxy *clashProxy, plugin string, pluginOptions string) error {
+	if plugin == "" {
+		return nil
+	}
+	opts := make(map[string]any)
+	for _, item := range strings.Split(pluginOptions, ";") {
+		if item == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(item, "=")
+		opts[key] = value
+	}
+	switch plugin {
+	case "obfs-local":
+		proxy.Plugin = "obfs"
+		proxy.PluginOpts = map[string]any{"mode": opts["obfs"]}
+		if host, ok := opts["obfs-host"]; ok {
+			proxy.PluginOpts["host"] = host
+		}
+	case "v2ray-plugin":
+		proxy.Plugin = "v2ray-plugin"
+		proxy.PluginOpts = make(map[string]any)
+		for key, value := range opts {
+			switch key {
+			case "tls", "mux":
+				proxy.PluginOpts[key] = true
+			default:
+				proxy.PluginOpts[key] = value
+			}
+		}
+	default:
+		return fmt.Errorf("plugin %s is not supported by clash", plugin)
+	}
+	return nil
+}
+
+func setClashSmux(proxy *clashProxy, mux *option.OutboundMultiplexOptions) {
+	if mux == nil || !mux.Enabled {
+		return
+	}
+	proxy.Smux = &clashSmux{
+		Enabled:        true,
+		Protocol:       mux.Protocol,
+		MaxConnections: mux.MaxConnections,
+		MinStreams:     mux.MinStreams,
+		MaxStreams:     mux.MaxStreams,
+		Padding:        mux.Padding,
+	}
+}
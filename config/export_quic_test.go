@@ -0,0 +1,78 @@
+//go:build with_utls && with_quic
+
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+// TLS-ссылки (ray2sing включает для них uTLS), reality и QUIC-протоколы
+// проходят libbox.CheckConfig только с тегами сборки из Makefile.
+const exportQUICTestProfile = `{
+  "outbounds": [
+    {"type": "selector", "tag": "select", "outbounds": ["vless-reality"]},
+    {"type": "direct", "tag": "direct"},
+    {
+      "type": "vless", "tag": "vless-reality", "server": "198.51.100.10", "server_port": 443,
+      "uuid": "b831381d-6324-4d53-ad4f-8cda48b30811", "flow": "xtls-rprx-vision",
+      "tls": {"enabled": true, "server_name": "www.example.com",
+        "utls": {"enabled": true, "fingerprint": "chrome"},
+        "reality": {"enabled": true, "public_key": "jNXHt1yRo0vDuchQlIP6Z0ZvjT3KtzVI-T4E7RoLJS0", "short_id": "0123abcd"}}
+    },
+    {
+      "type": "vless", "tag": "vless ws", "server": "vless.example.com", "server_port": 8443,
+      "uuid": "b831381d-6324-4d53-ad4f-8cda48b30811",
+      "tls": {"enabled": true, "server_name": "cdn.example.com"},
+      "transport": {"type": "ws", "path": "/ray", "headers": {"Host": "cdn.example.com"}}
+    },
+    {
+      "type": "vmess", "tag": "vmess-grpc", "server": "vmess.example.com", "server_port": 443,
+      "uuid": "b831381d-6324-4d53-ad4f-8cda48b30811", "security": "auto",
+      "tls": {"enabled": true, "server_name": "vmess.example.com"},
+      "transport": {"type": "grpc", "service_name": "grpc-svc"}
+    },
+    {
+      "type": "trojan", "tag": "trojan-ws", "server": "trojan.example.com", "server_port": 443,
+      "password": "trojan-pass",
+      "tls": {"enabled": true, "server_name": "trojan.example.com"},
+      "transport": {"type": "ws", "path": "/tr"}
+    },
+    {
+      "type": "hysteria2", "tag": "hy2", "server": "hy2.example.com", "server_port": 443,
+      "password": "hy2-pass", "obfs": {"type": "salamander", "password": "obfs-pass"},
+      "tls": {"enabled": true, "server_name": "hy2.example.com"}
+    },
+    {
+      "type": "tuic", "tag": "tuic", "server": "tuic.example.com", "server_port": 443,
+      "uuid": "b831381d-6324-4d53-ad4f-8cda48b30811", "password": "tuic-pass",
+      "congestion_control": "bbr",
+      "tls": {"enabled": true, "server_name": "tuic.example.com", "alpn": ["h3"]}
+    }
+  ]
+}`
+
+func TestExportQUICRoundTrip(t *testing.T) {
+	outbounds := exportTestOutbounds(t, exportQUICTestProfile)
+	result := ExportLinks(outbounds)
+	for _, prefix := range []string{"vless://", "vmess://", "trojan://", "hy2://", "tuic://"} {
+		if !strings.Contains(result.Content, prefix) {
+			t.Errorf("no %s link in %s", prefix, result.Content)
+		}
+	}
+	reparsed, err := ParseOutbounds(result.Content, nil)
+	if err != nil {
+		t.Fatalf("parse links: %v", err)
+	}
+	checkRoundTrip(t, outbounds, reparsed)
+
+	clash, err := ExportClash(outbounds)
+	if err != nil {
+		t.Fatalf("export clash: %v", err)
+	}
+	reparsed, err = ParseOutbounds(clash.Content, nil)
+	if err != nil {
+		t.Fatalf("parse clash: %v\n%s", err, clash.Content)
+	}
+	checkRoundTrip(t, outbounds, reparsed)
+}
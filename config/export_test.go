@@ -0,0 +1,237 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+// ray2sing включает uTLS для любой TLS-ссылки, поэтому здесь только
+// протоколы без TLS; TLS, reality и QUIC проверяются в export_quic_test.go
+// с тегами сборки
+const exportTestProfile = `{
+  "outbounds": [
+    {"type": "selector", "tag": "select", "outbounds": ["vless ws"]},
+    {"type": "direct", "tag": "direct"},
+    {
+      "type": "vless", "tag": "vless ws", "server": "vless.example.com", "server_port": 8080,
+      "uuid": "b831381d-6324-4d53-ad4f-8cda48b30811",
+      "transport": {"type": "ws", "path": "/ray", "headers": {"Host": "cdn.example.com"}}
+    },
+    {
+      "type": "vmess", "tag": "vmess-grpc", "server": "vmess.example.com", "server_port": 8080,
+      "uuid": "b831381d-6324-4d53-ad4f-8cda48b30811", "security": "auto",
+      "transport": {"type": "grpc", "service_name": "grpc-svc"}
+    },
+    {
+      "type": "shadowsocks", "tag": "ss", "server": "203.0.113.5", "server_port": 8388,
+      "method": "aes-256-gcm", "password": "ss-pass"
+    },
+    {
+      "type": "shadowsocks", "tag": "ss-2022", "server": "203.0.113.6", "server_port": 8388,
+      "method": "2022-blake3-aes-128-gcm", "password": "Xv6gV0cj7u5l0Xr8x0wO4w=="
+    }
+  ]
+}`
+
+// exportSummary — поля, которые обязаны пережить экспорт и повторный разбор.
+func exportSummary(outbound option.Outbound) string {
+	var (
+		server    option.ServerOptions
+		secret    string
+		tls       *option.OutboundTLSOptions
+		transport *option.V2RayTransportOptions
+	)
+	switch options := outbound.Options.(type) {
+	case *option.VLESSOutboundOptions:
+		server, secret, tls, transport = options.ServerOptions, options.UUID+options.Flow, options.TLS, options.Transport
+	case *option.VMessOutboundOptions:
+		server, secret, tls, transport = options.ServerOptions, options.UUID, options.TLS, options.Transport
+	case *option.TrojanOutboundOptions:
+		server, secret, tls, transport = options.ServerOptions, options.Password, options.TLS, options.Transport
+	case *option.ShadowsocksOutboundOptions:
+		server, secret = options.ServerOptions, options.Method+":"+options.Password
+	case *option.Hysteria2OutboundOptions:
+		server, secret, tls = options.ServerOptions, options.Password, options.TLS
+		if options.Obfs != nil {
+			secret += "/" + options.Obfs.Type + ":" + options.Obfs.Password
+		}
+	case *option.TUICOutboundOptions:
+		server, secret, tls = options.ServerOptions, options.UUID+":"+options.Password+"/"+options.CongestionControl, options.TLS
+	}
+	summary := fmt.Sprintf("%s %s:%d %s", outbound.Type, server.Server, server.ServerPort, secret)
+	if tls != nil && tls.Enabled {
+		summary += " tls=" + tls.ServerName
+		if tls.Reality != nil && tls.Reality.Enabled {
+			summary += " reality=" + tls.Reality.PublicKey + "/" + tls.Reality.ShortID
+		}
+	}
+	if transport != nil && transport.Type != "" {
+		summary += " transport=" + transport.Type
+		switch transport.Type {
+		case C.V2RayTransportTypeWebsocket:
+			summary += transport.WebsocketOptions.Path
+		case C.V2RayTransportTypeGRPC:
+			summary += transport.GRPCOptions.ServiceName
+		}
+	}
+	return summary
+}
+
+func exportTestOutbounds(t *testing.T, profile string) []option.Outbound {
+	t.Helper()
+	outbounds, err := ParseOutbounds(profile, nil)
+	if err != nil {
+		t.Fatalf("parse profile: %v", err)
+	}
+	return outbounds
+}
+
+func checkRoundTrip(t *testing.T, original []option.Outbound, reparsed []option.Outbound) {
+	t.Helper()
+	byTag := make(map[string]option.Outbound)
+	for _, outbound := range reparsed {
+		// ray2sing дописывает к тегу " § <номер>"
+		tag, _, _ := strings.Cut(outbound.Tag, " § ")
+		byTag[tag] = outbound
+	}
+	for _, outbound := range original {
+		if outbound.Type == C.TypeSelector || outbound.Type == C.TypeDirect {
+			continue
+		}
+		got, ok := byTag[outbound.Tag]
+		if !ok {
+			t.Errorf("%s: missing after round trip", outbound.Tag)
+			continue
+		}
+		if want, have := exportSummary(outbound), exportSummary(got); want != have {
+			t.Errorf("%s: round trip mismatch\nwant %s\nhave %s", outbound.Tag, want, have)
+		}
+	}
+}
+
+func TestExportLinksRoundTrip(t *testing.T) {
+	outbounds := exportTestOutbounds(t, exportTestProfile)
+	result := ExportLinks(outbounds)
+	if len(result.Links) != 4 {
+		t.Fatalf("expected 4 links, got %d: %v", len(result.Links), result.Links)
+	}
+	if len(result.Skipped) != 2 {
+		t.Errorf("selector and direct should be skipped, got %v", result.Skipped)
+	}
+	for _, prefix := range []string{"vless://", "vmess://", "ss://"} {
+		if !strings.Contains(result.Content, prefix) {
+			t.Errorf("no %s link in %s", prefix, result.Content)
+		}
+	}
+	reparsed, err := ParseOutbounds(result.Content, nil)
+	if err != nil {
+		t.Fatalf("parse links: %v", err)
+	}
+	checkRoundTrip(t, outbounds, reparsed)
+}
+
+func TestExportSubscriptionRoundTrip(t *testing.T) {
+	outbounds := exportTestOutbounds(t, exportTestProfile)
+	result := ExportSubscription(outbounds)
+	decoded, err := base64.StdEncoding.DecodeString(result.Content)
+	if err != nil {
+		t.Fatalf("subscription is not base64: %v", err)
+	}
+	if string(decoded) != strings.Join(result.Links, "\n") {
+		t.Errorf("subscription body differs from links")
+	}
+	reparsed, err := ParseOutbounds(result.Content, nil)
+	if err != nil {
+		t.Fatalf("parse subscription: %v", err)
+	}
+	checkRoundTrip(t, outbounds, reparsed)
+}
+
+func TestExportClashRoundTrip(t *testing.T) {
+	outbounds := exportTestOutbounds(t, exportTestProfile)
+	result, err := ExportClash(outbounds)
+	if err != nil {
+		t.Fatalf("export clash: %v", err)
+	}
+	if !strings.HasPrefix(result.Content, "proxies:") {
+		t.Errorf("unexpected clash document:\n%s", result.Content)
+	}
+	reparsed, err := ParseOutbounds(result.Content, nil)
+	if err != nil {
+		t.Fatalf("parse clash: %v\n%s", err, result.Content)
+	}
+	checkRoundTrip(t, outbounds, reparsed)
+}
+
+func TestShadowsocksLink(t *testing.T) {
+	options := &option.ShadowsocksOutboundOptions{
+		ServerOptions: option.ServerOptions{Server: "203.0.113.5", ServerPort: 8388},
+		Method:        "chacha20-ietf-poly1305",
+		Password:      "p@ss/w+rd?",
+	}
+	link, err := shadowsocksLink("ss test", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, hasPassword := parsed.User.Password(); hasPassword {
+		t.Fatalf("userinfo is not base64: %s", link)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(parsed.User.Username())
+	if err != nil || string(decoded) != options.Method+":"+options.Password {
+		t.Fatalf("userinfo %q: %s %v", parsed.User.Username(), decoded, err)
+	}
+	reparsed, err := ParseOutbounds(link, nil)
+	if err != nil {
+		t.Fatalf("parse %s: %v", link, err)
+	}
+	if len(reparsed) != 1 {
+		t.Fatalf("outbounds = %d", len(reparsed))
+	}
+	got, ok := reparsed[0].Options.(*option.ShadowsocksOutboundOptions)
+	if !ok || got.Method != options.Method || got.Password != options.Password {
+		t.Errorf("reparsed = %+v", reparsed[0].Options)
+	}
+}
+
+func TestHysteria2LinkPorts(t *testing.T) {
+	options := &option.Hysteria2OutboundOptions{
+		ServerOptions: option.ServerOptions{Server: "hy2.example.com"},
+		ServerPorts:   []string{"20000:30000", "443"},
+		Password:      "hy2-pass",
+		OutboundTLSOptionsContainer: option.OutboundTLSOptionsContainer{TLS: &option.OutboundTLSOptions{
+			Enabled: true, ServerName: "hy2.example.com", ECH: &option.OutboundECHOptions{Enabled: true},
+		}},
+	}
+	link, err := hysteria2Link("hy2", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+	if parsed.Port() != "20000" || query.Get("mport") != "20000-30000,443" {
+		t.Errorf("ports: %s", link)
+	}
+	// ECH-конфиг в ссылку не помещается, ech=1 без него бесполезен
+	if query.Has("ech") {
+		t.Errorf("ech exported: %s", link)
+	}
+
+	options.ServerPorts = []string{"30000:20000"}
+	result := ExportLinks([]option.Outbound{{Type: "hysteria2", Tag: "bad", Options: options}})
+	if len(result.Links) != 0 || len(result.Skipped) != 1 || !strings.Contains(result.Skipped[0], "invalid server port range") {
+		t.Errorf("result = %+v", result)
+	}
+}
@@ -0,0 +1,39 @@
+package geoip
+
+import (
+	"net/netip"
+	"testing"
+)
+
+// База приходит из файла пользователя: битые данные дают ошибку, а не панику
+// или зависание.
+func FuzzFromBytes(f *testing.F) {
+	f.Add(testDatabase(encodeString("de")))
+	f.Add(testDatabase(encodeMap(encodeString("country"), encodeMap(encodeString("iso_code"), encodeString("de")))))
+	f.Add(testDatabase([]byte{typePointer << 5, 0}))
+	f.Add([]byte("not a database"))
+	f.Fuzz(func(t *testing.T, database []byte) {
+		reader, err := FromBytes(database)
+		if err != nil {
+			return
+		}
+		reader.DatabaseType()
+		for _, addr := range []string{"1.2.3.4", "200.1.1.1", "::ffff:1.2.3.4", "2001:db8::1"} {
+			reader.Country(netip.MustParseAddr(addr))
+		}
+	})
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add(encodeString("de"))
+	f.Add(encodeMap(encodeString("registered_country"), encodeMap(encodeString("iso_code"), encodeString("DE"))))
+	f.Add([]byte{typePointer << 5, 2, typePointer << 5, 0})
+	f.Add([]byte{typeMap<<5 | 28})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		reader, err := FromBytes(testDatabase(data))
+		if err != nil {
+			t.Fatalf("valid tree rejected: %v", err)
+		}
+		reader.Country(netip.MustParseAddr("1.2.3.4"))
+	})
+}
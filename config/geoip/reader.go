@@ -0,0 +1,365 @@
+// Package geoip читает базы MaxMind DB (.mmdb): sing-geoip (geoip.db), где
+// запись — просто код страны, и GeoLite2/GeoIP2-Country с country.iso_code.
+// Поддерживается ровно то, что нужно для поиска страны по адресу: ради этого
+// не тянем maxminddb-golang в зависимости ядра. Разбор битых баз проверяется
+// фаззингом (fuzz_test.go).
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"os"
+	"strings"
+)
+
+var metadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+const (
+	// размер нулевого разделителя между деревом и секцией данных
+	dataSectionSeparator = 16
+	// вложенность значений и указателей: в настоящих базах их единицы,
+	// глубже — битая или зацикленная база
+	maxDecodeDepth = 32
+)
+
+type Reader struct {
+	buffer       []byte
+	nodeCount    uint
+	recordSize   uint
+	ipVersion    uint
+	databaseType string
+	treeSize     uint
+	ipv4Start    uint
+}
+
+func Open(path string) (*Reader, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return FromBytes(content)
+}
+
+func FromBytes(buffer []byte) (*Reader, error) {
+	markerIndex := bytes.LastIndex(buffer, metadataMarker)
+	if markerIndex < 0 {
+		return nil, errors.New("invalid MaxMind DB: metadata not found")
+	}
+	metadataStart := markerIndex + len(metadataMarker)
+	metadata, _, err := (&decoder{buffer: buffer[metadataStart:]}).decode(0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MaxMind DB metadata: %w", err)
+	}
+	fields, ok := metadata.(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid MaxMind DB metadata")
+	}
+	reader := &Reader{
+		buffer:       buffer[:markerIndex],
+		nodeCount:    uintField(fields, "node_count"),
+		recordSize:   uintField(fields, "record_size"),
+		ipVersion:    uintField(fields, "ip_version"),
+		databaseType: fmt.Sprint(fields["database_type"]),
+	}
+	switch reader.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("unsupported record size: %d", reader.recordSize)
+	}
+	reader.treeSize = reader.nodeCount * reader.recordSize / 4
+	if reader.treeSize+dataSectionSeparator > uint(len(reader.buffer)) {
+		return nil, errors.New("invalid MaxMind DB: truncated search tree")
+	}
+	// IPv4 в IPv6-дереве лежат под ::/96 — спускаемся по 96 нулевым битам один раз
+	if reader.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < reader.nodeCount; i++ {
+			node = reader.readNode(node, 0)
+		}
+		reader.ipv4Start = node
+	}
+	return reader, nil
+}
+
+func (r *Reader) DatabaseType() string {
+	return r.databaseType
+}
+
+// Country — ISO-код страны адреса в верхнем регистре, пустая строка если не найден.
+func (r *Reader) Country(addr netip.Addr) (string, error) {
+	record, err := r.lookup(addr)
+	if err != nil || record == nil {
+		return "", err
+	}
+	switch value := record.(type) {
+	case string:
+		return strings.ToUpper(value), nil
+	case map[string]any:
+		for _, key := range []string{"country", "registered_country"} {
+			if country, ok := value[key].(map[string]any); ok {
+				if code, ok := country["iso_code"].(string); ok && code != "" {
+					return strings.ToUpper(code), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+func (r *Reader) lookup(addr netip.Addr) (any, error) {
+	addr = addr.Unmap()
+	var (
+		ip    []byte
+		node  uint
+		bitsN int
+	)
+	if addr.Is4() {
+		if r.ipVersion == 6 {
+			node = r.ipv4Start
+		}
+		ip4 := addr.As4()
+		ip, bitsN = ip4[:], 32
+	} else {
+		if r.ipVersion == 4 {
+			return nil, nil
+		}
+		ip16 := addr.As16()
+		ip, bitsN = ip16[:], 128
+	}
+	for i := 0; i < bitsN && node < r.nodeCount; i++ {
+		bit := uint(ip[i>>3]>>(7-uint(i&7))) & 1
+		node = r.readNode(node, bit)
+	}
+	if node == r.nodeCount {
+		return nil, nil
+	}
+	if node < r.nodeCount {
+		return nil, errors.New("invalid MaxMind DB: search tree is too deep")
+	}
+	offset := node - r.nodeCount - dataSectionSeparator
+	data := r.buffer[r.treeSize+dataSectionSeparator:]
+	if offset >= uint(len(data)) {
+		return nil, errors.New("invalid MaxMind DB: record out of range")
+	}
+	value, _, err := (&decoder{buffer: data}).decode(offset)
+	return value, err
+}
+
+func (r *Reader) readNode(node uint, bit uint) uint {
+	offset := node * r.recordSize / 4
+	b := r.buffer[offset:]
+	switch r.recordSize {
+	case 24:
+		if bit == 0 {
+			return uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3])<<16 | uint(b[4])<<8 | uint(b[5])
+	case 28:
+		if bit == 0 {
+			return (uint(b[3])&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return (uint(b[3])&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		if bit == 0 {
+			return uint(binary.BigEndian.Uint32(b[:4]))
+		}
+		return uint(binary.BigEndian.Uint32(b[4:8]))
+	}
+}
+
+func uintField(fields map[string]any, key string) uint {
+	switch value := fields[key].(type) {
+	case uint64:
+		return uint(value)
+	case uint32:
+		return uint(value)
+	case uint16:
+		return uint(value)
+	}
+	return 0
+}
+
+const (
+	typeExtended = iota
+	typePointer
+	typeString
+	typeDouble
+	typeBytes
+	typeUint16
+	typeUint32
+	typeMap
+	typeInt32
+	typeUint64
+	typeUint128
+	typeSlice
+	typeContainer
+	typeMarker
+	typeBool
+	typeFloat
+)
+
+// decoder — секция данных MaxMind DB: управляющий байт (тип и размер),
+// указатели считаются от начала секции.
+type decoder struct {
+	buffer []byte
+	depth  int
+}
+
+var (
+	errTruncated = errors.New("unexpected end of data")
+	errTooDeep   = errors.New("data is nested too deep")
+)
+
+func (d *decoder) decode(offset uint) (any, uint, error) {
+	if offset >= uint(len(d.buffer)) {
+		return nil, 0, errTruncated
+	}
+	if d.depth >= maxDecodeDepth {
+		return nil, 0, errTooDeep
+	}
+	d.depth++
+	defer func() { d.depth-- }()
+	control := d.buffer[offset]
+	offset++
+	dataType := uint(control >> 5)
+	if dataType == typePointer {
+		pointer, next, err := d.pointer(control, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		value, _, err := d.decode(pointer)
+		return value, next, err
+	}
+	if dataType == typeExtended {
+		if offset >= uint(len(d.buffer)) {
+			return nil, 0, errTruncated
+		}
+		dataType = uint(d.buffer[offset]) + 7
+		offset++
+	}
+	size := uint(control & 0x1F)
+	if size >= 29 {
+		extra := size - 28
+		if offset+extra > uint(len(d.buffer)) {
+			return nil, 0, errTruncated
+		}
+		value := uint(0)
+		for _, b := range d.buffer[offset : offset+extra] {
+			value = value<<8 | uint(b)
+		}
+		switch size {
+		case 29:
+			size = 29 + value
+		case 30:
+			size = 285 + value
+		default:
+			size = 65821 + value
+		}
+		offset += extra
+	}
+	return d.decodeValue(dataType, size, offset)
+}
+
+func (d *decoder) pointer(control byte, offset uint) (uint, uint, error) {
+	size := uint(control>>3)&0x3 + 1
+	if offset+size > uint(len(d.buffer)) {
+		return 0, 0, errTruncated
+	}
+	value := uint(0)
+	if size != 4 {
+		value = uint(control & 0x7)
+	}
+	for _, b := range d.buffer[offset : offset+size] {
+		value = value<<8 | uint(b)
+	}
+	switch size {
+	case 2:
+		value += 2048
+	case 3:
+		value += 526336
+	}
+	return value, offset + size, nil
+}
+
+func (d *decoder) decodeValue(dataType uint, size uint, offset uint) (any, uint, error) {
+	// у каждого элемента хотя бы управляющий байт: битый размер не раздувает память
+	if (dataType == typeMap || dataType == typeSlice) && size > uint(len(d.buffer))-offset {
+		return nil, 0, errTruncated
+	}
+	switch dataType {
+	case typeMap:
+		result := make(map[string]any, size)
+		for i := uint(0); i < size; i++ {
+			key, next, err := d.decode(offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			value, next, err := d.decode(next)
+			if err != nil {
+				return nil, 0, err
+			}
+			result[fmt.Sprint(key)] = value
+			offset = next
+		}
+		return result, offset, nil
+	case typeSlice:
+		result := make([]any, 0, size)
+		for i := uint(0); i < size; i++ {
+			value, next, err := d.decode(offset)
+			if err != nil {
+				return nil, 0, err
+			}
+			result = append(result, value)
+			offset = next
+		}
+		return result, offset, nil
+	case typeBool:
+		return size != 0, offset, nil
+	}
+	if offset+size > uint(len(d.buffer)) {
+		return nil, 0, errTruncated
+	}
+	data := d.buffer[offset : offset+size]
+	next := offset + size
+	switch dataType {
+	case typeString:
+		return string(data), next, nil
+	case typeBytes, typeUint128:
+		return append([]byte(nil), data...), next, nil
+	case typeDouble:
+		if size != 8 {
+			return nil, 0, errors.New("invalid double size")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(data)), next, nil
+	case typeFloat:
+		if size != 4 {
+			return nil, 0, errors.New("invalid float size")
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(data)), next, nil
+	case typeUint16, typeUint32, typeUint64:
+		value := uint64(0)
+		for _, b := range data {
+			value = value<<8 | uint64(b)
+		}
+		switch dataType {
+		case typeUint16:
+			return uint16(value), next, nil
+		case typeUint32:
+			return uint32(value), next, nil
+		}
+		return value, next, nil
+	case typeInt32:
+		value := uint32(0)
+		for _, b := range data {
+			value = value<<8 | uint32(b)
+		}
+		return int32(value), next, nil
+	case typeContainer, typeMarker:
+		return nil, next, nil
+	}
+	return nil, 0, fmt.Errorf("unknown data type: %d", dataType)
+}
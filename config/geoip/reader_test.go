@@ -0,0 +1,105 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func encodeString(value string) []byte {
+	return append([]byte{byte(typeString<<5 | len(value))}, value...)
+}
+
+func encodeUint16(value uint16) []byte {
+	return binary.BigEndian.AppendUint16([]byte{typeUint16<<5 | 2}, value)
+}
+
+func encodeUint32(value uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte{typeUint32<<5 | 4}, value)
+}
+
+func encodeMap(pairs ...[]byte) []byte {
+	result := []byte{byte(typeMap<<5 | len(pairs)/2)}
+	for _, pair := range pairs {
+		result = append(result, pair...)
+	}
+	return result
+}
+
+// testDatabase — IPv4-база из одного узла: адреса с нулевым первым битом
+// (0.0.0.0/1) ведут на data, остальные не найдены.
+func testDatabase(data []byte) []byte {
+	const nodeCount = 1
+	found := nodeCount + dataSectionSeparator
+	buffer := []byte{byte(found >> 16), byte(found >> 8), byte(found), 0, 0, nodeCount}
+	buffer = append(buffer, make([]byte, dataSectionSeparator)...)
+	buffer = append(buffer, data...)
+	buffer = append(buffer, metadataMarker...)
+	return append(buffer, encodeMap(
+		encodeString("node_count"), encodeUint32(nodeCount),
+		encodeString("record_size"), encodeUint16(24),
+		encodeString("ip_version"), encodeUint16(4),
+		encodeString("database_type"), encodeString("test"),
+	)...)
+}
+
+func TestCountry(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+	}{
+		{"sing-geoip", encodeString("de")},
+		{"geolite2", encodeMap(encodeString("country"), encodeMap(encodeString("iso_code"), encodeString("de")))},
+		{"registered country", encodeMap(encodeString("registered_country"), encodeMap(encodeString("iso_code"), encodeString("DE")))},
+	}
+	for _, c := range cases {
+		reader, err := FromBytes(testDatabase(c.data))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if reader.DatabaseType() != "test" {
+			t.Errorf("%s: database type = %s", c.name, reader.DatabaseType())
+		}
+		for addr, want := range map[string]string{"1.2.3.4": "DE", "::ffff:1.2.3.4": "DE", "200.1.1.1": "", "2001:db8::1": ""} {
+			country, err := reader.Country(netip.MustParseAddr(addr))
+			if err != nil || country != want {
+				t.Errorf("%s: %s = %q, %v; want %q", c.name, addr, country, err, want)
+			}
+		}
+	}
+}
+
+func TestCorruptedData(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		// указатель на самого себя раньше рекурсивно уходил в переполнение стека
+		{"self pointer", []byte{typePointer << 5, 0}, errTooDeep},
+		{"pointer loop", []byte{typePointer << 5, 2, typePointer << 5, 0}, errTooDeep},
+		{"map larger than data", []byte{typeMap<<5 | 28}, errTruncated},
+		{"short string", []byte{typeString<<5 | 10, 'd'}, errTruncated},
+	}
+	for _, c := range cases {
+		reader, err := FromBytes(testDatabase(c.data))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if _, err := reader.Country(netip.MustParseAddr("1.2.3.4")); !errors.Is(err, c.err) {
+			t.Errorf("%s: err = %v, want %v", c.name, err, c.err)
+		}
+	}
+}
+
+func TestInvalidDatabase(t *testing.T) {
+	if _, err := FromBytes([]byte("not a database")); err == nil {
+		t.Errorf("missing metadata must fail")
+	}
+	database := testDatabase(encodeString("de"))
+	if _, err := FromBytes(database[bytes.LastIndex(database, metadataMarker):]); err == nil {
+		t.Errorf("truncated tree must fail")
+	}
+}
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/json/badoption"
+
+	mDNS "github.com/miekg/dns"
+)
+
+// ParseHostsFile разбирает файл в формате /etc/hosts: «IP имя [имя...]»,
+// комментарии после #. Имена могут быть *.домен. Один домен может
+// встречаться в нескольких строках — адреса собираются вместе.
+func ParseHostsFile(reader io.Reader) (map[string][]string, error) {
+	records := make(map[string][]string)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		addr, err := netip.ParseAddr(fields[0])
+		// fe80::1%lo0 и прочие адреса с зоной в DNS-ответ не положить
+		if err != nil || addr.Zone() != "" {
+			continue
+		}
+		for _, name := range fields[1:] {
+			domain := normalizeHostsDomain(name)
+			if domain == "" {
+				continue
+			}
+			records[domain] = appendUniqueAddr(records[domain], addr.String())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func normalizeHostsDomain(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+func appendUniqueAddr(list []string, addr string) []string {
+	for _, item := range list {
+		if item == addr {
+			return list
+		}
+	}
+	return append(list, addr)
+}
+
+// loadHosts — сначала файлы по порядку, потом секция hosts. Домен из
+// более позднего источника заменяет записи целиком.
+func loadHosts(opt *RostovVPNOptions) (map[string][]netip.Addr, error) {
+	records := make(map[string][]netip.Addr)
+	for _, path := range opt.HostsFiles {
+		file, err := os.Open(os.ExpandEnv(path))
+		if err != nil {
+			return nil, fmt.Errorf("hosts file %s: %w", path, err)
+		}
+		parsed, err := ParseHostsFile(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("hosts file %s: %w", path, err)
+		}
+		for domain, ips := range parsed {
+			records[domain] = parseHostsAddrs(ips)
+		}
+	}
+	for name, ips := range opt.Hosts {
+		domain := normalizeHostsDomain(name)
+		if domain == "" || domain == "*" {
+			return nil, fmt.Errorf("hosts: invalid domain %q", name)
+		}
+		var addrs []netip.Addr
+		for _, ip := range ips {
+			addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+			if err != nil || addr.Zone() != "" {
+				return nil, fmt.Errorf("hosts[%s]: invalid address %q", name, ip)
+			}
+			addrs = appendUniqueNetipAddr(addrs, addr)
+		}
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("hosts[%s]: no addresses", name)
+		}
+		records[domain] = addrs
+	}
+	return records, nil
+}
+
+func parseHostsAddrs(ips []string) []netip.Addr {
+	var addrs []netip.Addr
+	for _, ip := range ips {
+		if addr, err := netip.ParseAddr(ip); err == nil {
+			addrs = appendUniqueNetipAddr(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+func appendUniqueNetipAddr(list []netip.Addr, addr netip.Addr) []netip.Addr {
+	addr = addr.Unmap()
+	for _, item := range list {
+		if item == addr {
+			return list
+		}
+	}
+	return append(list, addr)
+}
+
+// setHosts добавляет пользовательские hosts. Точные домены уходят в predefined
+// сервера dns-warp-hosts (там же встроенные записи), *.домен — в DNS-правила
+// с готовым ответом, отдельно для A и AAAA. Правила ставятся первыми, чтобы
+// свои записи побеждали и остальные правила, и fake-ip.
+func setHosts(options *option.Options, opt *RostovVPNOptions, report *BuildReport) error {
+	if len(opt.Hosts) == 0 && len(opt.HostsFiles) == 0 {
+		return nil
+	}
+	records, err := loadHosts(opt)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	if options.DNS == nil {
+		options.DNS = &option.DNSOptions{}
+	}
+
+	exact := make(map[string][]netip.Addr)
+	var exactDomains, wildcards []string
+	for domain, addrs := range records {
+		if strings.HasPrefix(domain, "*.") {
+			wildcards = append(wildcards, domain)
+			continue
+		}
+		exact[domain] = addrs
+		exactDomains = append(exactDomains, domain)
+	}
+	sort.Strings(exactDomains)
+	sort.Strings(wildcards)
+
+	var rules []option.DNSRule
+	if len(exactDomains) > 0 {
+		putStaticHosts(options, exact)
+		rules = append(rules, option.DNSRule{
+			Type: C.RuleTypeDefault,
+			DefaultOptions: option.DefaultDNSRule{
+				RawDefaultDNSRule: option.RawDefaultDNSRule{
+					Domain: exactDomains,
+				},
+				DNSRuleAction: option.DNSRuleAction{
+					Action: C.RuleActionTypeRoute,
+					RouteOptions: option.DNSRouteActionOptions{
+						Server:       DNSWarpHostsTag,
+						DisableCache: true,
+					},
+				},
+			},
+		})
+	}
+	for _, domain := range wildcards {
+		rules = append(rules,
+			wildcardHostsRule(domain, mDNS.TypeA, records[domain]),
+			wildcardHostsRule(domain, mDNS.TypeAAAA, records[domain]),
+		)
+	}
+	options.DNS.Rules = append(rules, options.DNS.Rules...)
+	report.DNSRules = append(repeatOrigin(newOrigin(SourceHosts, "hosts"), len(rules)), report.DNSRules...)
+	return nil
+}
+
+// wildcardHostsRule отвечает на запросы qtype к поддоменам: sing-box сам
+// подставляет имя из вопроса вместо *. Нет адресов нужного семейства —
+// пустой NOERROR, как у hosts-сервера.
+func wildcardHostsRule(domain string, qtype uint16, addrs []netip.Addr) option.DNSRule {
+	name := mDNS.Fqdn(domain)
+	var answer badoption.Listable[option.DNSRecordOptions]
+	for _, addr := range addrs {
+		header := mDNS.RR_Header{Name: name, Rrtype: qtype, Class: mDNS.ClassINET, Ttl: C.DefaultDNSTTL}
+		switch {
+		case qtype == mDNS.TypeA && addr.Is4():
+			answer = append(answer, option.DNSRecordOptions{RR: &mDNS.A{Hdr: header, A: addr.AsSlice()}})
+		case qtype == mDNS.TypeAAAA && addr.Is6():
+			answer = append(answer, option.DNSRecordOptions{RR: &mDNS.AAAA{Hdr: header, AAAA: addr.AsSlice()}})
+		}
+	}
+	rcode := option.DNSRCode(mDNS.RcodeSuccess)
+	return option.DNSRule{
+		Type: C.RuleTypeDefault,
+		DefaultOptions: option.DefaultDNSRule{
+			RawDefaultDNSRule: option.RawDefaultDNSRule{
+				// с точкой в начале — только поддомены, без самого домена
+				DomainSuffix: badoption.Listable[string]{strings.TrimPrefix(domain, "*")},
+				QueryType:    badoption.Listable[option.DNSQueryType]{option.DNSQueryType(qtype)},
+			},
+			DNSRuleAction: option.DNSRuleAction{
+				Action: C.RuleActionTypePredefined,
+				PredefinedOptions: option.DNSRouteActionPredefined{
+					Rcode:  &rcode,
+					Answer: answer,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const hostsTestFile = `# comment
+127.0.0.1   localhost
+::1         localhost ip6-localhost
+fe80::1%lo0 localhost
+10.0.0.1    nas.lan NAS.lan.   # trailing comment
+10.0.0.2    nas.lan
+fd00::1     nas.lan
+10.0.0.9    *.dev.lan
+broken      example.com
+`
+
+func TestParseHostsFile(t *testing.T) {
+	records, err := ParseHostsFile(strings.NewReader(hostsTestFile))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got := strings.Join(records["nas.lan"], ","); got != "10.0.0.1,10.0.0.2,fd00::1" {
+		t.Errorf("nas.lan = %s", got)
+	}
+	if got := strings.Join(records["localhost"], ","); got != "127.0.0.1,::1" {
+		t.Errorf("localhost = %s", got)
+	}
+	if got := strings.Join(records["*.dev.lan"], ","); got != "10.0.0.9" {
+		t.Errorf("*.dev.lan = %s", got)
+	}
+	if _, ok := records["example.com"]; ok {
+		t.Errorf("line with invalid address must be skipped")
+	}
+}
+
+func TestBuildConfigHosts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts")
+	if err := os.WriteFile(path, []byte(hostsTestFile), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	opt := DefaultRostovVPNOptions()
+	opt.HostsFiles = []string{path}
+	opt.Hosts = map[string][]string{
+		"nas.lan":     {"192.168.1.5"},
+		"*.corp.test": {"192.168.1.10", "192.168.1.11", "fd00::10"},
+	}
+	options, err := BuildConfig(*opt, option.Options{})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	var predefined map[string][]netip.Addr
+	for _, server := range options.DNS.Servers {
+		if hosts, ok := server.Options.(*option.HostsDNSServerOptions); ok && server.Tag == DNSWarpHostsTag {
+			predefined = make(map[string][]netip.Addr)
+			for _, entry := range hosts.Predefined.Entries() {
+				predefined[entry.Key] = entry.Value
+			}
+		}
+	}
+	if got := predefined["nas.lan"]; len(got) != 1 || got[0].String() != "192.168.1.5" {
+		t.Errorf("hosts section must override file: nas.lan = %v", got)
+	}
+	if _, ok := predefined["sky.rethinkdns.com"]; !ok {
+		t.Errorf("builtin records must be kept")
+	}
+
+	rules := options.DNS.Rules
+	if len(rules) < 5 {
+		t.Fatalf("rules: %d", len(rules))
+	}
+	first := rules[0].DefaultOptions
+	if first.RouteOptions.Server != DNSWarpHostsTag || !strings.Contains(strings.Join(first.Domain, ","), "nas.lan") {
+		t.Errorf("first rule must route exact hosts: %+v", first)
+	}
+	// *.corp.test и *.dev.lan — по правилу на A и AAAA
+	var answers []string
+	for _, rule := range rules[1:5] {
+		if rule.DefaultOptions.Action != C.RuleActionTypePredefined {
+			t.Fatalf("expected predefined rule, got %+v", rule.DefaultOptions)
+		}
+		for _, record := range rule.DefaultOptions.PredefinedOptions.Answer {
+			answers = append(answers, record.RR.String())
+		}
+	}
+	joined := strings.Join(answers, "\n")
+	for _, want := range []string{"*.corp.test.\t600\tIN\tA\t192.168.1.11", "*.corp.test.\t600\tIN\tAAAA\tfd00::10", "*.dev.lan.\t600\tIN\tA\t10.0.0.9"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("missing answer %q in\n%s", want, joined)
+		}
+	}
+
+	opt.Hosts = map[string][]string{"bad.lan": {"not-an-ip"}}
+	if _, err := BuildConfig(*opt, option.Options{}); err == nil {
+		t.Errorf("expected error for invalid address")
+	}
+}
@@ -5,14 +5,17 @@ import (
 	"fmt"
 
 	option "github.com/sagernet/sing-box/option"
+	singjson "github.com/sagernet/sing/common/json"
 )
 
 // outboundMap is a helper alias for working with JSON objects that represent
 // sing-box outbound definitions.
 type outboundMap map[string]any
 
+// marshalToMap и mapToStruct идут через singjson с контекстом реестров:
+// обычный encoding/json теряет Options у аутбаундов, инбаундов и DNS-серверов.
 func marshalToMap(value any) (outboundMap, error) {
-	data, err := json.Marshal(value)
+	data, err := singjson.MarshalContext(decodeContext(), value)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +31,7 @@ func mapToStruct(obj outboundMap, target any) error {
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(data, target)
+	return singjson.UnmarshalContext(decodeContext(), data, target)
 }
 
 func (m outboundMap) clone() outboundMap {
@@ -148,7 +151,7 @@ func (m outboundMap) set(keys []string, value any) error {
 }
 
 func outboundToMap(out option.Outbound) (outboundMap, error) {
-	return marshalToMap(out)
+	return marshalToMap(&out)
 }
 
 func mapToOutbound(obj outboundMap) (option.Outbound, error) {
@@ -138,7 +138,7 @@ func patchOutbound(base option.Outbound, configOpt RostovVPNOptions, staticIPs m
 	if err != nil {
 		return nil, "", formatErr(err)
 	}
-	obj, err = patchWarpMap(obj, &configOpt, true, staticIPs)
+	obj, err = patchWarpMap(obj, &configOpt, true, staticIPs, nil)
 	if err != nil {
 		return nil, "", formatErr(err)
 	}
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/balancer"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/json/badoption"
+)
+
+const (
+	GroupTypeSelector    = "selector"
+	GroupTypeURLTest     = "urltest"
+	GroupTypeFallback    = "fallback"
+	GroupTypeLoadBalance = "load-balance"
+)
+
+// OutboundGroupOptions описывает пользовательскую группу аутбаундов.
+// Участники отбираются по регулярке тега, протоколу и стране; пустой фильтр
+// не ограничивает выборку, несколько фильтров объединяются через «и».
+type OutboundGroupOptions struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	TagRegex  string   `json:"tag-regex,omitempty"`
+	Protocols []string `json:"protocols,omitempty"`
+	Countries []string `json:"countries,omitempty"`
+	// только для load-balance
+	Strategy  string            `json:"strategy,omitempty"`
+	Sticky    bool              `json:"sticky,omitempty"`
+	StickyTTL DurationInSeconds `json:"sticky-ttl,omitempty"`
+}
+
+func (opt *RostovVPNOptions) findOutboundGroup(name string) *OutboundGroupOptions {
+	if name == "" {
+		return nil
+	}
+	for i := range opt.OutboundGroups {
+		if opt.OutboundGroups[i].Name == name {
+			return &opt.OutboundGroups[i]
+		}
+	}
+	return nil
+}
+
+func (g *OutboundGroupOptions) matcher(countries map[string]string) (func(outbound option.Outbound) bool, error) {
+	var tagRegex *regexp.Regexp
+	if g.TagRegex != "" {
+		var err error
+		tagRegex, err = regexp.Compile(g.TagRegex)
+		if err != nil {
+			return nil, fmt.Errorf("group %s: invalid tag-regex: %w", g.Name, err)
+		}
+	}
+	return func(outbound option.Outbound) bool {
+		if tagRegex != nil && !tagRegex.MatchString(outbound.Tag) {
+			return false
+		}
+		if len(g.Protocols) > 0 && !containsFold(g.Protocols, outbound.Type) {
+			return false
+		}
+		if len(g.Countries) > 0 && !containsFold(g.Countries, OutboundCountry(countries, outbound.Tag)) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// buildOutboundGroups собирает пользовательские группы поверх прокси-аутбаундов.
+// Группа без участников ведёт в auto, чтобы правила на неё оставались валидными
+// (главный селектор сам содержит группы, ссылка на него дала бы цикл).
+func buildOutboundGroups(opt *RostovVPNOptions, proxies []option.Outbound, countries map[string]string, reserved map[string]bool) ([]option.Outbound, error) {
+	var groups []option.Outbound
+	for _, group := range opt.OutboundGroups {
+		if group.Name == "" {
+			return nil, fmt.Errorf("outbound group without name")
+		}
+		if reserved[group.Name] {
+			return nil, fmt.Errorf("group %s: tag already in use", group.Name)
+		}
+		reserved[group.Name] = true
+
+		match, err := group.matcher(countries)
+		if err != nil {
+			return nil, err
+		}
+		var members []string
+		for _, outbound := range proxies {
+			// скрытые (в т.ч. служебные копии Warp-режима) в группы не попадают, как и в главный селектор
+			if strings.Contains(strings.ToLower(outbound.Tag), "hide") || outbound.Tag == OutboundWarpTag {
+				continue
+			}
+			if match(outbound) {
+				members = append(members, outbound.Tag)
+			}
+		}
+		if len(members) == 0 {
+			members = []string{OutboundURLTestTag}
+		}
+
+		switch strings.ToLower(group.Type) {
+		case GroupTypeSelector, "":
+			groups = append(groups, option.Outbound{
+				Type: C.TypeSelector,
+				Tag:  group.Name,
+				Options: &option.SelectorOutboundOptions{
+					Outbounds:                 members,
+					Default:                   members[0],
+					InterruptExistConnections: true,
+				},
+			})
+		case GroupTypeURLTest:
+			groups = append(groups, newURLTestGroup(group.Name, members, opt))
+		case GroupTypeFallback:
+			groups = append(groups, option.Outbound{
+				Type: balancer.TypeFallback,
+				Tag:  group.Name,
+				Options: &balancer.Options{
+					Outbounds:   members,
+					URL:         urlTestURL(opt),
+					Interval:    badoption.Duration(opt.URLTestInterval.Duration()),
+					IdleTimeout: badoption.Duration(opt.URLTestInterval.Duration() * 3),
+				},
+			})
+		case GroupTypeLoadBalance:
+			groups = append(groups, option.Outbound{
+				Type: balancer.TypeLoadBalance,
+				Tag:  group.Name,
+				Options: &balancer.Options{
+					Outbounds:   members,
+					Strategy:    group.Strategy,
+					Sticky:      group.Sticky,
+					StickyTTL:   badoption.Duration(group.StickyTTL.Duration()),
+					URL:         urlTestURL(opt),
+					Interval:    badoption.Duration(opt.URLTestInterval.Duration()),
+					IdleTimeout: badoption.Duration(opt.URLTestInterval.Duration() * 3),
+				},
+			})
+		default:
+			return nil, fmt.Errorf("group %s: unsupported type %q", group.Name, group.Type)
+		}
+	}
+	return groups, nil
+}
+
+func newURLTestGroup(tag string, members []string, opt *RostovVPNOptions) option.Outbound {
+	return option.Outbound{
+		Type: C.TypeURLTest,
+		Tag:  tag,
+		Options: &option.URLTestOutboundOptions{
+			Outbounds:                 members,
+			URL:                       urlTestURL(opt),
+			Interval:                  badoption.Duration(opt.URLTestInterval.Duration()),
+			Tolerance:                 1,
+			IdleTimeout:               badoption.Duration(opt.URLTestInterval.Duration() * 3),
+			InterruptExistConnections: true,
+		},
+	}
+}
+
+func urlTestURL(opt *RostovVPNOptions) string {
+	url := opt.ConnectionTestUrl
+	if strings.HasPrefix(url, "http://cp.cloudflare.com") || url == "" {
+		url = TraceURL
+	}
+	return url
+}
+
+func containsFold(list []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, item := range list {
+		if strings.EqualFold(strings.TrimSpace(item), value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/balancer"
+	"github.com/Darkmen203/rostovvpn-core/protocol/blocklist"
+	"github.com/Darkmen203/rostovvpn-core/protocol/dnsgroup"
+	"github.com/Darkmen203/rostovvpn-core/protocol/fakeip"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/adapter/outbound"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/service"
+)
+
+// в приложении типы регистрирует v2; тестам config нужны те же группы
+func init() {
+	RegisterTypes(func(ctx context.Context) {
+		if registry, ok := service.FromContext[adapter.OutboundRegistry](ctx).(*outbound.Registry); ok {
+			balancer.Register(registry)
+			blocklist.RegisterOutbound(registry)
+		}
+		if registry, ok := service.FromContext[adapter.DNSTransportRegistry](ctx).(*dns.TransportRegistry); ok {
+			dnsgroup.Register(registry)
+			fakeip.Register(registry)
+			blocklist.RegisterTransport(registry)
+		}
+	})
+}
+
+func TestBuildOutboundGroups(t *testing.T) {
+	opt := DefaultRostovVPNOptions()
+	opt.OutboundGroups = []OutboundGroupOptions{
+		{Name: "backup", Type: GroupTypeFallback, TagRegex: "^(main|spare)$"},
+		{Name: "fast", Type: GroupTypeURLTest, Protocols: []string{C.TypeTrojan}},
+		{Name: "empty", Type: GroupTypeSelector, Countries: []string{"zz"}},
+	}
+	proxies := []option.Outbound{
+		{Type: C.TypeSOCKS, Tag: "main"},
+		{Type: C.TypeTrojan, Tag: "other"},
+		{Type: C.TypeSOCKS, Tag: "spare"},
+		{Type: C.TypeSOCKS, Tag: "spare-hide"},
+	}
+	groups, err := buildOutboundGroups(opt, proxies, nil, map[string]bool{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("groups: %+v", groups)
+	}
+
+	fallback, ok := groups[0].Options.(*balancer.Options)
+	if groups[0].Type != balancer.TypeFallback || !ok {
+		t.Fatalf("fallback group: %s %T", groups[0].Type, groups[0].Options)
+	}
+	// порядок участников — приоритет fallback
+	if len(fallback.Outbounds) != 2 || fallback.Outbounds[0] != "main" || fallback.Outbounds[1] != "spare" {
+		t.Errorf("fallback members: %v", fallback.Outbounds)
+	}
+	if urltest, ok := groups[1].Options.(*option.URLTestOutboundOptions); !ok || len(urltest.Outbounds) != 1 || urltest.Outbounds[0] != "other" {
+		t.Errorf("urltest group: %+v", groups[1].Options)
+	}
+	if selector := groups[2].Options.(*option.SelectorOutboundOptions); selector.Outbounds[0] != OutboundURLTestTag {
+		t.Errorf("empty group must lead to auto: %v", selector.Outbounds)
+	}
+
+	if _, err := buildOutboundGroups(opt, proxies, nil, map[string]bool{"backup": true}); err == nil {
+		t.Errorf("reserved tag must fail")
+	}
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	ParseFormatSingbox   = "sing-box"
+	ParseFormatWireGuard = "wireguard"
+	ParseFormatV2ray     = "v2ray"
+	ParseFormatClash     = "clash"
+	ParseFormatSIP008    = "sip008"
+	ParseFormatXray      = "xray"
+
+	parseEntryContentLimit = 120
+)
+
+// ParseReport — что удалось разобрать из профиля. Отклонённые записи не валят
+// весь профиль: подписка с парой битых ссылок загружается без них.
+type ParseReport struct {
+	Format   string       `json:"format"`
+	Accepted []ParseEntry `json:"accepted"`
+	Rejected []ParseEntry `json:"rejected"`
+	Filtered []ParseEntry `json:"filtered,omitempty"` // отброшены фильтрами профиля
+	Warnings []ParseEntry `json:"warnings,omitempty"` // приняты, но часть полей потеряна
+	// правила и группы из того же Clash-профиля; применяются только по запросу
+	Rules *RuleImport `json:"rules,omitempty"`
+}
+
+type ParseEntry struct {
+	Line    int    `json:"line,omitempty"` // номер строки в исходном тексте, 0 — неизвестен
+	Tag     string `json:"tag,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Content string `json:"content,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (r *ParseReport) reject(line int, content string, tag string, outboundType string, reason error) {
+	if len(content) > parseEntryContentLimit {
+		content = content[:parseEntryContentLimit] + "..."
+	}
+	r.Rejected = append(r.Rejected, ParseEntry{
+		Line:    line,
+		Tag:     tag,
+		Type:    outboundType,
+		Content: content,
+		Reason:  reason.Error(),
+	})
+}
+
+// warn отмечает принятую запись, у которой ядро не поддерживает часть полей.
+func (r *ParseReport) warn(line int, tag string, outboundType string, reason string) {
+	r.Warnings = append(r.Warnings, ParseEntry{
+		Line:   line,
+		Tag:    tag,
+		Type:   outboundType,
+		Reason: reason,
+	})
+}
+
+func (r *ParseReport) acceptOutbounds(outbounds []any, lines map[string]int) {
+	r.Accepted = nil
+	for _, raw := range outbounds {
+		outbound, _ := raw.(map[string]any)
+		tag, _ := outbound["tag"].(string)
+		outboundType, _ := outbound["type"].(string)
+		r.Accepted = append(r.Accepted, ParseEntry{Line: lines[tag], Tag: tag, Type: outboundType})
+	}
+}
+
+func (e ParseEntry) summary() string {
+	var summary string
+	if e.Line > 0 {
+		summary += "line " + strconv.Itoa(e.Line) + ": "
+	}
+	if e.Tag != "" {
+		summary += e.Tag + ": "
+	}
+	return summary + e.Reason
+}
+
+// Summary — короткая строка для логов и CLI.
+func (r *ParseReport) Summary() string {
+	summary := fmt.Sprintf("format: %s, accepted: %d, rejected: %d", r.Format, len(r.Accepted), len(r.Rejected))
+	if len(r.Filtered) > 0 {
+		summary += fmt.Sprintf(", filtered: %d", len(r.Filtered))
+	}
+	for _, entry := range r.Rejected {
+		summary += "\n  " + entry.summary()
+	}
+	for _, entry := range r.Warnings {
+		summary += "\n  warning: " + entry.summary()
+	}
+	if r.Rules != nil {
+		summary += fmt.Sprintf("\nrules: %d, groups: %d, chains: %d (not applied, see import-rules)",
+			len(r.Rules.Rules), len(r.Rules.OutboundGroups), len(r.Rules.Chains))
+	}
+	return summary
+}
+
+// removeOutboundReferences убирает тег из списков selector/urltest,
+// чтобы после отбраковки outbound'а группы не ссылались на пустоту.
+func removeOutboundReferences(outbounds []any, tag string) {
+	for _, raw := range outbounds {
+		outbound, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		members, ok := outbound["outbounds"].([]any)
+		if !ok {
+			continue
+		}
+		kept := members[:0]
+		for _, member := range members {
+			if member != tag {
+				kept = append(kept, member)
+			}
+		}
+		outbound["outbounds"] = kept
+		if outbound["default"] == tag {
+			delete(outbound, "default")
+		}
+	}
+}
+
+// decodeSubscriptionBody раскрывает base64-тело подписки так же, как ray2sing.
+func decodeSubscriptionBody(content string) string {
+	trimmed := strings.TrimSpace(content)
+	if strings.Contains(trimmed, "://") {
+		return content
+	}
+	padded := trimmed
+	if padding := len(padded) % 4; padding != 0 {
+		padded += strings.Repeat("=", 4-padding)
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding} {
+		if decoded, err := encoding.DecodeString(padded); err == nil {
+			return string(decoded)
+		}
+	}
+	return content
+}
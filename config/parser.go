@@ -3,10 +3,13 @@ package config
 import (
 	"bytes"
 	_ "embed"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/Darkmen203/ray2sing/ray2sing"
@@ -15,6 +18,7 @@ import (
 	SJ "github.com/sagernet/sing/common/json"
 	"github.com/xmdhs/clash2singbox/convert"
 	"github.com/xmdhs/clash2singbox/model/clash"
+	"github.com/xmdhs/clash2singbox/model/singbox"
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,6 +34,15 @@ func ParseConfig(path string, debug bool) ([]byte, error) {
 	return ParseConfigContent(string(content), debug, nil, false)
 }
 
+func ParseConfigWithReport(path string, debug bool, configOpt *RostovVPNOptions) ([]byte, *ParseReport, error) {
+	content, err := os.ReadFile(path)
+	os.Chdir(filepath.Dir(path))
+	if err != nil {
+		return nil, nil, err
+	}
+	return ParseConfigContentWithReport(string(content), debug, configOpt, false)
+}
+
 func ParseConfigContentToOptions(contentstr string, debug bool, configOpt *RostovVPNOptions, fullConfig bool) (*option.Options, error) {
 	content, err := ParseConfigContent(contentstr, debug, configOpt, fullConfig)
 	if err != nil {
@@ -44,11 +57,27 @@ func ParseConfigContentToOptions(contentstr string, debug bool, configOpt *Rosto
 }
 
 func ParseConfigContent(contentstr string, debug bool, configOpt *RostovVPNOptions, fullConfig bool) ([]byte, error) {
+	content, _, err := ParseConfigContentWithReport(contentstr, debug, configOpt, fullConfig)
+	return content, err
+}
+
+// ParseConfigContentWithReport — то же, что ParseConfigContent, плюс отчёт:
+// формат, принятые outbound'ы и отклонённые записи с номерами строк и причинами.
+func ParseConfigContentWithReport(contentstr string, debug bool, configOpt *RostovVPNOptions, fullConfig bool) ([]byte, *ParseReport, error) {
 	// fmt.Print("\n[config.ParseConfigContent] !!! configOpt= \n", configOpt, ",\n  !!! [config.ParseConfigContent] \n")
 	
 	if configOpt == nil {
 		configOpt = DefaultRostovVPNOptions()
 	}
+	report := &ParseReport{}
+	outlineName := ""
+	if isOutlineAccessKey(contentstr) {
+		body, name, err := FetchOutlineAccessKey(contentstr)
+		if err != nil {
+			return nil, report, fmt.Errorf("[OutlineParser] %w", err)
+		}
+		contentstr, outlineName = body, name
+	}
 	content := []byte(contentstr)
 	var jsonObj map[string]interface{} = make(map[string]interface{})
 
@@ -56,6 +85,33 @@ func ParseConfigContent(contentstr string, debug bool, configOpt *RostovVPNOptio
 	var tmpJsonResult any
 	jsonDecoder := json.NewDecoder(SJ.NewCommentFilter(bytes.NewReader(content)))
 	if err := jsonDecoder.Decode(&tmpJsonResult); err == nil {
+		if tmpJsonObj, ok := tmpJsonResult.(map[string]interface{}); ok && isSIP008Config(tmpJsonObj) {
+			report.Format = ParseFormatSIP008
+			rawJson, _ := json.Marshal(tmpJsonObj)
+			sip008, err := ParseSIP008Config(rawJson)
+			if err != nil {
+				return nil, report, fmt.Errorf("[SIP008Parser] %w", err)
+			}
+			outbounds := sip008.ToOutbounds(outlineName, report)
+			if len(outbounds) == 0 {
+				return nil, report, fmt.Errorf("[SIP008Parser] no outbounds found")
+			}
+			newContent, _ := json.MarshalIndent(map[string]any{"outbounds": outbounds}, "", "  ")
+			return patchConfig(newContent, "SIP008Parser", configOpt, report, nil)
+		}
+		if tmpJsonObj, ok := tmpJsonResult.(map[string]interface{}); ok && isXrayConfig(tmpJsonObj) {
+			report.Format = ParseFormatXray
+			rawJson, _ := json.Marshal(tmpJsonObj)
+			outbounds, err := XrayToOutbounds(rawJson, report)
+			if err != nil {
+				return nil, report, fmt.Errorf("[XrayParser] %w", err)
+			}
+			if len(outbounds) == 0 {
+				return nil, report, fmt.Errorf("[XrayParser] no outbounds found")
+			}
+			newContent, _ := json.MarshalIndent(map[string]any{"outbounds": outbounds}, "", "  ")
+			return patchConfig(newContent, "XrayParser", configOpt, report, nil)
+		}
 		if tmpJsonObj, ok := tmpJsonResult.(map[string]interface{}); ok {
 			if tmpJsonObj["outbounds"] == nil {
 				jsonObj["outbounds"] = []interface{}{jsonObj}
@@ -75,51 +131,193 @@ func ParseConfigContent(contentstr string, debug bool, configOpt *RostovVPNOptio
 		} else if jsonArray, ok := tmpJsonResult.([]interface{}); ok {
 			jsonObj["outbounds"] = jsonArray
 		} else {
-			return nil, fmt.Errorf("[SingboxParser] Incorrect Json Format")
+			return nil, report, fmt.Errorf("[SingboxParser] Incorrect Json Format")
 		}
 
 		newContent, _ := json.MarshalIndent(jsonObj, "", "  ")
 
-		return patchConfig(newContent, "SingboxParser", configOpt)
+		report.Format = ParseFormatSingbox
+		return patchConfig(newContent, "SingboxParser", configOpt, report, nil)
+	}
+
+	if isWireGuardConfig(contentstr) {
+		report.Format = ParseFormatWireGuard
+		wgConfig, err := ParseWireGuardConfig(contentstr)
+		if err != nil {
+			return nil, report, fmt.Errorf("[WireGuardParser] %w", err)
+		}
+		endpoint, err := wgConfig.ToEndpoint("")
+		if err != nil {
+			return nil, report, fmt.Errorf("[WireGuardParser] %w", err)
+		}
+		newContent, _ := json.MarshalIndent(map[string]any{"endpoints": []any{endpoint}}, "", "  ")
+		return patchConfig(newContent, "WireGuardParser", configOpt, report, nil)
 	}
 
-	v2rayStr, err := ray2sing.Ray2Singbox(string(content), configOpt.UseXrayCoreWhenPossible)
-	if err == nil {
-		return patchConfig([]byte(v2rayStr), "V2rayParser", configOpt)
+	if v2rayContent, lines, rejected := parseShareLinks(contentstr, configOpt); v2rayContent != "" {
+		report.Format = ParseFormatV2ray
+		report.Rejected = rejected
+		return patchConfig([]byte(v2rayContent), "V2rayParser", configOpt, report, lines)
 	}
 	// fmt.Printf("Convert using clash\n")
 	clashObj := clash.Clash{}
 	if err := yaml.Unmarshal(content, &clashObj); err == nil && clashObj.Proxies != nil {
+		report.Format = ParseFormatClash
 		if len(clashObj.Proxies) == 0 {
-			return nil, fmt.Errorf("[ClashParser] no outbounds found")
+			return nil, report, fmt.Errorf("[ClashParser] no outbounds found")
 		}
-		converted, err := convert.Clash2sing(clashObj)
-		if err != nil {
-			return nil, fmt.Errorf("[ClashParser] converting clash to sing-box error: %w", err)
+		converted, lines := convertClashProxies(content, clashObj, report)
+		if rules, err := importClashRules(content); err == nil {
+			report.Rules = rules
+		}
+		if len(converted) == 0 {
+			return nil, report, fmt.Errorf("[ClashParser] converting clash to sing-box error: no valid proxies")
 		}
 		output := configByte
 		output, err = convert.Patch(output, converted, "", "", nil)
 		if err != nil {
-			return nil, fmt.Errorf("[ClashParser] patching clash config error: %w", err)
+			return nil, report, fmt.Errorf("[ClashParser] patching clash config error: %w", err)
+		}
+		return patchConfig(output, "ClashParser", configOpt, report, lines)
+	}
+
+	return nil, report, fmt.Errorf("unable to determine config format")
+}
+
+// parseShareLinks разбирает подписку построчно: каждая строка один раз
+// конвертируется ray2sing, битые попадают в отчёт. Нумерация тегов " § N"
+// сквозная, как при конвертации всей подписки одним вызовом.
+func parseShareLinks(contentstr string, configOpt *RostovVPNOptions) (string, map[string]int, []ParseEntry) {
+	body := strings.ReplaceAll(decodeSubscriptionBody(contentstr), "\r\n", "\n")
+	var (
+		outbounds []map[string]any
+		rejected  ParseReport
+	)
+	lines := make(map[string]int)
+	for i, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		// те же пропуски, что и в ray2sing
+		if len(line) < 5 || line[0] == '#' || line[0] == '/' {
+			continue
+		}
+		if !strings.Contains(line, "://") {
+			rejected.reject(i+1, line, "", "", fmt.Errorf("not a share link"))
+			continue
+		}
+		line = normalizeShadowsocksLink(line)
+		converted, err := ray2sing.Ray2Singbox(line, configOpt.UseXrayCoreWhenPossible)
+		var single struct {
+			Outbounds []map[string]any `json:"outbounds"`
+		}
+		if err == nil {
+			decoder := json.NewDecoder(strings.NewReader(converted))
+			decoder.UseNumber()
+			if err = decoder.Decode(&single); err == nil && len(single.Outbounds) == 0 {
+				err = fmt.Errorf("no outbounds found")
+			}
+		}
+		if err != nil {
+			rejected.reject(i+1, line, "", "", err)
+			continue
+		}
+		// строка с цепочкой "&&detour=" даёт несколько outbound'ов подряд
+		renumberShareLinkTags(single.Outbounds, len(outbounds))
+		for _, outbound := range single.Outbounds {
+			tag, _ := outbound["tag"].(string)
+			lines[tag] = i + 1
+		}
+		outbounds = append(outbounds, single.Outbounds...)
+	}
+	if len(outbounds) == 0 {
+		return "", nil, nil
+	}
+	content, err := json.MarshalIndent(map[string]any{"outbounds": outbounds}, "", "  ")
+	if err != nil {
+		return "", nil, nil
+	}
+	return string(content), lines, rejected.Rejected
+}
+
+// renumberShareLinkTags сдвигает номера " § N" в тегах одной строки на offset
+// и переписывает detour'ы цепочки на новые теги.
+func renumberShareLinkTags(outbounds []map[string]any, offset int) {
+	renamed := make(map[string]string, len(outbounds))
+	for i, outbound := range outbounds {
+		tag, _ := outbound["tag"].(string)
+		index := strings.LastIndex(tag, " § ")
+		if index < 0 {
+			continue
+		}
+		newTag := tag[:index] + " § " + strconv.Itoa(offset+i)
+		renamed[tag] = newTag
+		outbound["tag"] = newTag
+	}
+	for _, outbound := range outbounds {
+		if detour, ok := outbound["detour"].(string); ok && renamed[detour] != "" {
+			outbound["detour"] = renamed[detour]
 		}
-		return patchConfig(output, "ClashParser", configOpt)
+		if warp, ok := outbound["warp"].(map[string]any); ok {
+			if detour, ok := warp["detour"].(string); ok && renamed[detour] != "" {
+				warp["detour"] = renamed[detour]
+			}
+		}
+	}
+}
+
+// normalizeShadowsocksLink раскрывает userinfo SIP002-ссылки в URL-safe base64:
+// ray2sing понимает только обычный base64 или открытый "method:password".
+func normalizeShadowsocksLink(line string) string {
+	if !strings.HasPrefix(strings.ToLower(line), "ss://") {
+		return line
+	}
+	userInfo, rest, found := strings.Cut(line[len("ss://"):], "@")
+	if !found || strings.Contains(userInfo, ":") {
+		return line
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(userInfo, "="))
+	if err != nil {
+		return line
+	}
+	method, password, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return line
 	}
+	return "ss://" + url.UserPassword(method, password).String() + "@" + rest
+}
 
-	return nil, fmt.Errorf("unable to determine config format")
+// convertClashProxies конвертирует прокси по одному: неподдерживаемый тип или
+// битое поле отбрасывает только этот прокси. Номера строк — из узлов YAML.
+func convertClashProxies(content []byte, clashObj clash.Clash, report *ParseReport) ([]singbox.SingBoxOut, map[string]int) {
+	proxyLines := yamlSequenceLines(content, "proxies")
+	var converted []singbox.SingBoxOut
+	lines := make(map[string]int)
+	for i, proxy := range clashObj.Proxies {
+		outbounds, err := convert.Clash2sing(clash.Clash{Proxies: []clash.Proxies{proxy}})
+		if err != nil {
+			report.reject(lineAt(proxyLines, i), "", proxy.Name, proxy.Type, err)
+			continue
+		}
+		for _, outbound := range outbounds {
+			lines[outbound.Tag] = lineAt(proxyLines, i)
+		}
+		converted = append(converted, outbounds...)
+	}
+	return converted, lines
 }
 
-func patchConfig(content []byte, name string, configOpt *RostovVPNOptions) ([]byte, error) {
+func patchConfig(content []byte, name string, configOpt *RostovVPNOptions, report *ParseReport, lines map[string]int) ([]byte, *ParseReport, error) {
 	// 1) Разбираем как обычную JSON-карту (без реестров/типов)
 	var root any
 	dec := json.NewDecoder(SJ.NewCommentFilter(bytes.NewReader(content)))
 	if err := dec.Decode(&root); err != nil {
-		return nil, fmt.Errorf("[SingboxParser] json decode error: %w", err)
+		return nil, report, fmt.Errorf("[SingboxParser] json decode error: %w", err)
 	}
 	obj, ok := root.(map[string]any)
 	if !ok {
-		return nil, fmt.Errorf("[SingboxParser] root must be JSON object")
+		return nil, report, fmt.Errorf("[SingboxParser] root must be JSON object")
 	}
 	// 2) Достаём outbounds и патчим каждый как map[string]any
+	accounts := &warpAccounts{}
 	rawOuts, ok := obj["outbounds"].([]any)
 	if !ok {
 		// может быть отсутствует (тогда ничего патчить не надо)
@@ -139,24 +337,139 @@ func patchConfig(content []byte, name string, configOpt *RostovVPNOptions) ([]by
 		}
 
 		// 3.2. Твой текущий патч (warp и т.д.)
-		patched, err := patchWarpMap(outboundMap(m), configOpt, false, nil)
+		patched, err := patchWarpMap(outboundMap(m), configOpt, false, nil, accounts)
 		if err != nil {
-			return nil, fmt.Errorf("[Warp] patch warp error: %w", err)
+			return nil, report, fmt.Errorf("[Warp] patch warp error: %w", err)
 		}
 		rawOuts[i] = map[string]any(patched)
 	}
 	obj["outbounds"] = rawOuts
 
 DUMP_AND_VALIDATE:
-	content, _ = json.MarshalIndent(obj, "", "  ")
-	// fmt.Printf("%s\n", content)
-	return validateResult(content, name)
+	if err := applyProfileFilter(obj, configOpt, report, lines); err != nil {
+		return nil, report, fmt.Errorf("[%s] profile filter: %w", name, err)
+	}
+	content, err := validateResult(obj, name, report, lines)
+	return content, report, err
 }
 
-func validateResult(content []byte, name string) ([]byte, error) {
-	err := libbox.CheckConfig(string(content))
+// checkConfig — проверка sing-box конфига, подменяется в тестах.
+var checkConfig = libbox.CheckConfig
+
+// validateResult проверяет конфиг через libbox одним вызовом. Только если он
+// не прошёл, outbound'ы делятся пополам до битых записей: они уходят в
+// report.Rejected, и одна битая запись не валит весь профиль.
+func validateResult(obj map[string]any, name string, report *ParseReport, lines map[string]int) ([]byte, error) {
+	content, _ := json.MarshalIndent(obj, "", "  ")
+	err := checkConfig(string(content))
+	rawOuts, _ := obj["outbounds"].([]any)
 	if err != nil {
-		return nil, fmt.Errorf("[%s] invalid sing-box config: %w", name, err)
+		invalid := invalidOutbounds(obj, rawOuts, err)
+		if len(invalid) == 0 {
+			obj["outbounds"] = rawOuts
+			return nil, fmt.Errorf("[%s] invalid sing-box config: %w", name, err)
+		}
+		kept := make([]any, 0, len(rawOuts)-len(invalid))
+		var removed []string
+		for i, raw := range rawOuts {
+			failedErr, failed := invalid[i]
+			if !failed {
+				kept = append(kept, raw)
+				continue
+			}
+			outbound, _ := raw.(map[string]any)
+			tag, _ := outbound["tag"].(string)
+			outboundType, _ := outbound["type"].(string)
+			report.reject(lines[tag], "", tag, outboundType, failedErr)
+			removed = append(removed, tag)
+		}
+		for _, tag := range removed {
+			removeOutboundReferences(kept, tag)
+		}
+		rawOuts = kept
+		obj["outbounds"] = rawOuts
+		if len(rawOuts) == 0 {
+			return nil, fmt.Errorf("[%s] no valid outbounds: %w", name, err)
+		}
+		content, _ = json.MarshalIndent(obj, "", "  ")
+		if err = checkConfig(string(content)); err != nil {
+			return nil, fmt.Errorf("[%s] invalid sing-box config: %w", name, err)
+		}
 	}
+	// эндпоинты (wireguard из .conf) — такие же серверы профиля
+	rawEndpoints, _ := obj["endpoints"].([]any)
+	report.acceptOutbounds(append(rawOuts[:len(rawOuts):len(rawOuts)], rawEndpoints...), lines)
 	return content, nil
 }
+
+// invalidOutbounds ищет outbound'ы, на которых падает libbox: список, не
+// прошедший проверку с ошибкой err, делится пополам, и проверяются половины,
+// пока не останутся одиночные записи. Пусто — дело не в outbound'ах.
+// obj["outbounds"] при этом перезаписывается.
+func invalidOutbounds(obj map[string]any, outbounds []any, err error) map[int]error {
+	invalid := make(map[int]error)
+	tags := make(map[string]bool)
+	for _, raw := range outbounds {
+		if outbound, ok := raw.(map[string]any); ok {
+			if tag, _ := outbound["tag"].(string); tag != "" {
+				tags[tag] = true
+			}
+		}
+	}
+	var bisect func(offset int, part []any, err error)
+	bisect = func(offset int, part []any, err error) {
+		if len(part) == 1 {
+			invalid[offset] = err
+			return
+		}
+		middle := len(part) / 2
+		for _, half := range []struct {
+			offset int
+			part   []any
+		}{{offset, part[:middle]}, {offset + middle, part[middle:]}} {
+			obj["outbounds"] = withReferenceStubs(half.part, tags)
+			content, _ := json.Marshal(obj)
+			if err := checkConfig(string(content)); err != nil {
+				bisect(half.offset, half.part, err)
+			}
+		}
+	}
+	// конфиг без outbound'ов тоже не проходит — ошибка не в них
+	obj["outbounds"] = []any{}
+	content, _ := json.Marshal(obj)
+	if checkConfig(string(content)) == nil && len(outbounds) > 0 {
+		bisect(0, outbounds, err)
+	}
+	return invalid
+}
+
+// withReferenceStubs дополняет половину заглушками direct для outbound'ов из
+// другой половины, на которые ссылаются её detour и группы: иначе половина
+// падала бы на ссылке, а не на битой записи.
+func withReferenceStubs(part []any, tags map[string]bool) []any {
+	inPart := make(map[string]bool, len(part))
+	for _, raw := range part {
+		if outbound, ok := raw.(map[string]any); ok {
+			if tag, _ := outbound["tag"].(string); tag != "" {
+				inPart[tag] = true
+			}
+		}
+	}
+	result := part[:len(part):len(part)]
+	for _, raw := range part {
+		outbound, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		references, _ := outbound["outbounds"].([]any)
+		references = append(references[:len(references):len(references)], outbound["detour"], outbound["default"])
+		for _, reference := range references {
+			tag, _ := reference.(string)
+			if tag != "" && tags[tag] && !inPart[tag] {
+				inPart[tag] = true
+				result = append(result, map[string]any{"type": "direct", "tag": tag})
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseShareLinks(t *testing.T) {
+	content := strings.Join([]string{
+		"vless://b831381d-6324-4d53-ad4f-8cda48b30811@198.51.100.1:8080?type=tcp&security=none#first",
+		"not a link",
+		"vless://b831381d-6324-4d53-ad4f-8cda48b30811@198.51.100.2:8080?type=tcp&security=none#front" +
+			"&&detour=vless://b831381d-6324-4d53-ad4f-8cda48b30811@198.51.100.3:8080?type=tcp&security=none#exit",
+		"ss://YWVzLTI1Ni1nY206c3MtcGFzcw@203.0.113.5:8388#last",
+	}, "\n")
+	converted, lines, rejected := parseShareLinks(content, DefaultRostovVPNOptions())
+	if len(rejected) != 1 || rejected[0].Line != 2 {
+		t.Errorf("rejected = %+v", rejected)
+	}
+	var parsed struct {
+		Outbounds []map[string]any `json:"outbounds"`
+	}
+	if err := json.Unmarshal([]byte(converted), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	// нумерация сквозная, как при конвертации всей подписки разом
+	wantTags := []string{"first § 0", "front § 1", "exit § 2", "last § 3"}
+	wantLines := []int{1, 3, 3, 4}
+	if len(parsed.Outbounds) != len(wantTags) {
+		t.Fatalf("outbounds = %v", parsed.Outbounds)
+	}
+	for i, outbound := range parsed.Outbounds {
+		if outbound["tag"] != wantTags[i] {
+			t.Errorf("outbound %d tag = %v, want %s", i, outbound["tag"], wantTags[i])
+		}
+		if lines[wantTags[i]] != wantLines[i] {
+			t.Errorf("%s line = %d, want %d", wantTags[i], lines[wantTags[i]], wantLines[i])
+		}
+	}
+	if detour := parsed.Outbounds[2]["detour"]; detour != "front § 1" {
+		t.Errorf("chain detour = %v", detour)
+	}
+	if method := parsed.Outbounds[3]["method"]; method != "aes-256-gcm" {
+		t.Errorf("sip002 method = %v", method)
+	}
+}
+
+func validateTestObject() map[string]any {
+	return map[string]any{"outbounds": []any{
+		map[string]any{"type": "selector", "tag": "select", "outbounds": []any{"good", "bad", "good-2"}, "default": "bad"},
+		map[string]any{"type": "socks", "tag": "good", "server": "198.51.100.1", "server_port": 1080},
+		map[string]any{"type": "shadowsocks", "tag": "bad", "server": "198.51.100.2", "server_port": 8388, "method": "unknown-method", "password": "x"},
+		map[string]any{"type": "socks", "tag": "good-2", "server": "198.51.100.3", "server_port": 1080},
+	}}
+}
+
+func countCheckConfig(t *testing.T) *int {
+	calls := new(int)
+	check := checkConfig
+	checkConfig = func(content string) error {
+		*calls++
+		return check(content)
+	}
+	t.Cleanup(func() { checkConfig = check })
+	return calls
+}
+
+func TestValidateResultOnce(t *testing.T) {
+	obj := validateTestObject()
+	outbounds := obj["outbounds"].([]any)
+	obj["outbounds"] = append(outbounds[:2:2], outbounds[3])
+	obj["outbounds"].([]any)[0].(map[string]any)["default"] = "good"
+	calls := countCheckConfig(t)
+	report := &ParseReport{}
+	if _, err := validateResult(obj, "test", report, nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 1 {
+		t.Errorf("check config calls = %d, want 1", *calls)
+	}
+	if len(report.Accepted) != 3 || len(report.Rejected) != 0 {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestValidateResultBisect(t *testing.T) {
+	obj := validateTestObject()
+	report := &ParseReport{}
+	lines := map[string]int{"bad": 7}
+	content, err := validateResult(obj, "test", report, lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rejected) != 1 || report.Rejected[0].Tag != "bad" || report.Rejected[0].Line != 7 {
+		t.Fatalf("rejected = %+v", report.Rejected)
+	}
+	if len(report.Accepted) != 3 {
+		t.Errorf("accepted = %+v", report.Accepted)
+	}
+	var result struct {
+		Outbounds []struct {
+			Tag       string   `json:"tag"`
+			Outbounds []string `json:"outbounds"`
+			Default   string   `json:"default"`
+		} `json:"outbounds"`
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		t.Fatal(err)
+	}
+	selector := result.Outbounds[0]
+	if strings.Join(selector.Outbounds, ",") != "good,good-2" || selector.Default != "" {
+		t.Errorf("selector = %+v", selector)
+	}
+}
+
+func TestValidateResultConfigError(t *testing.T) {
+	obj := validateTestObject()
+	obj["outbounds"] = obj["outbounds"].([]any)[1:2]
+	obj["log"] = map[string]any{"level": "no-such-level"}
+	report := &ParseReport{}
+	_, err := validateResult(obj, "test", report, nil)
+	if err == nil || len(report.Rejected) != 0 {
+		t.Fatalf("err = %v, rejected = %+v", err, report.Rejected)
+	}
+	if !strings.Contains(err.Error(), "invalid sing-box config") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestValidateResultBisectKeepsReferences(t *testing.T) {
+	obj := map[string]any{"outbounds": []any{
+		map[string]any{"type": "socks", "tag": "front", "server": "198.51.100.1", "server_port": 1080},
+		map[string]any{"type": "shadowsocks", "tag": "bad", "server": "198.51.100.2", "server_port": 8388, "method": "unknown-method", "password": "x"},
+		map[string]any{"type": "selector", "tag": "select", "outbounds": []any{"front", "chained"}},
+		map[string]any{"type": "socks", "tag": "chained", "server": "198.51.100.3", "server_port": 1080, "detour": "front"},
+	}}
+	// libbox находит висячие detour и участников групп только при старте —
+	// проверка здесь строже, как если бы они ловились уже при сборке
+	check := checkConfig
+	checkConfig = func(content string) error {
+		var config struct {
+			Outbounds []struct {
+				Tag       string   `json:"tag"`
+				Detour    string   `json:"detour"`
+				Outbounds []string `json:"outbounds"`
+			} `json:"outbounds"`
+		}
+		json.Unmarshal([]byte(content), &config)
+		tags := make(map[string]bool)
+		for _, outbound := range config.Outbounds {
+			tags[outbound.Tag] = true
+		}
+		for _, outbound := range config.Outbounds {
+			for _, reference := range append(outbound.Outbounds, outbound.Detour) {
+				if reference != "" && !tags[reference] {
+					return fmt.Errorf("outbound %s: dependency not found: %s", outbound.Tag, reference)
+				}
+			}
+		}
+		return check(content)
+	}
+	t.Cleanup(func() { checkConfig = check })
+
+	report := &ParseReport{}
+	if _, err := validateResult(obj, "test", report, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rejected) != 1 || report.Rejected[0].Tag != "bad" {
+		t.Errorf("rejected = %+v", report.Rejected)
+	}
+	if len(report.Accepted) != 3 {
+		t.Errorf("accepted = %+v", report.Accepted)
+	}
+}
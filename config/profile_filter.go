@@ -0,0 +1,341 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+// ProfileFilterOptions — фильтры и переименования для одного профиля.
+// Применяются при разборе, до BuildConfig: отброшенные записи не попадают
+// ни в селектор, ни в группы. Если include не пуст, outbound должен подойти
+// хотя бы под один фильтр из него; под exclude — ни под один.
+type ProfileFilterOptions struct {
+	Include []OutboundFilter `json:"include,omitempty"`
+	Exclude []OutboundFilter `json:"exclude,omitempty"`
+	Rename  []RenameRule     `json:"rename,omitempty"`
+}
+
+// OutboundFilter — условия объединяются через «и», пустое условие не ограничивает.
+type OutboundFilter struct {
+	TagRegex  string   `json:"tag-regex,omitempty"`
+	Protocols []string `json:"protocols,omitempty"`
+	Ports     []string `json:"ports,omitempty"` // "443" или диапазон "8000-9000"
+	Countries []string `json:"countries,omitempty"`
+}
+
+// RenameRule применяется к тегу по порядку: strip-prefix, затем regex/replace
+// (replace поддерживает $1), затем флаг страны в начало.
+type RenameRule struct {
+	StripPrefix string `json:"strip-prefix,omitempty"`
+	Regex       string `json:"regex,omitempty"`
+	Replace     string `json:"replace,omitempty"`
+	AddFlag     bool   `json:"add-flag,omitempty"`
+}
+
+func (o *ProfileFilterOptions) isEmpty() bool {
+	return len(o.Include) == 0 && len(o.Exclude) == 0 && len(o.Rename) == 0
+}
+
+func (o *ProfileFilterOptions) usesCountry() bool {
+	for _, filter := range append(append([]OutboundFilter(nil), o.Include...), o.Exclude...) {
+		if len(filter.Countries) > 0 {
+			return true
+		}
+	}
+	for _, rule := range o.Rename {
+		if rule.AddFlag {
+			return true
+		}
+	}
+	return false
+}
+
+type compiledFilter struct {
+	filter   OutboundFilter
+	tagRegex *regexp.Regexp
+	ports    [][2]uint64
+}
+
+func (f OutboundFilter) compile() (*compiledFilter, error) {
+	compiled := &compiledFilter{filter: f}
+	if f.TagRegex != "" {
+		var err error
+		compiled.tagRegex, err = regexp.Compile(f.TagRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag-regex: %w", err)
+		}
+	}
+	for _, port := range f.Ports {
+		from, to, isRange := strings.Cut(strings.TrimSpace(port), "-")
+		if !isRange {
+			to = from
+		}
+		start, err := strconv.ParseUint(strings.TrimSpace(from), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", port)
+		}
+		end, err := strconv.ParseUint(strings.TrimSpace(to), 10, 16)
+		if err != nil || end < start {
+			return nil, fmt.Errorf("invalid port %q", port)
+		}
+		compiled.ports = append(compiled.ports, [2]uint64{start, end})
+	}
+	return compiled, nil
+}
+
+func (f *compiledFilter) match(obj outboundMap, country func() string) bool {
+	tag := obj.string("tag")
+	if f.tagRegex != nil && !f.tagRegex.MatchString(tag) {
+		return false
+	}
+	if len(f.filter.Protocols) > 0 && !containsFold(f.filter.Protocols, obj.string("type")) {
+		return false
+	}
+	if len(f.ports) > 0 {
+		port, _ := obj["server_port"].(float64)
+		matched := false
+		for _, portRange := range f.ports {
+			if uint64(port) >= portRange[0] && uint64(port) <= portRange[1] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(f.filter.Countries) > 0 && !containsFold(f.filter.Countries, country()) {
+		return false
+	}
+	return true
+}
+
+func compileFilters(filters []OutboundFilter, name string) ([]*compiledFilter, error) {
+	var compiled []*compiledFilter
+	for i, filter := range filters {
+		c, err := filter.compile()
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", name, i, err)
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// группы и служебные outbound'ы фильтры не трогают
+func isFilterableOutbound(obj outboundMap) bool {
+	switch strings.ToLower(obj.string("type")) {
+	case C.TypeDirect, C.TypeBlock, C.TypeDNS, C.TypeSelector, C.TypeURLTest:
+		return false
+	}
+	return true
+}
+
+// applyProfileFilter отбрасывает outbound'ы по include/exclude (в report.Filtered)
+// и переименовывает оставшиеся, поправляя ссылки на них в группах и lines.
+func applyProfileFilter(obj map[string]any, configOpt *RostovVPNOptions, report *ParseReport, lines map[string]int) error {
+	filter := configOpt.ProfileFilter
+	rawOuts, ok := obj["outbounds"].([]any)
+	if !ok || filter.isEmpty() {
+		return nil
+	}
+	include, err := compileFilters(filter.Include, "include")
+	if err != nil {
+		return err
+	}
+	exclude, err := compileFilters(filter.Exclude, "exclude")
+	if err != nil {
+		return err
+	}
+	renames := make([]*regexp.Regexp, len(filter.Rename))
+	for i, rule := range filter.Rename {
+		if rule.Regex == "" {
+			continue
+		}
+		if renames[i], err = regexp.Compile(rule.Regex); err != nil {
+			return fmt.Errorf("rename[%d]: invalid regex: %w", i, err)
+		}
+	}
+
+	// страна по GeoIP адреса сервера, иначе по тегу. Серверы резолвятся разом
+	// и параллельно, только если страна нужна фильтрам или флагам; hosts из
+	// настроек — те же статические адреса, что получит ядро.
+	var serverCountries map[string]string
+	if filter.usesCountry() {
+		var servers []string
+		for _, raw := range rawOuts {
+			if outbound, ok := raw.(map[string]any); ok && isFilterableOutbound(outbound) {
+				servers = append(servers, outboundMap(outbound).string("server"))
+			}
+		}
+		serverCountries = lookupServerCountries(context.Background(), loadGeoIP(configOpt.GeoIPPath), servers, configOpt.Hosts)
+	}
+	countryOf := func(obj outboundMap) func() string {
+		return func() string {
+			if country := serverCountries[obj.string("server")]; country != "" {
+				return country
+			}
+			return countryFromTag(obj.string("tag"))
+		}
+	}
+
+	var kept []any
+	var removed []string
+	for _, raw := range rawOuts {
+		outbound, ok := raw.(map[string]any)
+		if !ok || !isFilterableOutbound(outbound) {
+			kept = append(kept, raw)
+			continue
+		}
+		if reason := filterReason(outboundMap(outbound), countryOf(outbound), include, exclude); reason != "" {
+			tag := outboundMap(outbound).string("tag")
+			report.Filtered = append(report.Filtered, ParseEntry{
+				Line:   lines[tag],
+				Tag:    tag,
+				Type:   outboundMap(outbound).string("type"),
+				Reason: reason,
+			})
+			removed = append(removed, tag)
+			continue
+		}
+		kept = append(kept, raw)
+	}
+	for _, tag := range removed {
+		removeOutboundReferences(kept, tag)
+	}
+
+	if len(filter.Rename) > 0 {
+		used := make(map[string]bool)
+		for _, raw := range kept {
+			if outbound, ok := raw.(map[string]any); ok && !isFilterableOutbound(outbound) {
+				used[outboundMap(outbound).string("tag")] = true
+			}
+		}
+		// новые теги считаются все сразу: иначе A→B и B→C склеились бы по цепочке
+		renamed := make(map[string]string)
+		for _, raw := range kept {
+			outbound, ok := raw.(map[string]any)
+			if !ok || !isFilterableOutbound(outbound) {
+				continue
+			}
+			tag := outboundMap(outbound).string("tag")
+			newTag := uniqueTag(renameTag(tag, countryOf(outbound), filter.Rename, renames), used)
+			used[newTag] = true
+			outbound["tag"] = newTag
+			if newTag != tag {
+				renamed[tag] = newTag
+				if line, ok := lines[tag]; ok {
+					lines[newTag] = line
+				}
+			}
+		}
+		renameOutboundReferences(kept, renamed)
+	}
+	obj["outbounds"] = kept
+	return nil
+}
+
+func filterReason(obj outboundMap, country func() string, include []*compiledFilter, exclude []*compiledFilter) string {
+	if len(include) > 0 {
+		matched := false
+		for _, filter := range include {
+			if filter.match(obj, country) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return "not matched by include filter"
+		}
+	}
+	for i, filter := range exclude {
+		if filter.match(obj, country) {
+			return fmt.Sprintf("excluded by filter %d", i+1)
+		}
+	}
+	return ""
+}
+
+func renameTag(tag string, country func() string, rules []RenameRule, regexps []*regexp.Regexp) string {
+	for i, rule := range rules {
+		if rule.StripPrefix != "" {
+			tag = strings.TrimSpace(strings.TrimPrefix(tag, rule.StripPrefix))
+		}
+		if regexps[i] != nil {
+			tag = strings.TrimSpace(regexps[i].ReplaceAllString(tag, rule.Replace))
+		}
+		if rule.AddFlag {
+			tag = addCountryFlag(tag, country())
+		}
+	}
+	return tag
+}
+
+// addCountryFlag дописывает флаг в начало тега, если страна известна,
+// а флага там ещё нет.
+func addCountryFlag(tag string, code string) string {
+	for _, r := range tag {
+		if isRegionalIndicator(r) {
+			return tag
+		}
+	}
+	if code == "" {
+		return tag
+	}
+	return countryFlag(code) + " " + tag
+}
+
+func countryFlag(code string) string {
+	code = strings.ToUpper(code)
+	if len(code) != 2 {
+		return ""
+	}
+	return string([]rune{rune(code[0]-'A') + 0x1F1E6, rune(code[1]-'A') + 0x1F1E6})
+}
+
+func uniqueTag(tag string, used map[string]bool) string {
+	if tag == "" {
+		tag = "outbound"
+	}
+	if !used[tag] {
+		return tag
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s %d", tag, i)
+		if !used[candidate] {
+			return candidate
+		}
+	}
+}
+
+// renameOutboundReferences — пара к removeOutboundReferences для переименования.
+func renameOutboundReferences(outbounds []any, renamed map[string]string) {
+	if len(renamed) == 0 {
+		return
+	}
+	for _, raw := range outbounds {
+		outbound, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if members, ok := outbound["outbounds"].([]any); ok {
+			for i, member := range members {
+				if newTag, ok := renamed[fmt.Sprint(member)]; ok {
+					members[i] = newTag
+				}
+			}
+		}
+		for _, key := range []string{"default", "detour"} {
+			if tag, ok := outbound[key].(string); ok {
+				if newTag, ok := renamed[tag]; ok {
+					outbound[key] = newTag
+				}
+			}
+		}
+	}
+}
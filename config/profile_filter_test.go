@@ -0,0 +1,162 @@
+package config
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func profileFilterTestObject() map[string]any {
+	outbound := func(outboundType string, tag string, port float64) map[string]any {
+		return map[string]any{"type": outboundType, "tag": tag, "server": "198.51.100.1", "server_port": port}
+	}
+	return map[string]any{"outbounds": []any{
+		map[string]any{"type": "selector", "tag": "select", "outbounds": []any{"[Premium] DE Server 1", "NL Server 2", "🇺🇸 US fast"}},
+		outbound("vless", "[Premium] DE Server 1", 443),
+		outbound("trojan", "NL Server 2", 8443),
+		outbound("shadowsocks", "🇺🇸 US fast", 8388),
+		outbound("vmess", "no country", 80),
+		map[string]any{"type": "direct", "tag": "direct"},
+	}}
+}
+
+// profileFilterTags — теги outbound'ов после фильтра, кроме служебных.
+func profileFilterTags(obj map[string]any) []string {
+	var tags []string
+	for _, raw := range obj["outbounds"].([]any) {
+		outbound := outboundMap(raw.(map[string]any))
+		if isFilterableOutbound(outbound) {
+			tags = append(tags, outbound.string("tag"))
+		}
+	}
+	return tags
+}
+
+func TestApplyProfileFilter(t *testing.T) {
+	cases := []struct {
+		name     string
+		filter   ProfileFilterOptions
+		tags     []string
+		filtered []string
+	}{
+		{
+			name:     "include regex",
+			filter:   ProfileFilterOptions{Include: []OutboundFilter{{TagRegex: `Server \d$`}}},
+			tags:     []string{"[Premium] DE Server 1", "NL Server 2"},
+			filtered: []string{"🇺🇸 US fast", "no country"},
+		},
+		{
+			name:     "exclude regex and protocol are separate filters",
+			filter:   ProfileFilterOptions{Exclude: []OutboundFilter{{TagRegex: "fast"}, {Protocols: []string{"VMESS"}}}},
+			tags:     []string{"[Premium] DE Server 1", "NL Server 2"},
+			filtered: []string{"🇺🇸 US fast", "no country"},
+		},
+		{
+			name:     "conditions in one filter are combined",
+			filter:   ProfileFilterOptions{Include: []OutboundFilter{{TagRegex: "Server", Ports: []string{"8000-9000"}}}},
+			tags:     []string{"NL Server 2"},
+			filtered: []string{"[Premium] DE Server 1", "🇺🇸 US fast", "no country"},
+		},
+		{
+			name:     "country from tag word and flag",
+			filter:   ProfileFilterOptions{Include: []OutboundFilter{{Countries: []string{"de", "US"}}}},
+			tags:     []string{"[Premium] DE Server 1", "🇺🇸 US fast"},
+			filtered: []string{"NL Server 2", "no country"},
+		},
+		{
+			name:     "exclude country keeps unknown",
+			filter:   ProfileFilterOptions{Exclude: []OutboundFilter{{Countries: []string{"NL"}}}},
+			tags:     []string{"[Premium] DE Server 1", "🇺🇸 US fast", "no country"},
+			filtered: []string{"NL Server 2"},
+		},
+		{
+			name: "rename steps run in rule order",
+			filter: ProfileFilterOptions{Rename: []RenameRule{
+				{StripPrefix: "[Premium]"},
+				{Regex: `Server (\d+)`, Replace: "#$1", AddFlag: true},
+			}},
+			tags: []string{"🇩🇪 DE #1", "🇳🇱 NL #2", "🇺🇸 US fast", "no country"},
+		},
+		{
+			name: "later rule sees the flag of an earlier one",
+			filter: ProfileFilterOptions{Rename: []RenameRule{
+				{AddFlag: true},
+				{Regex: `^\S+ `, Replace: ""},
+			}},
+			tags: []string{"[Premium] DE Server 1", "NL Server 2", "US fast", "country"},
+		},
+		{
+			name:   "renames to the same tag stay unique",
+			filter: ProfileFilterOptions{Rename: []RenameRule{{Regex: ".*", Replace: "node"}}},
+			tags:   []string{"node", "node 2", "node 3", "node 4"},
+		},
+		{
+			name: "filter runs before rename",
+			filter: ProfileFilterOptions{
+				Include: []OutboundFilter{{TagRegex: "^NL"}},
+				Rename:  []RenameRule{{Regex: "^NL", Replace: "DE"}},
+			},
+			tags:     []string{"DE Server 2"},
+			filtered: []string{"[Premium] DE Server 1", "🇺🇸 US fast", "no country"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			obj := profileFilterTestObject()
+			opt := DefaultRostovVPNOptions()
+			opt.ProfileFilter = c.filter
+			report := &ParseReport{}
+			if err := applyProfileFilter(obj, opt, report, map[string]int{}); err != nil {
+				t.Fatal(err)
+			}
+			if tags := profileFilterTags(obj); !reflect.DeepEqual(tags, c.tags) {
+				t.Errorf("tags = %q, want %q", tags, c.tags)
+			}
+			var filtered []string
+			for _, entry := range report.Filtered {
+				filtered = append(filtered, entry.Tag)
+			}
+			if !reflect.DeepEqual(filtered, c.filtered) {
+				t.Errorf("filtered = %q, want %q", filtered, c.filtered)
+			}
+			// селектор ссылается только на оставшиеся теги, уже переименованные
+			selector := obj["outbounds"].([]any)[0].(map[string]any)
+			kept := make(map[string]bool)
+			for _, tag := range c.tags {
+				kept[tag] = true
+			}
+			for _, member := range selector["outbounds"].([]any) {
+				if !kept[member.(string)] {
+					t.Errorf("selector references %v", member)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyProfileFilterInvalid(t *testing.T) {
+	for _, filter := range []ProfileFilterOptions{
+		{Include: []OutboundFilter{{TagRegex: "("}}},
+		{Exclude: []OutboundFilter{{Ports: []string{"9000-8000"}}}},
+		{Rename: []RenameRule{{Regex: "["}}},
+	} {
+		opt := DefaultRostovVPNOptions()
+		opt.ProfileFilter = filter
+		if err := applyProfileFilter(profileFilterTestObject(), opt, &ParseReport{}, nil); err == nil {
+			t.Errorf("%+v: expected error", filter)
+		}
+	}
+}
+
+func TestLoadGeoIPRetriesFailedOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geoip.db")
+	if loadGeoIP(path) != nil {
+		t.Fatal("missing database opened")
+	}
+	geoIPAccess.Lock()
+	cached := geoIPReaderPath == path
+	geoIPAccess.Unlock()
+	if cached {
+		t.Errorf("failed open is cached for %s", path)
+	}
+}
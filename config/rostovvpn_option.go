@@ -12,17 +12,24 @@ type RostovVPNOptions struct {
 	EnableClashApi          bool   `json:"enable-clash-api"`
 	ClashApiPort            uint16 `json:"clash-api-port"`
 	ClashApiSecret          string `json:"web-secret"`
+	ClashMode               string `json:"clash-mode"`
+	ClashModeSwitch         bool   `json:"clash-mode-switch"` // clash-сервер без внешнего контроллера: clash-mode при выключенном enable-clash-api (пишет clash.db)
 	Region                  string `json:"region"`
 	BlockAds                bool   `json:"block-ads"`
 	PerAppProxyMode         string   `json:"per_app_proxy_mode"`
 	UseXrayCoreWhenPossible bool   `json:"use-xray-core-when-possible"`
-	// GeoIPPath        string      `json:"geoip-path"`
+	EnableProfiling         bool   `json:"enable-profiling"`
+	GeoIPPath               string `json:"geoip-path"` // локальная mmdb (sing-geoip или GeoLite2-Country) для стран серверов
 	// GeoSitePath      string      `json:"geosite-path"`
-	Rules     []Rule      `json:"rules"`
-	Warp      WarpOptions `json:"warp"`
-	Warp2     WarpOptions `json:"warp2"`
-	Mux       MuxOptions  `json:"mux"`
-	TLSTricks TLSTricks   `json:"tls-tricks"`
+	Rules          []Rule                 `json:"rules"`
+	OutboundGroups []OutboundGroupOptions `json:"outbound-groups"`
+	Chains         []ChainOptions         `json:"chains"`
+	Warp           WarpOptions            `json:"warp"`
+	Warp2          WarpOptions            `json:"warp2"`
+	Mux            MuxOptions             `json:"mux"`
+	TLSTricks      TLSTricks              `json:"tls-tricks"`
+	ProfileFilter  ProfileFilterOptions   `json:"profile-filter"`
+	Blocklists     []BlocklistOptions     `json:"blocklists,omitempty"`
 	DNSOptions
 	InboundOptions
 	URLTestOptions
@@ -37,6 +44,57 @@ type DNSOptions struct {
 	IndependentDNSCache     bool                  `json:"independent-dns-cache"`
 	EnableFakeDNS           bool                  `json:"enable-fake-dns"`
 	EnableDNSRouting        bool                  `json:"enable-dns-routing"`
+	DNSServers              []DNSServerConfig     `json:"dns-servers,omitempty"`
+	DNSRules                []DNSRuleConfig       `json:"dns-rules,omitempty"`
+	DNSGroups               []DNSGroupConfig      `json:"dns-groups,omitempty"`
+	// Hosts — свои записи: домен или *.домен (только поддомены) -> IPv4/IPv6.
+	// Перекрывают записи из HostsFiles и встроенные.
+	Hosts      map[string][]string `json:"hosts,omitempty"`
+	HostsFiles []string            `json:"hosts-files,omitempty"` // файлы в формате /etc/hosts
+}
+
+// DNSServerConfig — именованный DNS-сервер поверх встроенных (dns-remote, dns-bootstrap, ...).
+// Адрес: udp://, tcp://, tls:// (DoT), https:// (DoH), quic:// (DoQ), h3://
+// или просто host[:port] для UDP.
+type DNSServerConfig struct {
+	Tag      string                `json:"tag"`
+	Address  string                `json:"address"`
+	Detour   string                `json:"detour,omitempty"`   // direct (по умолчанию), proxy или тег outbound'а
+	Resolver string                `json:"resolver,omitempty"` // DNS-сервер для имени в адресе, по умолчанию dns-bootstrap
+	Strategy option.DomainStrategy `json:"strategy,omitempty"`
+}
+
+// DNSGroupConfig — racing-группа: запрос уходит в несколько серверов сразу
+// (parallel) или по очереди с задержкой stagger-ms (staggered), берётся первый
+// нормальный ответ. Группа с тегом dns-remote занимает место встроенного
+// сервера, а сам он доступен в servers как dns-remote.
+type DNSGroupConfig struct {
+	Tag         string            `json:"tag"`
+	Servers     []string          `json:"servers"`
+	Mode        string            `json:"mode,omitempty"` // staggered (по умолчанию) или parallel
+	StaggerMs   int               `json:"stagger-ms,omitempty"`
+	MaxFailures int               `json:"max-failures,omitempty"` // ошибок подряд до вывода из ротации
+	Cooldown    DurationInSeconds `json:"cooldown,omitempty"`
+}
+
+// DNSRuleConfig отправляет запросы в именованный сервер. Условия объединяются через «и».
+type DNSRuleConfig struct {
+	Domains  string   `json:"domains,omitempty"`   // как в Rule: full:, domain:, keyword:, regexp:, geosite:
+	RuleSets []string `json:"rule-sets,omitempty"` // теги rule-set или URL .srs
+	Inbounds []string `json:"inbounds,omitempty"`
+	Server   string   `json:"server"` // тег сервера или block
+}
+
+// BlocklistOptions — свой список блокировки. Ядро само скачивает его и
+// компилирует в rule-set; домены из включённых списков блокируются и на уровне
+// DNS, и на уровне соединений.
+type BlocklistOptions struct {
+	Name           string            `json:"name"`
+	Source         string            `json:"source"`           // путь к файлу или http(s) URL
+	Format         string            `json:"format,omitempty"` // hosts, abp, domains; пусто — по содержимому
+	Enable         bool              `json:"enable"`
+	Inline         bool              `json:"inline,omitempty"`          // встроить в конфиг вместо .srs, обновляется только при перезапуске
+	UpdateInterval DurationInSeconds `json:"update-interval,omitempty"` // по умолчанию раз в сутки
 }
 
 type InboundOptions struct {
@@ -136,7 +194,8 @@ func DefaultRostovVPNOptions() *RostovVPNOptions {
 		EnableClashApi: true,
 		ClashApiPort:   16756,
 		ClashApiSecret: "",
-		// GeoIPPath:      "geoip.db",
+		ClashMode:      ClashModeRule,
+		GeoIPPath:      "geoip.db",
 		// GeoSitePath:    "geosite.db",
 		Rules: []Rule{},
 		Mux: MuxOptions{
@@ -0,0 +1,327 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+
+	mDNS "github.com/miekg/dns"
+	box "github.com/sagernet/sing-box"
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	R "github.com/sagernet/sing-box/route/rule"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+	"github.com/sagernet/sing/service"
+	"github.com/sagernet/sing/service/filemanager"
+)
+
+// RouteRequest — соединение, для которого объясняется маршрут.
+type RouteRequest struct {
+	Host    string `json:"host"` // домен или IP
+	Port    uint16 `json:"port,omitempty"`
+	Network string `json:"network,omitempty"` // tcp (по умолчанию) или udp
+	Process string `json:"process,omitempty"` // путь или имя процесса, на Android — пакет
+	Inbound string `json:"inbound,omitempty"`
+}
+
+// RuleMatch — сработавшее правило: индекс в Route.Rules или DNS.Rules,
+// описание в формате sing-box, действие и источник.
+type RuleMatch struct {
+	Index  int    `json:"index"`
+	Rule   string `json:"rule"`
+	Action string `json:"action"`
+	Origin Origin `json:"origin"`
+}
+
+// RouteExplanation — результат ExplainRoute. Rule == nil — сработал route.final.
+type RouteExplanation struct {
+	Rule      *RuleMatch `json:"rule,omitempty"`
+	Outbound  string     `json:"outbound,omitempty"`
+	Path      []string   `json:"path,omitempty"` // выбор групп до конечного outbound'а, только на живом ядре
+	DNSRule   *RuleMatch `json:"dns-rule,omitempty"`
+	DNSServer string     `json:"dns-server,omitempty"`
+	Notes     []string   `json:"notes,omitempty"`
+}
+
+// ExplainRoute прогоняет запрос по правилам собранного конфига так же, как
+// роутер sing-box: первое правило с конечным действием побеждает.
+// ctx — контекст запущенного ядра; nil — офлайн: конфиг поднимается через
+// box.New без запуска. Локальные rule-set'ы читаются с диска относительно
+// рабочей директории ядра, удалённые не загружены — о них и о локальных,
+// которые не прочитались, говорится в Notes.
+// Сниффинг и резолв не выполняются: правила по IP для домена не сработают.
+func ExplainRoute(ctx context.Context, options *option.Options, report *BuildReport, request RouteRequest) (*RouteExplanation, error) {
+	if request.Host == "" {
+		return nil, fmt.Errorf("host is required")
+	}
+	network := strings.ToLower(request.Network)
+	switch network {
+	case "":
+		network = N.NetworkTCP
+	case N.NetworkTCP, N.NetworkUDP:
+	default:
+		return nil, fmt.Errorf("unknown network %s", request.Network)
+	}
+
+	explanation := &RouteExplanation{}
+	live := ctx != nil
+	if !live {
+		// правилам нужны только роутер и rule-set'ы: без clash api, cache file
+		// и inbound'ов офлайн-экземпляр ничего не открывает
+		offline := *options
+		offline.Log = &option.LogOptions{Disabled: true}
+		offline.Inbounds = nil
+		offline.Experimental = nil
+		// box.New регистрирует роутер в реестре переданного контекста;
+		// относительные пути локальных rule-set'ов — как у ядра
+		ctx = filemanager.WithDefault(BaseContext(), workingDir, "", os.Getuid(), os.Getgid())
+		if options.Route != nil {
+			route := *options.Route
+			route.RuleSet = make([]option.RuleSet, len(options.Route.RuleSet))
+			for i, ruleSet := range options.Route.RuleSet {
+				switch ruleSet.Type {
+				case C.RuleSetTypeRemote:
+					explanation.Notes = append(explanation.Notes, "remote rule-set "+ruleSet.Tag+" is not loaded offline")
+				case C.RuleSetTypeLocal:
+					if err := loadLocalRuleSet(ctx, ruleSet); err != nil {
+						explanation.Notes = append(explanation.Notes, "local rule-set "+ruleSet.Tag+" is not loaded: "+err.Error())
+						// без файла box.New не соберётся; пустой набор не совпадёт
+						// ни с чем, как удалённый офлайн
+						ruleSet = option.RuleSet{Type: C.RuleSetTypeRemote, Tag: ruleSet.Tag, Format: ruleSet.Format}
+					}
+				}
+				route.RuleSet[i] = ruleSet
+			}
+			offline.Route = &route
+		}
+		instance, err := box.New(box.Options{Context: ctx, Options: offline})
+		if err != nil {
+			return nil, fmt.Errorf("create instance: %w", err)
+		}
+		defer instance.Close()
+	}
+	ctx = withUncountedRuleSets(ctx)
+
+	metadata := adapter.InboundContext{
+		Inbound: request.Inbound,
+		Network: network,
+	}
+	if addr, err := netip.ParseAddr(request.Host); err == nil {
+		metadata.Destination = M.SocksaddrFrom(addr, request.Port)
+		metadata.DestinationAddresses = []netip.Addr{addr}
+	} else {
+		metadata.Domain = strings.ToLower(strings.TrimSuffix(request.Host, "."))
+		metadata.Destination = M.Socksaddr{Fqdn: metadata.Domain, Port: request.Port}
+		explanation.Notes = append(explanation.Notes, "destination is not resolved, ip rules are skipped for domains")
+	}
+	if request.Process != "" {
+		metadata.ProcessInfo = &adapter.ConnectionOwner{ProcessPath: request.Process, AndroidPackageName: request.Process}
+	}
+
+	logger := log.NewNOPFactory().Logger()
+	if options.Route != nil {
+		for i, ruleOptions := range options.Route.Rules {
+			rule, err := R.NewRule(ctx, logger, ruleOptions, false)
+			if err != nil {
+				return nil, fmt.Errorf("route rule[%d]: %w", i, err)
+			}
+			matched, err := matchRule(rule, &metadata)
+			if err != nil {
+				return nil, fmt.Errorf("route rule[%d]: %w", i, err)
+			}
+			if !matched || !finalRouteAction(rule.Action()) {
+				continue
+			}
+			explanation.Rule = &RuleMatch{Index: i, Rule: rule.String(), Action: rule.Action().String(), Origin: report.RouteRuleOrigin(i)}
+			if route, ok := rule.Action().(*R.RuleActionRoute); ok {
+				explanation.Outbound = route.Outbound
+			}
+			break
+		}
+		if explanation.Rule == nil {
+			explanation.Outbound = options.Route.Final
+		}
+	}
+
+	if metadata.Domain != "" && options.DNS != nil {
+		matcher, err := NewDNSRuleMatcher(ctx, options.DNS.Rules)
+		if err != nil {
+			return nil, err
+		}
+		defer matcher.Close()
+		dnsMetadata := metadata
+		dnsMetadata.QueryType = mDNS.TypeA
+		dnsMetadata.IPVersion = 4
+		dnsMetadata.DestinationAddresses = nil
+		// правила с ограничением по адресам ответа проверяются после запроса
+		if i, rule := matcher.Match(&dnsMetadata, 0, true); rule != nil {
+			explanation.DNSRule = &RuleMatch{Index: i, Rule: rule.String(), Action: rule.Action().String(), Origin: report.DNSRuleOrigin(i)}
+			if route, ok := rule.Action().(*R.RuleActionDNSRoute); ok {
+				explanation.DNSServer = route.Server
+			}
+		} else {
+			explanation.DNSServer = options.DNS.Final
+		}
+	}
+
+	if live && explanation.Outbound != "" {
+		explanation.Path = outboundPath(ctx, explanation.Outbound)
+	}
+	return explanation, nil
+}
+
+// DNSRuleMatcher — запущенные DNS-правила конфига. Первое сработавшее
+// правило ищется так же, как в DNS-роутере sing-box; по нему объясняется
+// маршрут и подписываются записи журнала DNS-запросов.
+type DNSRuleMatcher struct {
+	rules []adapter.DNSRule
+}
+
+// NewDNSRuleMatcher собирает правила на роутере из ctx: rule-set'ы берутся
+// у него без подсчёта ссылок, как в ExplainRoute.
+func NewDNSRuleMatcher(ctx context.Context, rules []option.DNSRule) (*DNSRuleMatcher, error) {
+	ctx = withUncountedRuleSets(ctx)
+	logger := log.NewNOPFactory().Logger()
+	matcher := &DNSRuleMatcher{}
+	for i, ruleOptions := range rules {
+		rule, err := R.NewDNSRule(ctx, logger, ruleOptions, false)
+		if err == nil {
+			err = rule.Start()
+		}
+		if err != nil {
+			matcher.Close()
+			return nil, fmt.Errorf("dns rule[%d]: %w", i, err)
+		}
+		matcher.rules = append(matcher.rules, rule)
+	}
+	return matcher, nil
+}
+
+// Match — индекс и первое правило с конечным действием, начиная с from;
+// -1 и nil — сработал final. Правила по адресам ответа для запросов не A и
+// AAAA пропускаются, как в роутере.
+func (m *DNSRuleMatcher) Match(metadata *adapter.InboundContext, from int, isAddressQuery bool) (int, adapter.DNSRule) {
+	for i := from; i < len(m.rules); i++ {
+		rule := m.rules[i]
+		if rule.WithAddressLimit() && !isAddressQuery {
+			continue
+		}
+		metadata.ResetRuleCache()
+		if !rule.Match(metadata) {
+			continue
+		}
+		if _, ok := rule.Action().(*R.RuleActionDNSRouteOptions); ok {
+			continue
+		}
+		return i, rule
+	}
+	return -1, nil
+}
+
+func (m *DNSRuleMatcher) Close() {
+	for _, rule := range m.rules {
+		rule.Close()
+	}
+}
+
+func loadLocalRuleSet(ctx context.Context, options option.RuleSet) error {
+	ruleSet, err := R.NewRuleSet(ctx, log.NewNOPFactory().Logger(), options)
+	if err != nil {
+		return err
+	}
+	return ruleSet.Close()
+}
+
+// withUncountedRuleSets подменяет роутер для создаваемых правил: Start у
+// условия rule_set берёт ссылку на набор (IncRef), а Close её не отдаёт, и
+// на живом ядре каждый запрос навсегда удерживал бы наборы. Подмена живёт в
+// своём реестре поверх реестра ядра, сам роутер ядра не трогается.
+func withUncountedRuleSets(ctx context.Context) context.Context {
+	router := service.FromContext[adapter.Router](ctx)
+	if router == nil {
+		return ctx
+	}
+	ctx = service.ContextWithRegistry(ctx, overlayRegistry{Registry: service.NewRegistry(), parent: service.RegistryFromContext(ctx)})
+	return service.ContextWith[adapter.Router](ctx, uncountedRouter{router})
+}
+
+// overlayRegistry — реестр, который ищет сервис в себе, затем в parent.
+type overlayRegistry struct {
+	service.Registry
+	parent service.Registry
+}
+
+func (r overlayRegistry) Get(serviceType any) any {
+	if found := r.Registry.Get(serviceType); found != nil {
+		return found
+	}
+	return r.parent.Get(serviceType)
+}
+
+type uncountedRouter struct {
+	adapter.Router
+}
+
+func (r uncountedRouter) RuleSet(tag string) (adapter.RuleSet, bool) {
+	ruleSet, loaded := r.Router.RuleSet(tag)
+	if !loaded {
+		return nil, false
+	}
+	return uncountedRuleSet{ruleSet}, true
+}
+
+type uncountedRuleSet struct {
+	adapter.RuleSet
+}
+
+func (uncountedRuleSet) IncRef() {}
+
+func (uncountedRuleSet) DecRef() {}
+
+func matchRule(rule adapter.Rule, metadata *adapter.InboundContext) (bool, error) {
+	if err := rule.Start(); err != nil {
+		return false, err
+	}
+	defer rule.Close()
+	return rule.Match(metadata), nil
+}
+
+// finalRouteAction — действия, после которых роутер не смотрит дальше.
+func finalRouteAction(action adapter.RuleAction) bool {
+	switch action.(type) {
+	case *R.RuleActionSniff, *R.RuleActionResolve, *R.RuleActionRouteOptions:
+		return false
+	}
+	return true
+}
+
+// outboundPath раскрывает выбор групп: select → auto → сервер.
+func outboundPath(ctx context.Context, tag string) []string {
+	path := []string{tag}
+	manager := service.FromContext[adapter.OutboundManager](ctx)
+	if manager == nil {
+		return path
+	}
+	seen := map[string]bool{tag: true}
+	for {
+		outbound, loaded := manager.Outbound(tag)
+		if !loaded {
+			return path
+		}
+		group, isGroup := outbound.(adapter.OutboundGroup)
+		if !isGroup {
+			return path
+		}
+		tag = group.Now()
+		if tag == "" || seen[tag] {
+			return path
+		}
+		seen[tag] = true
+		path = append(path, tag)
+	}
+}
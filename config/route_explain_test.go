@@ -0,0 +1,215 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	R "github.com/sagernet/sing-box/route/rule"
+	"github.com/sagernet/sing/service"
+)
+
+func explainTestOptions(t *testing.T) *RostovVPNOptions {
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile("ads.txt", []byte("0.0.0.0 ads.example.com\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	opt := DefaultRostovVPNOptions()
+	opt.EnableDNSRouting = true
+	opt.BypassLAN = true
+	opt.Rules = []Rule{
+		{Domains: "domain:example.org", Outbound: "bypass"},
+		{Port: "6881:6889", Outbound: "block"},
+	}
+	opt.Blocklists = []BlocklistOptions{{Name: "ads", Source: "ads.txt", Enable: true, Inline: true}}
+	opt.DNSRules = []DNSRuleConfig{{Domains: "domain:corp.test", Server: DNSBootstrapTag}}
+	opt.Hosts = map[string][]string{"nas.lan": {"192.168.1.5"}}
+	return opt
+}
+
+// без серверов профиля select остаётся пустым и box.New его не примет
+var explainTestInput = option.Options{Outbounds: []option.Outbound{{
+	Type: C.TypeSOCKS,
+	Tag:  "server",
+	Options: &option.SOCKSOutboundOptions{
+		ServerOptions: option.ServerOptions{Server: "1.1.1.1", ServerPort: 1080},
+	},
+}}}
+
+func TestBuildReportOrigins(t *testing.T) {
+	opt := explainTestOptions(t)
+	options, report, err := BuildConfigWithReport(*opt, explainTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(report.RouteRules) != len(options.Route.Rules) {
+		t.Fatalf("route origins %d, rules %d", len(report.RouteRules), len(options.Route.Rules))
+	}
+	if len(report.DNSRules) != len(options.DNS.Rules) {
+		t.Fatalf("dns origins %d, rules %d", len(report.DNSRules), len(options.DNS.Rules))
+	}
+	// hosts ставятся первыми, затем dns-rules
+	if origin := report.DNSRuleOrigin(0); origin.Source != SourceHosts {
+		t.Errorf("dns rule 0 = %+v", origin)
+	}
+	if origin := report.DNSRuleOrigin(1); origin != newOrigin(SourceDNSRules, "dns-rules[0]") {
+		t.Errorf("dns rule 1 = %+v", origin)
+	}
+	sources := make(map[Origin]bool)
+	for _, origin := range report.RouteRules {
+		sources[origin] = true
+	}
+	for _, origin := range []Origin{
+		newOrigin(SourceUser, "rules[0]"),
+		newOrigin(SourceUser, "rules[1]"),
+		newOrigin(SourceBuiltin, "bypass-lan"),
+		newOrigin(SourceBlocklist, "blocklist-ads"),
+	} {
+		if !sources[origin] {
+			t.Errorf("route origin %+v missing: %+v", origin, report.RouteRules)
+		}
+	}
+	if origin := report.RouteRuleOrigin(len(report.RouteRules)); origin.Source != SourceProfile {
+		t.Errorf("out of range origin = %+v", origin)
+	}
+}
+
+func TestExplainRoute(t *testing.T) {
+	opt := explainTestOptions(t)
+	options, report, err := BuildConfigWithReport(*opt, explainTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	cases := []struct {
+		request  RouteRequest
+		origin   Origin
+		outbound string
+	}{
+		{RouteRequest{Host: "www.example.org", Port: 443}, newOrigin(SourceUser, "rules[0]"), OutboundBypassTag},
+		{RouteRequest{Host: "1.2.3.4", Port: 6881}, newOrigin(SourceUser, "rules[1]"), OutboundBlockTag},
+		{RouteRequest{Host: "192.168.1.1", Port: 80}, newOrigin(SourceBuiltin, "bypass-lan"), OutboundBypassTag},
+		{RouteRequest{Host: "ads.example.com", Port: 443}, newOrigin(SourceBlocklist, "blocklist-ads"), "blocklist-ads"},
+		{RouteRequest{Host: "8.8.8.8", Port: 53, Network: "udp"}, newOrigin(SourceBuiltin, "dns hijack"), OutboundDNSTag},
+	}
+	for _, c := range cases {
+		explanation, err := ExplainRoute(nil, options, report, c.request)
+		if err != nil {
+			t.Fatalf("%s: %v", c.request.Host, err)
+		}
+		if explanation.Rule == nil || explanation.Rule.Origin != c.origin {
+			t.Errorf("%s: rule = %+v", c.request.Host, explanation.Rule)
+			continue
+		}
+		if explanation.Outbound != c.outbound {
+			t.Errorf("%s: outbound = %s, want %s", c.request.Host, explanation.Outbound, c.outbound)
+		}
+	}
+
+	explanation, err := ExplainRoute(nil, options, report, RouteRequest{Host: "unknown.example.net", Port: 443})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if explanation.Rule != nil || explanation.Outbound != options.Route.Final {
+		t.Errorf("final: %+v", explanation)
+	}
+	explanation, err = ExplainRoute(nil, options, report, RouteRequest{Host: "git.corp.test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if explanation.DNSRule == nil || explanation.DNSRule.Origin.Source != SourceDNSRules || explanation.DNSServer != DNSBootstrapTag {
+		t.Errorf("dns: %+v", explanation.DNSRule)
+	}
+
+	if _, err := ExplainRoute(nil, options, report, RouteRequest{Host: "example.org", Network: "icmp"}); err == nil {
+		t.Errorf("unknown network must fail")
+	}
+}
+
+func TestExplainRouteLocalRuleSets(t *testing.T) {
+	opt := explainTestOptions(t)
+	options, report, err := BuildConfigWithReport(*opt, explainTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	// относительный путь ищется в рабочей директории ядра, а не в текущей
+	dir := t.TempDir()
+	SetWorkingDir(dir)
+	t.Cleanup(func() { SetWorkingDir("") })
+	if err := os.WriteFile(filepath.Join(dir, "corp.json"), []byte(`{"version": 3, "rules": [{"domain_suffix": ["corp.internal"]}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	options.Route.RuleSet = append(options.Route.RuleSet,
+		option.RuleSet{Type: C.RuleSetTypeLocal, Tag: "corp", Format: C.RuleSetFormatSource, LocalOptions: option.LocalRuleSet{Path: "corp.json"}},
+		option.RuleSet{Type: C.RuleSetTypeLocal, Tag: "missing", Format: C.RuleSetFormatSource, LocalOptions: option.LocalRuleSet{Path: "missing.json"}},
+	)
+	routeRule := func(ruleSet string) option.Rule {
+		return newRouteRule(option.RawDefaultRule{RuleSet: []string{ruleSet}}, OutboundBypassTag)
+	}
+	options.Route.Rules = append(options.Route.Rules, routeRule("missing"), routeRule("corp"))
+
+	explanation, err := ExplainRoute(nil, options, report, RouteRequest{Host: "git.corp.internal", Port: 443})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if explanation.Rule == nil || explanation.Rule.Index != len(options.Route.Rules)-1 || explanation.Outbound != OutboundBypassTag {
+		t.Errorf("rule = %+v, outbound %s", explanation.Rule, explanation.Outbound)
+	}
+	var reported bool
+	for _, note := range explanation.Notes {
+		reported = reported || strings.HasPrefix(note, "local rule-set missing is not loaded")
+	}
+	if !reported {
+		t.Errorf("missing local rule-set is not reported: %q", explanation.Notes)
+	}
+}
+
+type countingRuleSet struct {
+	adapter.RuleSet
+	refs int
+}
+
+func (s *countingRuleSet) IncRef() { s.refs++ }
+
+func (s *countingRuleSet) DecRef() { s.refs-- }
+
+func (s *countingRuleSet) Match(metadata *adapter.InboundContext) bool {
+	return metadata.Domain == "ads.example.com"
+}
+
+type countingRouter struct {
+	adapter.Router
+	ruleSet *countingRuleSet
+}
+
+func (r countingRouter) RuleSet(tag string) (adapter.RuleSet, bool) {
+	return r.ruleSet, tag == "ads"
+}
+
+func TestExplainRouteKeepsRuleSetRefs(t *testing.T) {
+	router := countingRouter{ruleSet: &countingRuleSet{}}
+	core := service.ContextWith[adapter.Router](context.Background(), adapter.Router(router))
+	ctx := withUncountedRuleSets(core)
+	rule, err := R.NewRule(ctx, log.NewNOPFactory().Logger(), newRouteRule(option.RawDefaultRule{RuleSet: []string{"ads"}}, OutboundBlockTag), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if matched, err := matchRule(rule, &adapter.InboundContext{Domain: "ads.example.com"}); err != nil || !matched {
+			t.Fatalf("match = %v, %v", matched, err)
+		}
+	}
+	if router.ruleSet.refs != 0 {
+		t.Errorf("refs = %d after explaining", router.ruleSet.refs)
+	}
+	// подмена не попадает в реестр ядра
+	if _, isCore := service.FromContext[adapter.Router](core).(countingRouter); !isCore {
+		t.Errorf("core router is replaced")
+	}
+}
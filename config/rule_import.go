@@ -0,0 +1,646 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/balancer"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	geositeRuleSetURL = "https://raw.githubusercontent.com/SagerNet/sing-geosite/rule-set/geosite-%s.srs"
+	geoipRuleSetURL   = "https://raw.githubusercontent.com/SagerNet/sing-geoip/rule-set/geoip-%s.srs"
+
+	// GEOIP,LAN у Clash и geoip:private у v2ray
+	privateIPCIDR = "10.0.0.0/8,100.64.0.0/10,127.0.0.0/8,169.254.0.0/16,172.16.0.0/12,192.168.0.0/16,fc00::/7,fe80::/10,::1/128"
+)
+
+// RuleImport — правила, группы и цепочки из чужого формата, уже в виде
+// настроек RostovVPN: Settings() можно влить в настройки как есть.
+type RuleImport struct {
+	Format         string                 `json:"format"`
+	Rules          []Rule                 `json:"rules"`
+	OutboundGroups []OutboundGroupOptions `json:"outbound-groups"`
+	Chains         []ChainOptions         `json:"chains"`
+	// записи, которые перенести нельзя, и перенесённые с упрощением
+	Unmapped     []ParseEntry `json:"unmapped,omitempty"`
+	Approximated []ParseEntry `json:"approximated,omitempty"`
+}
+
+// Settings — фрагмент настроек с rules, outbound-groups и chains.
+func (r *RuleImport) Settings() ([]byte, error) {
+	return json.MarshalIndent(struct {
+		Rules          []Rule                 `json:"rules"`
+		OutboundGroups []OutboundGroupOptions `json:"outbound-groups"`
+		Chains         []ChainOptions         `json:"chains"`
+	}{r.Rules, r.OutboundGroups, r.Chains}, "", "  ")
+}
+
+// Summary — короткая строка для логов и CLI.
+func (r *RuleImport) Summary() string {
+	summary := fmt.Sprintf("format: %s, rules: %d, groups: %d, chains: %d, unmapped: %d, approximated: %d",
+		r.Format, len(r.Rules), len(r.OutboundGroups), len(r.Chains), len(r.Unmapped), len(r.Approximated))
+	for _, entries := range [][]ParseEntry{r.Unmapped, r.Approximated} {
+		for _, entry := range entries {
+			summary += "\n  "
+			if entry.Line > 0 {
+				summary += "line " + strconv.Itoa(entry.Line) + ": "
+			}
+			if entry.Tag != "" {
+				summary += entry.Tag + ": "
+			}
+			if entry.Content != "" {
+				summary += entry.Content + ": "
+			}
+			summary += entry.Reason
+		}
+	}
+	return summary
+}
+
+func (r *RuleImport) unmapped(line int, tag string, content string, reason string) {
+	r.Unmapped = append(r.Unmapped, ruleImportEntry(line, tag, content, reason))
+}
+
+func (r *RuleImport) approximated(line int, tag string, content string, reason string) {
+	r.Approximated = append(r.Approximated, ruleImportEntry(line, tag, content, reason))
+}
+
+func ruleImportEntry(line int, tag string, content string, reason string) ParseEntry {
+	if len(content) > parseEntryContentLimit {
+		content = content[:parseEntryContentLimit] + "..."
+	}
+	return ParseEntry{Line: line, Tag: tag, Content: content, Reason: reason}
+}
+
+// addRule склеивает подряд идущие правила с одним outbound'ом, если оба только
+// по доменам или только по IP: порядок срабатывания от этого не меняется.
+func (r *RuleImport) addRule(rule Rule) {
+	if n := len(r.Rules); n > 0 {
+		last := &r.Rules[n-1]
+		if last.Outbound == rule.Outbound {
+			if domainsOnly(*last) && domainsOnly(rule) {
+				last.Domains += "," + rule.Domains
+				return
+			}
+			if ipOnly(*last) && ipOnly(rule) {
+				last.IP += "," + rule.IP
+				return
+			}
+		}
+	}
+	r.Rules = append(r.Rules, rule)
+}
+
+func domainsOnly(rule Rule) bool {
+	return rule.Domains != "" && rule.IP == "" && rule.Port == "" && rule.Network == "" && rule.Protocol == "" && rule.RuleSetUrl == "" && rule.IPRuleSetUrl == ""
+}
+
+func ipOnly(rule Rule) bool {
+	return rule.IP != "" && rule.Domains == "" && rule.Port == "" && rule.Network == "" && rule.Protocol == "" && rule.RuleSetUrl == "" && rule.IPRuleSetUrl == ""
+}
+
+// ImportRules переводит правила Clash (rules/proxy-groups/rule-providers из
+// конфига или просто строки вида DOMAIN-SUFFIX,x,Proxy) или маршрутизацию
+// v2ray/Xray/v2rayN (routing.rules и balancers) в правила и группы RostovVPN.
+func ImportRules(content string) (*RuleImport, error) {
+	trimmed := strings.TrimSpace(strings.TrimPrefix(content, "\ufeff"))
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		return importV2rayRouting([]byte(trimmed))
+	}
+	return importClashRules([]byte(content))
+}
+
+// --- Clash ---
+
+type clashRuleDocument struct {
+	Proxies []struct {
+		Name string `yaml:"name"`
+	} `yaml:"proxies"`
+	ProxyGroups   []clashProxyGroup            `yaml:"proxy-groups"`
+	Rules         []string                     `yaml:"rules"`
+	RuleProviders map[string]clashRuleProvider `yaml:"rule-providers"`
+}
+
+type clashProxyGroup struct {
+	Name              string   `yaml:"name"`
+	Type              string   `yaml:"type"`
+	Proxies           []string `yaml:"proxies"`
+	Use               []string `yaml:"use"`
+	Filter            string   `yaml:"filter"`
+	ExcludeFilter     string   `yaml:"exclude-filter"`
+	IncludeAll        bool     `yaml:"include-all"`
+	IncludeAllProxies bool     `yaml:"include-all-proxies"`
+	Strategy          string   `yaml:"strategy"`
+}
+
+type clashRuleProvider struct {
+	Type     string `yaml:"type"`
+	Behavior string `yaml:"behavior"`
+	Format   string `yaml:"format"`
+	URL      string `yaml:"url"`
+	Path     string `yaml:"path"`
+}
+
+var clashRulePattern = regexp.MustCompile(`^[A-Z][A-Z0-9-]*,`)
+
+// yamlSequenceLines — номера строк элементов списка верхнего уровня key.
+func yamlSequenceLines(content []byte, key string) []int {
+	var root yaml.Node
+	if yaml.Unmarshal(content, &root) != nil || len(root.Content) == 0 {
+		return nil
+	}
+	mapping := root.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != key {
+			continue
+		}
+		lines := make([]int, 0, len(mapping.Content[i+1].Content))
+		for _, item := range mapping.Content[i+1].Content {
+			lines = append(lines, item.Line)
+		}
+		return lines
+	}
+	return nil
+}
+
+func lineAt(lines []int, index int) int {
+	if index < len(lines) {
+		return lines[index]
+	}
+	return 0
+}
+
+type clashImporter struct {
+	result    *RuleImport
+	proxies   map[string]bool
+	servers   []string // в порядке файла
+	groups    map[string]*clashProxyGroup
+	imported  map[string]bool // группы и цепочки, которые удалось перенести
+	providers map[string]clashRuleProvider
+}
+
+func importClashRules(content []byte) (*RuleImport, error) {
+	var doc clashRuleDocument
+	ruleLines := yamlSequenceLines(content, "rules")
+	groupLines := yamlSequenceLines(content, "proxy-groups")
+	if err := yaml.Unmarshal(content, &doc); err != nil || (len(doc.Rules) == 0 && len(doc.ProxyGroups) == 0) {
+		// список правил без обёртки — по одному на строку
+		doc = clashRuleDocument{}
+		ruleLines = nil
+		for i, line := range strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n") {
+			line = strings.Trim(strings.TrimPrefix(strings.TrimSpace(line), "- "), `'"`)
+			if clashRulePattern.MatchString(line) {
+				doc.Rules = append(doc.Rules, line)
+				ruleLines = append(ruleLines, i+1)
+			}
+		}
+		if len(doc.Rules) == 0 {
+			return nil, fmt.Errorf("no clash rules or proxy-groups found")
+		}
+	}
+	importer := &clashImporter{
+		result:    &RuleImport{Format: ParseFormatClash},
+		proxies:   make(map[string]bool),
+		groups:    make(map[string]*clashProxyGroup),
+		imported:  make(map[string]bool),
+		providers: doc.RuleProviders,
+	}
+	for _, proxy := range doc.Proxies {
+		importer.proxies[proxy.Name] = true
+		importer.servers = append(importer.servers, proxy.Name)
+	}
+	for i := range doc.ProxyGroups {
+		importer.groups[doc.ProxyGroups[i].Name] = &doc.ProxyGroups[i]
+	}
+	for i := range doc.ProxyGroups {
+		importer.importGroup(lineAt(groupLines, i), &doc.ProxyGroups[i])
+	}
+	for i, line := range doc.Rules {
+		importer.importRule(lineAt(ruleLines, i), line)
+	}
+	return importer.result, nil
+}
+
+var reservedOutboundTags = map[string]bool{
+	OutboundSelectTag: true, OutboundURLTestTag: true, OutboundDNSTag: true, OutboundDirectTag: true,
+	OutboundBypassTag: true, OutboundBlockTag: true, OutboundWarpTag: true, OutboundDirectFragmentTag: true,
+}
+
+func (c *clashImporter) importGroup(line int, group *clashProxyGroup) {
+	result := c.result
+	if group.Name == "" {
+		result.unmapped(line, "", group.Type, "group without name")
+		return
+	}
+	if reservedOutboundTags[group.Name] || c.proxies[group.Name] {
+		result.unmapped(line, group.Name, group.Type, "name clashes with a built-in outbound or a server")
+		return
+	}
+	if strings.EqualFold(group.Type, "relay") {
+		if len(group.Proxies) == 0 {
+			result.unmapped(line, group.Name, group.Type, "relay without proxies")
+			return
+		}
+		for i, member := range group.Proxies {
+			// первым звеном может быть группа, дальше — только серверы
+			if !c.proxies[member] && (i > 0 || c.groups[member] == nil) {
+				result.unmapped(line, group.Name, member, "relay member is not a server")
+				return
+			}
+		}
+		result.Chains = append(result.Chains, ChainOptions{Name: group.Name, Outbounds: group.Proxies})
+		c.imported[group.Name] = true
+		return
+	}
+
+	options := OutboundGroupOptions{Name: group.Name}
+	switch strings.ToLower(group.Type) {
+	case "select", "":
+		options.Type = GroupTypeSelector
+	case "url-test":
+		options.Type = GroupTypeURLTest
+	case "fallback":
+		options.Type = GroupTypeFallback
+	case "load-balance":
+		options.Type = GroupTypeLoadBalance
+		switch group.Strategy {
+		case "consistent-hashing":
+			options.Strategy = balancer.StrategyConsistentHash
+		case "sticky-sessions":
+			options.Strategy, options.Sticky = balancer.StrategyRoundRobin, true
+		default:
+			options.Strategy = balancer.StrategyRoundRobin
+		}
+	default:
+		result.unmapped(line, group.Name, group.Type, "unsupported group type")
+		return
+	}
+
+	servers, dropped := c.groupServers(group, map[string]bool{})
+	switch {
+	case group.IncludeAll || group.IncludeAllProxies:
+		// все серверы — фильтр не нужен
+		options.TagRegex = group.Filter
+	case len(servers) > 0:
+		quoted := make([]string, 0, len(servers))
+		for _, server := range servers {
+			quoted = append(quoted, regexp.QuoteMeta(server))
+		}
+		options.TagRegex = "^(?:" + strings.Join(quoted, "|") + ")$"
+	case group.Filter != "":
+		options.TagRegex = group.Filter
+	default:
+		result.approximated(line, group.Name, "", "no servers resolved, group will use all servers")
+	}
+	if options.TagRegex != "" {
+		if _, err := regexp.Compile(options.TagRegex); err != nil {
+			result.unmapped(line, group.Name, options.TagRegex, "filter is not a valid RE2 regexp")
+			return
+		}
+	}
+	if len(dropped) > 0 {
+		result.approximated(line, group.Name, strings.Join(dropped, ", "), "members are not servers, dropped")
+	}
+	if len(group.Use) > 0 {
+		result.approximated(line, group.Name, strings.Join(group.Use, ", "), "proxy-providers are not imported")
+	}
+	if group.ExcludeFilter != "" {
+		result.approximated(line, group.Name, group.ExcludeFilter, "exclude-filter is not supported")
+	}
+	result.OutboundGroups = append(result.OutboundGroups, options)
+	c.imported[group.Name] = true
+}
+
+// groupServers раскрывает вложенные группы в список серверов: группы
+// RostovVPN состоят только из серверов.
+func (c *clashImporter) groupServers(group *clashProxyGroup, visited map[string]bool) ([]string, []string) {
+	visited[group.Name] = true
+	var servers, dropped []string
+	seen := make(map[string]bool)
+	add := func(server string) {
+		if !seen[server] {
+			seen[server] = true
+			servers = append(servers, server)
+		}
+	}
+	for _, member := range group.Proxies {
+		switch {
+		case c.proxies[member]:
+			add(member)
+		case c.groups[member] != nil && !visited[member]:
+			nested, nestedDropped := c.groupServers(c.groups[member], visited)
+			for _, server := range nested {
+				add(server)
+			}
+			dropped = append(dropped, nestedDropped...)
+		default:
+			dropped = append(dropped, member)
+		}
+	}
+	if group.Filter != "" && len(group.Proxies) == 0 {
+		if filter, err := regexp.Compile(group.Filter); err == nil {
+			for _, name := range c.servers {
+				if filter.MatchString(name) {
+					add(name)
+				}
+			}
+		}
+	}
+	return servers, dropped
+}
+
+// target — куда ведёт правило в терминах RostovVPN.
+func (c *clashImporter) target(line int, content string, name string) (string, bool) {
+	switch strings.ToUpper(name) {
+	case "DIRECT":
+		return "bypass", true
+	case "REJECT", "REJECT-DROP", "REJECT-TINYGIF":
+		return "block", true
+	}
+	if c.imported[name] {
+		return name, true
+	}
+	if c.proxies[name] || c.groups[name] != nil || strings.EqualFold(name, "GLOBAL") || strings.EqualFold(name, "PROXY") {
+		c.result.approximated(line, "", content, fmt.Sprintf("target %s is routed via the main proxy selector", name))
+		return "proxy", true
+	}
+	c.result.unmapped(line, "", content, fmt.Sprintf("unknown target %s", name))
+	return "", false
+}
+
+func (c *clashImporter) importRule(line int, content string) {
+	parts := strings.Split(content, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	kind := strings.ToUpper(parts[0])
+	if kind == "MATCH" || kind == "FINAL" {
+		if len(parts) < 2 {
+			c.result.unmapped(line, "", content, "missing target")
+			return
+		}
+		if outbound, ok := c.target(line, content, parts[1]); ok && outbound != "proxy" {
+			c.result.unmapped(line, "", content, "final outbound is always the main proxy selector")
+		}
+		return
+	}
+	if len(parts) < 3 {
+		c.result.unmapped(line, "", content, "expected TYPE,payload,target")
+		return
+	}
+	payload := parts[1]
+	var rule Rule
+	switch kind {
+	case "DOMAIN":
+		rule.Domains = "full:" + payload
+	case "DOMAIN-SUFFIX":
+		rule.Domains = "domain:" + payload
+	case "DOMAIN-KEYWORD":
+		rule.Domains = "keyword:" + payload
+	case "DOMAIN-REGEX":
+		rule.Domains = "regexp:" + payload
+	case "GEOSITE":
+		rule.RuleSetUrl = fmt.Sprintf(geositeRuleSetURL, strings.ToLower(payload))
+	case "IP-CIDR", "IP-CIDR6":
+		rule.IP = payload
+	case "GEOIP":
+		if strings.EqualFold(payload, "LAN") || strings.EqualFold(payload, "PRIVATE") {
+			rule.IP = privateIPCIDR
+		} else {
+			rule.IPRuleSetUrl = fmt.Sprintf(geoipRuleSetURL, strings.ToLower(payload))
+		}
+	case "DST-PORT":
+		port := strings.ReplaceAll(payload, "-", ":")
+		if _, err := strconv.Atoi(strings.ReplaceAll(port, ":", "")); err != nil {
+			c.result.unmapped(line, "", content, "unsupported port list")
+			return
+		}
+		rule.Port = port
+	case "NETWORK":
+		rule.Network = strings.ToLower(payload)
+	case "RULE-SET":
+		provider, ok := c.providers[payload]
+		if !ok {
+			c.result.unmapped(line, "", content, "unknown rule-provider "+payload)
+			return
+		}
+		if !strings.HasSuffix(strings.ToLower(strings.SplitN(provider.URL, "?", 2)[0]), ".srs") {
+			c.result.unmapped(line, "", content, "only sing-box .srs rule-providers are supported")
+			return
+		}
+		if strings.EqualFold(provider.Behavior, "ipcidr") {
+			rule.IPRuleSetUrl = provider.URL
+		} else {
+			rule.RuleSetUrl = provider.URL
+		}
+	default:
+		c.result.unmapped(line, "", content, "unsupported rule type "+kind)
+		return
+	}
+	outbound, ok := c.target(line, content, parts[2])
+	if !ok {
+		return
+	}
+	rule.Outbound = outbound
+	c.result.addRule(rule)
+}
+
+// --- v2ray / Xray / v2rayN ---
+
+type v2rayRoutingRule struct {
+	Type        string   `json:"type"`
+	Domain      []string `json:"domain"`
+	IP          []string `json:"ip"`
+	Port        any      `json:"port"`
+	Network     string   `json:"network"`
+	Protocol    []string `json:"protocol"`
+	OutboundTag string   `json:"outboundTag"`
+	BalancerTag string   `json:"balancerTag"`
+	Enabled     *bool    `json:"enabled"` // v2rayN
+	Remarks     string   `json:"remarks"`
+
+	InboundTag []string `json:"inboundTag"`
+	Source     []string `json:"source"`
+	SourcePort any      `json:"sourcePort"`
+	User       []string `json:"user"`
+	Attrs      any      `json:"attrs"`
+	Process    []string `json:"process"`
+}
+
+type v2rayBalancer struct {
+	Tag      string   `json:"tag"`
+	Selector []string `json:"selector"`
+	Strategy struct {
+		Type string `json:"type"`
+	} `json:"strategy"`
+}
+
+type v2rayRouting struct {
+	Rules     []json.RawMessage `json:"rules"`
+	Balancers []v2rayBalancer   `json:"balancers"`
+}
+
+func importV2rayRouting(content []byte) (*RuleImport, error) {
+	var routing v2rayRouting
+	if bytes.HasPrefix(content, []byte("[")) {
+		if err := json.Unmarshal(content, &routing.Rules); err != nil {
+			return nil, err
+		}
+	} else {
+		var doc struct {
+			Routing *v2rayRouting `json:"routing"`
+			v2rayRouting
+		}
+		if err := json.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+		routing = doc.v2rayRouting
+		if doc.Routing != nil {
+			routing = *doc.Routing
+		}
+	}
+	if len(routing.Rules) == 0 && len(routing.Balancers) == 0 {
+		return nil, fmt.Errorf("no routing rules found")
+	}
+	result := &RuleImport{Format: ParseFormatV2ray}
+	balancers := make(map[string]bool)
+	for i, item := range routing.Balancers {
+		tag := fmt.Sprintf("balancers[%d]", i)
+		if item.Tag == "" || reservedOutboundTags[item.Tag] {
+			result.unmapped(0, tag, item.Tag, "missing or reserved balancer tag")
+			continue
+		}
+		options := OutboundGroupOptions{Name: item.Tag, Type: GroupTypeURLTest}
+		switch item.Strategy.Type {
+		case "", "leastPing", "leastLoad":
+		case "random", "roundRobin":
+			options.Type, options.Strategy = GroupTypeLoadBalance, balancer.StrategyRoundRobin
+		default:
+			result.approximated(0, tag, item.Strategy.Type, "unknown strategy, using urltest")
+		}
+		// selector в v2ray — префиксы тегов
+		if len(item.Selector) > 0 {
+			quoted := make([]string, 0, len(item.Selector))
+			for _, prefix := range item.Selector {
+				quoted = append(quoted, regexp.QuoteMeta(prefix))
+			}
+			options.TagRegex = "^(?:" + strings.Join(quoted, "|") + ")"
+		}
+		result.OutboundGroups = append(result.OutboundGroups, options)
+		balancers[item.Tag] = true
+	}
+	for i, raw := range routing.Rules {
+		tag := fmt.Sprintf("rules[%d]", i)
+		var compact bytes.Buffer
+		_ = json.Compact(&compact, raw)
+		var rule v2rayRoutingRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			result.unmapped(0, tag, compact.String(), err.Error())
+			continue
+		}
+		if rule.Remarks != "" {
+			tag += " " + rule.Remarks
+		}
+		importV2rayRule(result, tag, compact.String(), rule, balancers)
+	}
+	return result, nil
+}
+
+func importV2rayRule(result *RuleImport, tag string, content string, rule v2rayRoutingRule, balancers map[string]bool) {
+	if rule.Enabled != nil && !*rule.Enabled {
+		result.unmapped(0, tag, content, "rule is disabled")
+		return
+	}
+	for name, set := range map[string]bool{
+		"inboundTag": len(rule.InboundTag) > 0, "source": len(rule.Source) > 0, "sourcePort": rule.SourcePort != nil,
+		"user": len(rule.User) > 0, "attrs": rule.Attrs != nil, "process": len(rule.Process) > 0,
+	} {
+		// без этого условия правило стало бы шире, чем было
+		if set {
+			result.unmapped(0, tag, content, "unsupported condition "+name)
+			return
+		}
+	}
+
+	var converted Rule
+	var domains, ips, ruleSets, ipRuleSets []string
+	for _, item := range rule.Domain {
+		switch {
+		case strings.HasPrefix(item, "geosite:") && !strings.Contains(item, "@"):
+			ruleSets = append(ruleSets, fmt.Sprintf(geositeRuleSetURL, strings.ToLower(strings.TrimPrefix(item, "geosite:"))))
+		case strings.Contains(item, ","):
+			result.unmapped(0, tag, item, "domain item contains a comma")
+		case strings.HasPrefix(item, "domain:"), strings.HasPrefix(item, "full:"),
+			strings.HasPrefix(item, "keyword:"), strings.HasPrefix(item, "regexp:"):
+			domains = append(domains, item)
+		case strings.Contains(item, ":"):
+			result.unmapped(0, tag, item, "unsupported domain item")
+		default:
+			// без префикса v2ray ищет подстроку
+			domains = append(domains, "keyword:"+item)
+		}
+	}
+	for _, item := range rule.IP {
+		switch {
+		case strings.EqualFold(item, "geoip:private"):
+			ips = append(ips, privateIPCIDR)
+		case strings.HasPrefix(item, "geoip:"):
+			ipRuleSets = append(ipRuleSets, fmt.Sprintf(geoipRuleSetURL, strings.ToLower(strings.TrimPrefix(item, "geoip:"))))
+		default:
+			if _, err := netip.ParsePrefix(item); err != nil {
+				if _, err := netip.ParseAddr(item); err != nil {
+					result.unmapped(0, tag, item, "unsupported ip item")
+					continue
+				}
+			}
+			ips = append(ips, item)
+		}
+	}
+	converted.Domains = strings.Join(domains, ",")
+	converted.IP = strings.Join(ips, ",")
+	converted.RuleSetUrl = strings.Join(ruleSets, ",")
+	converted.IPRuleSetUrl = strings.Join(ipRuleSets, ",")
+	if rule.Port != nil {
+		port := strings.ReplaceAll(strings.ReplaceAll(fmt.Sprint(rule.Port), " ", ""), "-", ":")
+		converted.Port = port
+	}
+	switch network := strings.ToLower(strings.ReplaceAll(rule.Network, " ", "")); network {
+	case "tcp", "udp":
+		converted.Network = network
+	}
+	converted.Protocol = strings.Join(rule.Protocol, ",")
+	if converted.Domains == "" && converted.IP == "" && converted.RuleSetUrl == "" && converted.IPRuleSetUrl == "" && converted.Port == "" &&
+		converted.Network == "" && converted.Protocol == "" {
+		result.unmapped(0, tag, content, "no supported conditions")
+		return
+	}
+
+	switch {
+	case rule.BalancerTag != "":
+		if !balancers[rule.BalancerTag] {
+			result.unmapped(0, tag, content, "unknown balancer "+rule.BalancerTag)
+			return
+		}
+		converted.Outbound = rule.BalancerTag
+	case strings.EqualFold(rule.OutboundTag, "direct"):
+		converted.Outbound = "bypass"
+	case strings.EqualFold(rule.OutboundTag, "block"), strings.EqualFold(rule.OutboundTag, "blocked"), strings.EqualFold(rule.OutboundTag, "reject"):
+		converted.Outbound = "block"
+	case strings.EqualFold(rule.OutboundTag, "proxy"):
+		converted.Outbound = "proxy"
+	case rule.OutboundTag == "":
+		result.unmapped(0, tag, content, "missing outboundTag")
+		return
+	default:
+		result.approximated(0, tag, content, fmt.Sprintf("outbound %s is routed via the main proxy selector", rule.OutboundTag))
+		converted.Outbound = "proxy"
+	}
+	result.addRule(converted)
+}
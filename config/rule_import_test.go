@@ -0,0 +1,276 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/balancer"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const clashRulesTestConfig = `proxies:
+  - {name: "HK 1", type: ss, server: 1.1.1.1, port: 443, cipher: aes-128-gcm, password: x}
+  - {name: "HK 2", type: ss, server: 1.1.1.2, port: 443, cipher: aes-128-gcm, password: x}
+  - {name: "US.1", type: ss, server: 1.1.1.3, port: 443, cipher: aes-128-gcm, password: x}
+proxy-groups:
+  - name: HK
+    type: url-test
+    proxies: [HK 1, HK 2]
+  - name: Streaming
+    type: select
+    proxies: [HK, US.1, DIRECT]
+  - name: Balance
+    type: load-balance
+    strategy: consistent-hashing
+    filter: "HK"
+  - name: Relay
+    type: relay
+    proxies: [HK, US.1]
+  - name: auto
+    type: url-test
+    proxies: [US.1]
+rule-providers:
+  ads:
+    type: http
+    behavior: domain
+    url: https://example.com/ads.srs
+  legacy:
+    type: http
+    behavior: domain
+    url: https://example.com/legacy.yaml
+rules:
+  - DOMAIN-SUFFIX,netflix.com,Streaming
+  - DOMAIN-KEYWORD,nflx,Streaming
+  - IP-CIDR,10.0.0.0/8,DIRECT,no-resolve
+  - GEOIP,LAN,DIRECT
+  - RULE-SET,ads,REJECT
+  - RULE-SET,legacy,REJECT
+  - DST-PORT,6881-6889,Relay
+  - PROCESS-NAME,qbittorrent,DIRECT
+  - DOMAIN,one.example.com,US.1
+  - GEOSITE,CN,DIRECT
+  - MATCH,Streaming
+`
+
+func TestImportClashRules(t *testing.T) {
+	result, err := ImportRules(clashRulesTestConfig)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Format != ParseFormatClash {
+		t.Errorf("format = %s", result.Format)
+	}
+
+	want := []Rule{
+		{Domains: "domain:netflix.com,keyword:nflx", Outbound: "Streaming"},
+		{IP: "10.0.0.0/8," + privateIPCIDR, Outbound: "bypass"},
+		{RuleSetUrl: "https://example.com/ads.srs", Outbound: "block"},
+		{Port: "6881:6889", Outbound: "Relay"},
+		{Domains: "full:one.example.com", Outbound: "proxy"},
+		{RuleSetUrl: "https://raw.githubusercontent.com/SagerNet/sing-geosite/rule-set/geosite-cn.srs", Outbound: "bypass"},
+	}
+	if len(result.Rules) != len(want) {
+		t.Fatalf("rules = %+v", result.Rules)
+	}
+	for i := range want {
+		if result.Rules[i] != want[i] {
+			t.Errorf("rule %d = %+v, want %+v", i, result.Rules[i], want[i])
+		}
+	}
+
+	groups := make(map[string]OutboundGroupOptions)
+	for _, group := range result.OutboundGroups {
+		groups[group.Name] = group
+	}
+	if group := groups["HK"]; group.Type != GroupTypeURLTest || group.TagRegex != `^(?:HK 1|HK 2)$` {
+		t.Errorf("HK = %+v", group)
+	}
+	// вложенная группа раскрывается в серверы, DIRECT выбрасывается
+	if group := groups["Streaming"]; group.Type != GroupTypeSelector || group.TagRegex != `^(?:HK 1|HK 2|US\.1)$` {
+		t.Errorf("Streaming = %+v", group)
+	}
+	if group := groups["Balance"]; group.Type != GroupTypeLoadBalance || group.Strategy != balancer.StrategyConsistentHash || group.TagRegex == "" {
+		t.Errorf("Balance = %+v", group)
+	}
+	if _, ok := groups["auto"]; ok {
+		t.Errorf("reserved group name must not be imported")
+	}
+	if len(result.Chains) != 1 || strings.Join(result.Chains[0].Outbounds, ",") != "HK,US.1" {
+		t.Errorf("chains = %+v", result.Chains)
+	}
+
+	unmapped := make(map[int]string)
+	for _, entry := range result.Unmapped {
+		unmapped[entry.Line] = entry.Reason
+	}
+	for _, line := range []int{19, 37, 39, 42} {
+		if _, ok := unmapped[line]; !ok {
+			t.Errorf("line %d must be reported as unmapped: %+v", line, result.Unmapped)
+		}
+	}
+	if len(result.Approximated) == 0 {
+		t.Errorf("dropped DIRECT member and server target must be reported")
+	}
+
+	lines, err := ImportRules("# exported\nDOMAIN-SUFFIX,example.com,DIRECT\n- 'DOMAIN-SUFFIX,example.org,DIRECT'\n")
+	if err != nil {
+		t.Fatalf("plain rules: %v", err)
+	}
+	if len(lines.Rules) != 1 || lines.Rules[0].Domains != "domain:example.com,domain:example.org" {
+		t.Errorf("plain rules = %+v", lines.Rules)
+	}
+}
+
+const v2rayRoutingTestConfig = `{
+  "routing": {
+    "domainStrategy": "IPIfNonMatch",
+    "balancers": [{"tag": "fast", "selector": ["hk-", "sg-"], "strategy": {"type": "leastPing"}}],
+    "rules": [
+      {"type": "field", "domain": ["geosite:category-ads-all"], "outboundTag": "block"},
+      {"type": "field", "domain": ["domain:example.com", "google", "ext:custom.dat:x"], "outboundTag": "direct"},
+      {"type": "field", "ip": ["geoip:private", "1.1.1.1"], "outboundTag": "direct"},
+      {"type": "field", "port": "1000-2000,3000", "network": "tcp,udp", "balancerTag": "fast"},
+      {"type": "field", "inboundTag": ["socks-in"], "outboundTag": "direct"},
+      {"type": "field", "protocol": ["bittorrent"], "outboundTag": "proxy-2"},
+      {"type": "field", "domain": ["full:off.example.com"], "outboundTag": "direct", "enabled": false}
+    ]
+  }
+}`
+
+func TestImportV2rayRouting(t *testing.T) {
+	result, err := ImportRules(v2rayRoutingTestConfig)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	want := []Rule{
+		{RuleSetUrl: "https://raw.githubusercontent.com/SagerNet/sing-geosite/rule-set/geosite-category-ads-all.srs", Outbound: "block"},
+		{Domains: "domain:example.com,keyword:google", Outbound: "bypass"},
+		{IP: privateIPCIDR + ",1.1.1.1", Outbound: "bypass"},
+		{Port: "1000:2000,3000", Outbound: "fast"},
+		{Protocol: "bittorrent", Outbound: "proxy"},
+	}
+	if len(result.Rules) != len(want) {
+		t.Fatalf("rules = %+v", result.Rules)
+	}
+	for i := range want {
+		if result.Rules[i] != want[i] {
+			t.Errorf("rule %d = %+v, want %+v", i, result.Rules[i], want[i])
+		}
+	}
+	if len(result.OutboundGroups) != 1 || result.OutboundGroups[0].Type != GroupTypeURLTest || result.OutboundGroups[0].TagRegex != "^(?:hk-|sg-)" {
+		t.Errorf("groups = %+v", result.OutboundGroups)
+	}
+	// ext:, inboundTag и выключенное правило
+	if len(result.Unmapped) != 3 {
+		t.Errorf("unmapped = %+v", result.Unmapped)
+	}
+	if len(result.Approximated) != 1 {
+		t.Errorf("approximated = %+v", result.Approximated)
+	}
+}
+
+func TestBuildConfigImportedRules(t *testing.T) {
+	result, err := ImportRules(clashRulesTestConfig)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	opt := DefaultRostovVPNOptions()
+	opt.EnableDNSRouting = true
+	opt.Rules = result.Rules
+	// цепочкам нужны серверы профиля, здесь их нет
+	opt.OutboundGroups = result.OutboundGroups
+	options, err := BuildConfig(*opt, option.Options{})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+
+	ruleSets := make(map[string]bool)
+	for _, ruleSet := range options.Route.RuleSet {
+		ruleSets[ruleSet.Tag] = ruleSet.Type == C.RuleSetTypeRemote
+	}
+	adsTag := ruleSetURLTag("rule-", "https://example.com/ads.srs")
+	if !ruleSets[adsTag] {
+		t.Fatalf("remote rule-set %s missing: %+v", adsTag, options.Route.RuleSet)
+	}
+	var routed, dnsBlocked bool
+	for _, rule := range options.Route.Rules {
+		if len(rule.DefaultOptions.RuleSet) == 1 && rule.DefaultOptions.RuleSet[0] == adsTag {
+			routed = rule.DefaultOptions.RouteOptions.Outbound == OutboundBlockTag
+		}
+	}
+	for _, rule := range options.DNS.Rules {
+		if len(rule.DefaultOptions.RuleSet) == 1 && rule.DefaultOptions.RuleSet[0] == adsTag {
+			dnsBlocked = rule.DefaultOptions.Action == C.RuleActionTypePredefined
+		}
+		// правило только по IP не должно превращаться в DNS-правило без условий
+		if rule.Type == C.RuleTypeDefault && !rule.DefaultOptions.IsValid() {
+			t.Errorf("empty dns rule: %+v", rule.DefaultOptions)
+		}
+	}
+	if !routed || !dnsBlocked {
+		t.Errorf("rule-set rule: route %v, dns %v", routed, dnsBlocked)
+	}
+}
+
+func TestImportIPRuleSets(t *testing.T) {
+	result, err := ImportRules(`rule-providers:
+  cn-ip:
+    type: http
+    behavior: ipcidr
+    url: https://example.com/cn.srs
+  cn-site:
+    type: http
+    behavior: domain
+    url: https://mirror.example.com/cn.srs
+rules:
+  - GEOIP,RU,DIRECT
+  - RULE-SET,cn-ip,DIRECT
+  - RULE-SET,cn-site,DIRECT
+`)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	want := []Rule{
+		{IPRuleSetUrl: "https://raw.githubusercontent.com/SagerNet/sing-geoip/rule-set/geoip-ru.srs", Outbound: "bypass"},
+		{IPRuleSetUrl: "https://example.com/cn.srs", Outbound: "bypass"},
+		{RuleSetUrl: "https://mirror.example.com/cn.srs", Outbound: "bypass"},
+	}
+	if len(result.Rules) != len(want) {
+		t.Fatalf("rules = %+v", result.Rules)
+	}
+	for i := range want {
+		if result.Rules[i] != want[i] {
+			t.Errorf("rule %d = %+v, want %+v", i, result.Rules[i], want[i])
+		}
+	}
+
+	opt := DefaultRostovVPNOptions()
+	opt.EnableDNSRouting = true
+	opt.Rules = result.Rules
+	options, err := BuildConfig(*opt, option.Options{})
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	// одноимённые файлы с разных адресов — разные rule-set'ы
+	ipTag := ruleSetURLTag("rule-", "https://example.com/cn.srs")
+	siteTag := ruleSetURLTag("rule-", "https://mirror.example.com/cn.srs")
+	if ipTag == siteTag {
+		t.Fatalf("same tag %s for different URLs", ipTag)
+	}
+	ruleSets := make(map[string]bool)
+	for _, ruleSet := range options.Route.RuleSet {
+		ruleSets[ruleSet.Tag] = true
+	}
+	if !ruleSets[ipTag] || !ruleSets[siteTag] {
+		t.Errorf("rule-sets = %+v", options.Route.RuleSet)
+	}
+	// IP-наборы остаются только в маршрутизации
+	for _, rule := range options.DNS.Rules {
+		for _, tag := range rule.DefaultOptions.RuleSet {
+			if tag != siteTag {
+				t.Errorf("dns rule uses rule-set %s", tag)
+			}
+		}
+	}
+}
@@ -10,12 +10,15 @@ import (
 
 type Rule struct {
 	RuleSetUrl string `json:"rule-set-url"`
-	Domains    string `json:"domains"`
-	IP         string `json:"ip"`
-	Port       string `json:"port"`
-	Network    string `json:"network"`
-	Protocol   string `json:"protocol"`
-	Outbound   string `json:"outbound"`
+	// IPRuleSetUrl — rule-set'ы только с IP (geoip, ipcidr): в DNS-правила они
+	// не попадают, там они матчили бы адреса ответа, а не запрос
+	IPRuleSetUrl string `json:"ip-rule-set-url"`
+	Domains      string `json:"domains"`
+	IP           string `json:"ip"`
+	Port         string `json:"port"`
+	Network      string `json:"network"`
+	Protocol     string `json:"protocol"`
+	Outbound     string `json:"outbound"`
 }
 
 func (r *Rule) MakeRule() option.DefaultRule {
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+const (
+	outlineAccessKeyScheme = "ssconf://"
+	outlineFetchTimeout    = 15 * time.Second
+	outlineFetchLimit      = 1 << 20
+	sip008DefaultTag       = "Shadowsocks"
+	shadowsocksMethodNone  = "none"
+)
+
+// outlineHTTPClient — клиент для динамических ключей Outline, в тестах подменяется.
+var outlineHTTPClient = &http.Client{Timeout: outlineFetchTimeout}
+
+// SIP008Config — онлайн-конфиг Shadowsocks (SIP008). Динамический ключ Outline
+// отдаёт либо его, либо один сервер без обёртки servers.
+type SIP008Config struct {
+	Version int            `json:"version"`
+	Servers []SIP008Server `json:"servers"`
+}
+
+type SIP008Server struct {
+	ID         string `json:"id,omitempty"`
+	Remarks    string `json:"remarks,omitempty"`
+	Server     string `json:"server"`
+	ServerPort uint16 `json:"server_port"`
+	Password   string `json:"password"`
+	Method     string `json:"method"`
+	Plugin     string `json:"plugin,omitempty"`
+	PluginOpts string `json:"plugin_opts,omitempty"`
+	Prefix     string `json:"prefix,omitempty"` // Outline, sing-box не поддерживает: уходит в report.Warnings
+}
+
+// isSIP008Config — корневой объект со списком servers или одиночный сервер
+// Outline: server + method + password и никакого "type".
+func isSIP008Config(obj map[string]any) bool {
+	if _, ok := obj["outbounds"]; ok {
+		return false
+	}
+	if servers, ok := obj["servers"].([]any); ok {
+		for _, raw := range servers {
+			server, _ := raw.(map[string]any)
+			if _, ok := server["method"]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	_, hasType := obj["type"]
+	_, hasServer := obj["server"]
+	_, hasMethod := obj["method"]
+	_, hasPassword := obj["password"]
+	return !hasType && hasServer && hasMethod && hasPassword
+}
+
+func ParseSIP008Config(content []byte) (*SIP008Config, error) {
+	var config SIP008Config
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, err
+	}
+	if config.Servers == nil {
+		var server SIP008Server
+		if err := json.Unmarshal(content, &server); err != nil {
+			return nil, err
+		}
+		config.Servers = []SIP008Server{server}
+	}
+	return &config, nil
+}
+
+// ToOutbounds — по shadowsocks-outbound'у на сервер. Сервер без адреса, порта,
+// метода или пароля (кроме метода none) отклоняется в report, остальные
+// проходят. Outline prefix sing-box не умеет — сервер принимается с
+// предупреждением в report.Warnings.
+func (c *SIP008Config) ToOutbounds(name string, report *ParseReport) []any {
+	outbounds := make([]any, 0, len(c.Servers))
+	for i, server := range c.Servers {
+		tag := server.Remarks
+		if tag == "" {
+			tag = name
+		}
+		if tag == "" {
+			tag = sip008DefaultTag
+		}
+		if len(c.Servers) > 1 && server.Remarks == "" {
+			tag = fmt.Sprintf("%s %d", tag, i+1)
+		}
+		if server.Server == "" || server.ServerPort == 0 || server.Method == "" ||
+			server.Password == "" && server.Method != shadowsocksMethodNone {
+			report.reject(0, "", tag, C.TypeShadowsocks, fmt.Errorf("server %d: missing server, server_port, method or password", i+1))
+			continue
+		}
+		if server.Prefix != "" {
+			report.warn(0, tag, C.TypeShadowsocks, "outline prefix is not supported and is dropped, the server may reject connections")
+		}
+		obj := outboundMap{
+			"type":        C.TypeShadowsocks,
+			"tag":         tag,
+			"server":      server.Server,
+			"server_port": server.ServerPort,
+			"method":      server.Method,
+			"password":    server.Password,
+		}
+		if server.Plugin != "" {
+			obj["plugin"] = server.Plugin
+			obj["plugin_opts"] = server.PluginOpts
+		}
+		outbounds = append(outbounds, map[string]any(obj))
+	}
+	return outbounds
+}
+
+func isOutlineAccessKey(content string) bool {
+	return strings.HasPrefix(strings.TrimSpace(content), outlineAccessKeyScheme)
+}
+
+// FetchOutlineAccessKey скачивает конфиг по динамическому ключу Outline:
+// ssconf://host/path#name → https://host/path. Имя из фрагмента
+// возвращается отдельно — в ответе сервера его обычно нет.
+func FetchOutlineAccessKey(accessKey string) (string, string, error) {
+	accessKey = strings.TrimSpace(accessKey)
+	link, err := url.Parse("https://" + strings.TrimPrefix(accessKey, outlineAccessKeyScheme))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid access key: %w", err)
+	}
+	name := link.Fragment
+	link.Fragment = ""
+	link.RawFragment = ""
+	resp, err := outlineHTTPClient.Get(link.String())
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("request failed with status code: %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, outlineFetchLimit))
+	if err != nil {
+		return "", "", err
+	}
+	return string(body), name, nil
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const sip008TestConfig = `{
+  "version": 1,
+  "servers": [
+    {"id": "1", "remarks": "Frankfurt", "server": "198.51.100.1", "server_port": 8388, "password": "pass-1", "method": "chacha20-ietf-poly1305"},
+    {"id": "2", "remarks": "Obfs", "server": "198.51.100.2", "server_port": 443, "password": "pass-2", "method": "aes-256-gcm", "plugin": "obfs-local", "plugin_opts": "obfs=http;obfs-host=example.com"},
+    {"id": "3", "remarks": "Broken", "server": "", "server_port": 0, "password": "pass-3", "method": "aes-256-gcm"}
+  ]
+}`
+
+func shadowsocksByTag(t *testing.T, content []byte) map[string]*option.ShadowsocksOutboundOptions {
+	t.Helper()
+	outbounds, err := ParseOutbounds(string(content), nil)
+	if err != nil {
+		t.Fatalf("parse result: %v", err)
+	}
+	result := make(map[string]*option.ShadowsocksOutboundOptions)
+	for _, outbound := range outbounds {
+		if outbound.Type == C.TypeShadowsocks {
+			result[outbound.Tag] = outbound.Options.(*option.ShadowsocksOutboundOptions)
+		}
+	}
+	return result
+}
+
+func TestParseSIP008(t *testing.T) {
+	content, report, err := ParseConfigContentWithReport(sip008TestConfig, false, nil, false)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if report.Format != ParseFormatSIP008 {
+		t.Errorf("format = %q", report.Format)
+	}
+	if len(report.Rejected) != 1 || report.Rejected[0].Tag != "Broken" {
+		t.Errorf("expected only Broken to be rejected, got %+v", report.Rejected)
+	}
+	servers := shadowsocksByTag(t, content)
+	frankfurt := servers["Frankfurt"]
+	if frankfurt == nil || frankfurt.Server != "198.51.100.1" || frankfurt.ServerPort != 8388 ||
+		frankfurt.Method != "chacha20-ietf-poly1305" || frankfurt.Password != "pass-1" {
+		t.Errorf("Frankfurt: %+v", frankfurt)
+	}
+	obfs := servers["Obfs"]
+	if obfs == nil || obfs.Plugin != "obfs-local" || obfs.PluginOptions != "obfs=http;obfs-host=example.com" {
+		t.Errorf("Obfs: %+v", obfs)
+	}
+}
+
+func TestParseOutlineAccessKey(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/keys/abc" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"server": "203.0.113.7", "server_port": 12345, "password": "outline-pass", "method": "chacha20-ietf-poly1305"}`)
+	}))
+	defer server.Close()
+	defaultClient := outlineHTTPClient
+	outlineHTTPClient = server.Client()
+	defer func() { outlineHTTPClient = defaultClient }()
+
+	accessKey := "ssconf://" + strings.TrimPrefix(server.URL, "https://") + "/keys/abc#My%20Outline"
+	content, report, err := ParseConfigContentWithReport(accessKey, false, nil, false)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if report.Format != ParseFormatSIP008 {
+		t.Errorf("format = %q", report.Format)
+	}
+	outline := shadowsocksByTag(t, content)["My Outline"]
+	if outline == nil || outline.Server != "203.0.113.7" || outline.ServerPort != 12345 || outline.Password != "outline-pass" {
+		t.Errorf("outline server: %+v", outline)
+	}
+
+	_, _, err = ParseConfigContentWithReport("ssconf://"+strings.TrimPrefix(server.URL, "https://")+"/missing", false, nil, false)
+	if err == nil {
+		t.Errorf("expected error for missing key")
+	}
+}
+
+func TestSIP008Validation(t *testing.T) {
+	config := &SIP008Config{Servers: []SIP008Server{
+		{Remarks: "no-password", Server: "198.51.100.1", ServerPort: 8388, Method: "aes-256-gcm"},
+		{Remarks: "plain", Server: "198.51.100.2", ServerPort: 8388, Method: "none"},
+		{Remarks: "prefixed", Server: "198.51.100.3", ServerPort: 443, Method: "chacha20-ietf-poly1305", Password: "pass", Prefix: "\u0016\u0003\u0001"},
+	}}
+	report := &ParseReport{}
+	outbounds := config.ToOutbounds("", report)
+	if len(outbounds) != 2 {
+		t.Fatalf("outbounds = %v", outbounds)
+	}
+	if len(report.Rejected) != 1 || report.Rejected[0].Tag != "no-password" {
+		t.Errorf("rejected = %+v", report.Rejected)
+	}
+	if len(report.Warnings) != 1 || report.Warnings[0].Tag != "prefixed" {
+		t.Errorf("warnings = %+v", report.Warnings)
+	}
+	if !strings.Contains(report.Summary(), "warning: prefixed: outline prefix") {
+		t.Errorf("summary = %s", report.Summary())
+	}
+}
@@ -30,15 +30,18 @@ type SingboxConfig struct {
 	MTU           int      `json:"mtu"`
 }
 
+// Reserved — байты reserved в пакетах WireGuard к Warp: начало client id.
+// По ним Cloudflare находит устройство, без них часть эндпоинтов не отвечает.
+func (c WarpWireguardConfig) Reserved() [3]byte {
+	var reserved [3]byte
+	clientID, _ := base64.StdEncoding.DecodeString(c.ClientID)
+	copy(reserved[:], clientID)
+	return reserved
+}
+
 func wireGuardConfigToMap(wgConfig WarpWireguardConfig, server string, port uint16) (outboundMap, error) {
-	clientID, _ := base64.StdEncoding.DecodeString(wgConfig.ClientID)
-	if len(clientID) < 3 {
-		clientID = append(clientID, make([]byte, 3-len(clientID))...)
-	}
-	reserved := []int{0, 0, 0}
-	for i := 0; i < len(reserved) && i < len(clientID); i++ {
-		reserved[i] = int(clientID[i])
-	}
+	clientReserved := wgConfig.Reserved()
+	reserved := []int{int(clientReserved[0]), int(clientReserved[1]), int(clientReserved[2])}
 	obj := outboundMap{
 		"type":            C.TypeWireGuard,
 		"tag":             "WARP",
@@ -122,7 +125,7 @@ func GenerateWarpSingbox(wgConfig WarpWireguardConfig, host string, port uint16,
 
 func GenerateWarpInfo(license string, oldAccountId string, oldAccessToken string) (*warp.Identity, string, *WarpWireguardConfig, error) {
 	if oldAccountId != "" && oldAccessToken != "" {
-		err := warp.DeleteDevice(oldAccessToken, oldAccountId)
+		err := deleteWarpDevice(oldAccountId, oldAccessToken)
 		if err != nil {
 			fmt.Printf("Error in removing old device: %v\n", err)
 		} else {
@@ -149,37 +152,33 @@ func GenerateWarpInfo(license string, oldAccountId string, oldAccessToken string
 	return &identity, res, &warpcfg, err
 }
 
-func getOrGenerateWarpLocallyIfNeeded(warpOptions *WarpOptions) WarpWireguardConfig {
+func deleteWarpDevice(accountId string, accessToken string) error {
+	return warp.DeleteDevice(accessToken, accountId)
+}
+
+// savedWarpConfig — ключи p1/p2 из настроек или сохранённые раньше; новое
+// устройство здесь не регистрируется.
+func savedWarpConfig(warpOptions *WarpOptions) WarpWireguardConfig {
 	if warpOptions.WireguardConfig.PrivateKey != "" {
 		return warpOptions.WireguardConfig
 	}
-	table := db.GetTable[WarpOptions]()
-	dbWarpOptions, err := table.Get(warpOptions.Id)
+	dbWarpOptions, err := db.GetTable[WarpOptions]().Get(warpOptions.Id)
 	if err == nil && dbWarpOptions.WireguardConfig.PrivateKey != "" {
-		return warpOptions.WireguardConfig
-	}
-	license := ""
-	if len(warpOptions.Id) == 26 { // warp key is 26 characters long
-		license = warpOptions.Id
-	} else if len(warpOptions.Id) > 28 && warpOptions.Id[2] == '_' { // warp key is 26 characters long
-		license = warpOptions.Id[3:]
+		return dbWarpOptions.WireguardConfig
 	}
+	return WarpWireguardConfig{}
+}
 
-	accountidentity, _, wireguardConfig, err := GenerateWarpInfo(license, warpOptions.Account.AccountID, warpOptions.Account.AccessToken)
-	if err != nil {
-		return WarpWireguardConfig{}
+func licenseFromWarpId(id string) string {
+	if len(id) == 26 { // warp key is 26 characters long
+		return id
+	} else if len(id) > 28 && id[2] == '_' { // warp key is 26 characters long
+		return id[3:]
 	}
-	warpOptions.Account = WarpAccount{
-		AccountID:   accountidentity.ID,
-		AccessToken: accountidentity.Token,
-	}
-	warpOptions.WireguardConfig = *wireguardConfig
-	table.UpdateInsert(warpOptions)
-
-	return *wireguardConfig
+	return ""
 }
 
-func patchWarpMap(obj outboundMap, configOpt *RostovVPNOptions, final bool, staticIPs map[string][]string) (outboundMap, error) {
+func patchWarpMap(obj outboundMap, configOpt *RostovVPNOptions, final bool, staticIPs map[string][]string, accounts *warpAccounts) (outboundMap, error) {
 	if staticIPs == nil {
 		staticIPs = make(map[string][]string)
 	}
@@ -207,7 +206,7 @@ func patchWarpMap(obj outboundMap, configOpt *RostovVPNOptions, final bool, stat
 		fakePacketsDelay, _ := warpInfo["fake_packets_delay"].(string)
 		fakePacketsMode, _ := warpInfo["fake_packets_mode"].(string)
 
-		isSavedKey := len(key) > 1 && key[0] == 'p'
+		isSavedKey := (len(key) > 1 && key[0] == 'p') || accounts.get(key) != nil
 		if (configOpt == nil || !final) && isSavedKey {
 			return obj, nil
 		}
@@ -217,17 +216,10 @@ func patchWarpMap(obj outboundMap, configOpt *RostovVPNOptions, final bool, stat
 			wireguardConfig WarpWireguardConfig
 		)
 		if isSavedKey {
-			var warpOpt *WarpOptions
-			switch key {
-			case "p1":
-				warpOpt = &configOpt.Warp
-			case "p2":
-				warpOpt = &configOpt.Warp2
-			default:
-				warpOpt = &WarpOptions{Id: key}
+			wireguardConfig, err = resolveWarpKey(key, configOpt, accounts)
+			if err != nil {
+				return nil, err
 			}
-			warpOpt.Id = key
-			wireguardConfig = getOrGenerateWarpLocallyIfNeeded(warpOpt)
 		} else {
 			_, _, wgConfig, genErr := GenerateWarpInfo(key, "", "")
 			if genErr != nil {
@@ -263,7 +255,16 @@ func patchWarpMap(obj outboundMap, configOpt *RostovVPNOptions, final bool, stat
 
 	if final && strings.EqualFold(obj.string("type"), C.TypeWireGuard) {
 		host := obj.string("server")
-		if host == "default" || host == "random" || host == "auto" || host == "auto4" || host == "auto6" || isBlockedDomain(host) {
+		var cleanAddress string
+		var cleanPort uint16
+		if host == "auto" || host == "auto4" || host == "auto6" {
+			cleanAddress, cleanPort, _ = bestWarpCleanEndpoint(host)
+		}
+		if cleanAddress != "" {
+			// найденный сканером эндпоинт вместо случайного
+			obj["server"] = cleanAddress
+			obj["server_port"] = float64(cleanPort)
+		} else if host == "default" || host == "random" || host == "auto" || host == "auto4" || host == "auto6" || isBlockedDomain(host) {
 			rndDomain := strings.ToLower(generateRandomString(20))
 			staticIPs[rndDomain] = []string{}
 			if host != "auto4" {
@@ -285,9 +286,9 @@ func patchWarpMap(obj outboundMap, configOpt *RostovVPNOptions, final bool, stat
 			if mtuValue, ok := obj.float64("mtu"); !ok || mtuValue < 100 {
 				obj["mtu"] = 1280
 			}
-			obj["fake_packets"] = ""
-			obj["fake_packets_delay"] = ""
-			obj["fake_packets_size"] = ""
+			delete(obj, "fake_packets")
+			delete(obj, "fake_packets_delay")
+			delete(obj, "fake_packets_size")
 		}
 	}
 	return obj, nil
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/v2/db"
+	"github.com/Darkmen203/rostovvpn-core/v2/warpscan"
+)
+
+const warpCleanEndpointsId = "best"
+
+// WarpCleanEndpoints — лучшие эндпоинты Warp по результатам последнего сканирования.
+type WarpCleanEndpoints struct {
+	Id        string
+	Endpoints []WarpCleanEndpoint
+	Updated   time.Time
+}
+
+type WarpCleanEndpoint struct {
+	Address string
+	Port    uint16
+	RTT     time.Duration
+}
+
+// SaveWarpCleanEndpoints запоминает эндпоинты (лучший — первым). Warp-аутбаунды
+// без явного clean-ip (хост auto/auto4/auto6) берут их вместо случайного адреса.
+func SaveWarpCleanEndpoints(endpoints []WarpCleanEndpoint) error {
+	return db.GetTable[WarpCleanEndpoints]().UpdateInsert(&WarpCleanEndpoints{
+		Id:        warpCleanEndpointsId,
+		Endpoints: endpoints,
+		Updated:   time.Now(),
+	})
+}
+
+func SaveWarpScanResults(results []warpscan.Result) error {
+	endpoints := make([]WarpCleanEndpoint, 0, len(results))
+	for _, result := range results {
+		endpoints = append(endpoints, WarpCleanEndpoint{
+			Address: result.Endpoint.Addr().String(),
+			Port:    result.Endpoint.Port(),
+			RTT:     result.RTT,
+		})
+	}
+	return SaveWarpCleanEndpoints(endpoints)
+}
+
+func LoadWarpCleanEndpoints() []WarpCleanEndpoint {
+	stored, err := db.GetTable[WarpCleanEndpoints]().Get(warpCleanEndpointsId)
+	if err != nil || stored == nil {
+		return nil
+	}
+	return stored.Endpoints
+}
+
+// bestWarpCleanEndpoint выбирает первый сохранённый эндпоинт, подходящий под
+// режим хоста (auto4/auto6 ограничивают семейство адресов).
+func bestWarpCleanEndpoint(host string) (string, uint16, bool) {
+	for _, endpoint := range LoadWarpCleanEndpoints() {
+		addr, err := netip.ParseAddr(endpoint.Address)
+		if err != nil {
+			continue
+		}
+		if (host == "auto4" && !addr.Is4()) || (host == "auto6" && !addr.Is6()) {
+			continue
+		}
+		return endpoint.Address, endpoint.Port, true
+	}
+	return "", 0, false
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sagernet/sing-box/option"
+)
+
+// warpMode — режим Warp, влияющий на сборку прокси, или "" без него.
+func warpMode(opt *RostovVPNOptions) string {
+	if !opt.Warp.EnableWarp {
+		return ""
+	}
+	mode := strings.ToLower(strings.TrimSpace(opt.Warp.Mode))
+	if mode != ProxyOverWarp && mode != WarpOverProxy {
+		return ""
+	}
+	return mode
+}
+
+// applyWarpMode ставит Warp перед каждым прокси и эндпоинтом (proxy_over_warp:
+// они подключаются через Warp) или за ними (warp_over_proxy: Warp идёт через
+// главный селектор, выход в интернет — Cloudflare). В обоих режимах аутбаунд
+// Warp один: у аккаунта одно устройство, и несколько туннелей с одним ключом
+// выбивали бы друг друга. Без включённого Warp или с другим режимом прокси
+// возвращаются как есть.
+func applyWarpMode(opt *RostovVPNOptions, proxies []option.Outbound, endpoints []option.Endpoint, staticIPs map[string][]string, report *BuildReport) ([]option.Outbound, []option.Endpoint, error) {
+	mode := warpMode(opt)
+	if mode == "" {
+		return proxies, endpoints, nil
+	}
+	for _, proxy := range proxies {
+		if proxy.Tag == OutboundWarpTag {
+			return nil, nil, fmt.Errorf("warp mode %s: tag %s already in use", mode, OutboundWarpTag)
+		}
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.Tag == OutboundWarpTag {
+			return nil, nil, fmt.Errorf("warp mode %s: tag %s already in use", mode, OutboundWarpTag)
+		}
+	}
+	if opt.Warp.Id == "" {
+		opt.Warp.Id = "p1"
+	}
+	wgConfig, err := resolveWarpKey(opt.Warp.Id, opt, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("warp mode %s: %w", mode, err)
+	}
+	if wgConfig.PrivateKey == "" {
+		return nil, nil, fmt.Errorf("warp mode %s: warp account is not available", mode)
+	}
+
+	if mode == WarpOverProxy {
+		// выбор прокси остаётся в select, а маршруты в прокси ведут в Warp (mainProxyTag)
+		warpOutbound, err := warpModeOutbound(opt, wgConfig, OutboundWarpTag, OutboundSelectTag, staticIPs)
+		if err != nil {
+			return nil, nil, err
+		}
+		return append(proxies, warpOutbound), endpoints, nil
+	}
+
+	warpOutbound, err := warpModeOutbound(opt, wgConfig, OutboundWarpTag, "", staticIPs)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := []option.Outbound{warpOutbound}
+	for _, proxy := range proxies {
+		// прокси без dialer-опций или со своим detour идёт мимо Warp
+		detoured, err := detouredOutbound(proxy, proxy.Tag, OutboundWarpTag)
+		if err != nil {
+			report.ignore("warp.mode", proxy.Tag, "proxy_over_warp: "+err.Error())
+			result = append(result, proxy)
+			continue
+		}
+		result = append(result, detoured)
+	}
+	resultEndpoints := make([]option.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		detoured, err := detouredEndpoint(endpoint, OutboundWarpTag)
+		if err != nil {
+			report.ignore("warp.mode", endpoint.Tag, "proxy_over_warp: "+err.Error())
+			resultEndpoints = append(resultEndpoints, endpoint)
+			continue
+		}
+		resultEndpoints = append(resultEndpoints, detoured)
+	}
+	return result, resultEndpoints, nil
+}
+
+// mainProxyTag — куда ведут маршруты «в прокси»: в warp_over_proxy это Warp
+// поверх select, правила на пользовательские группы и цепочки идут мимо Warp.
+func mainProxyTag(opt *RostovVPNOptions) string {
+	if warpMode(opt) == WarpOverProxy {
+		return OutboundWarpTag
+	}
+	return OutboundSelectTag
+}
+
+// warpModeOutbound собирает Warp-аутбаунд и прогоняет его через финальный
+// patchWarpMap: случайный эндпоинт, а при detour — MTU 1280 и без шума.
+func warpModeOutbound(opt *RostovVPNOptions, wgConfig WarpWireguardConfig, tag string, detour string, staticIPs map[string][]string) (option.Outbound, error) {
+	warp := opt.Warp
+	warpOutbound, err := GenerateWarpSingbox(wgConfig, warp.CleanIP, warp.CleanPort, warp.FakePackets, warp.FakePacketSize, warp.FakePacketDelay, warp.FakePacketMode)
+	if err != nil {
+		return option.Outbound{}, fmt.Errorf("warp mode: %w", err)
+	}
+	obj, err := outboundToMap(*warpOutbound)
+	if err != nil {
+		return option.Outbound{}, fmt.Errorf("warp mode: %w", err)
+	}
+	obj["tag"] = tag
+	if detour != "" {
+		obj["detour"] = detour
+	}
+	obj, err = patchWarpMap(obj, opt, true, staticIPs, nil)
+	if err != nil {
+		return option.Outbound{}, fmt.Errorf("warp mode: %w", err)
+	}
+	return mapToOutbound(obj)
+}
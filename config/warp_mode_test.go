@@ -0,0 +1,162 @@
+package config
+
+import (
+	"net/netip"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/json/badoption"
+)
+
+func warpModeTestOptions(t *testing.T, mode string) *RostovVPNOptions {
+	opt := explainTestOptions(t)
+	opt.Warp.EnableWarp = true
+	opt.Warp.Mode = mode
+	opt.Warp.CleanIP = "162.159.192.1"
+	opt.Warp.CleanPort = 2408
+	opt.Warp.WireguardConfig = WarpWireguardConfig{
+		PrivateKey:       "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+		PeerPublicKey:    "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+		LocalAddressIPv4: "172.16.0.2",
+	}
+	return opt
+}
+
+var warpModeTestInput = option.Options{Outbounds: append([]option.Outbound{{
+	Type: C.TypeSOCKS,
+	Tag:  "second",
+	Options: &option.SOCKSOutboundOptions{
+		ServerOptions: option.ServerOptions{Server: "1.0.0.1", ServerPort: 1080},
+	},
+}}, explainTestInput.Outbounds...)}
+
+func findOutbound(options *option.Options, tag string) *option.Outbound {
+	for i := range options.Outbounds {
+		if options.Outbounds[i].Tag == tag {
+			return &options.Outbounds[i]
+		}
+	}
+	return nil
+}
+
+func outboundDetour(outbound *option.Outbound) string {
+	if dialer, ok := outbound.Options.(option.DialerOptionsWrapper); ok {
+		return dialer.TakeDialerOptions().Detour
+	}
+	return ""
+}
+
+func TestWarpOverProxy(t *testing.T) {
+	opt := warpModeTestOptions(t, WarpOverProxy)
+	options, err := BuildConfig(*opt, warpModeTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	// один Warp на все прокси: одно устройство аккаунта
+	warps := 0
+	for _, outbound := range options.Outbounds {
+		if outbound.Type == C.TypeWireGuard {
+			warps++
+		}
+	}
+	warp := findOutbound(options, OutboundWarpTag)
+	if warps != 1 || warp == nil {
+		t.Fatalf("warp outbounds: %d", warps)
+	}
+	if detour := outboundDetour(warp); detour != OutboundSelectTag {
+		t.Errorf("warp detour = %s", detour)
+	}
+	selector := findOutbound(options, OutboundSelectTag).Options.(*option.SelectorOutboundOptions)
+	for _, tag := range []string{"second", "server"} {
+		if !containsFold(selector.Outbounds, tag) {
+			t.Errorf("select lacks proxy %s: %v", tag, selector.Outbounds)
+		}
+		if findOutbound(options, tag) == nil {
+			t.Errorf("proxy %s is missing", tag)
+		}
+	}
+	if containsFold(selector.Outbounds, OutboundWarpTag) {
+		t.Errorf("select must not contain warp: %v", selector.Outbounds)
+	}
+	if options.Route.Final != OutboundWarpTag {
+		t.Errorf("final = %s", options.Route.Final)
+	}
+	// правила «в прокси» тоже идут через Warp
+	global := false
+	for _, rule := range options.Route.Rules {
+		if rule.DefaultOptions.ClashMode == ClashModeGlobal {
+			global = rule.DefaultOptions.RouteOptions.Outbound == OutboundWarpTag
+		}
+	}
+	if !global {
+		t.Errorf("global mode must route to warp")
+	}
+}
+
+func TestProxyOverWarp(t *testing.T) {
+	opt := warpModeTestOptions(t, ProxyOverWarp)
+	options, err := BuildConfig(*opt, warpModeTestInput)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if findOutbound(options, OutboundWarpTag) == nil {
+		t.Fatalf("warp outbound is missing")
+	}
+	for _, tag := range []string{"second", "server"} {
+		if detour := outboundDetour(findOutbound(options, tag)); detour != OutboundWarpTag {
+			t.Errorf("%s detour = %s", tag, detour)
+		}
+	}
+	if options.Route.Final != OutboundSelectTag {
+		t.Errorf("final = %s", options.Route.Final)
+	}
+}
+
+func TestProxyOverWarpEndpointsAndIgnored(t *testing.T) {
+	opt := warpModeTestOptions(t, ProxyOverWarp)
+	input := warpModeTestInput
+	input.Outbounds = append([]option.Outbound{{
+		Type: C.TypeSOCKS,
+		Tag:  "chained",
+		Options: &option.SOCKSOutboundOptions{
+			DialerOptions: option.DialerOptions{Detour: "second"},
+			ServerOptions: option.ServerOptions{Server: "1.0.0.2", ServerPort: 1080},
+		},
+	}}, input.Outbounds...)
+	input.Endpoints = []option.Endpoint{{
+		Type: C.TypeWireGuard,
+		Tag:  "conf",
+		Options: &option.WireGuardEndpointOptions{
+			PrivateKey: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+			Address:    badoption.Listable[netip.Prefix]{netip.MustParsePrefix("10.0.0.2/32")},
+			Peers: []option.WireGuardPeer{{
+				Address:    "198.51.100.9",
+				Port:       51820,
+				PublicKey:  "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+				AllowedIPs: badoption.Listable[netip.Prefix]{netip.MustParsePrefix("0.0.0.0/0")},
+			}},
+		},
+	}}
+	options, report, err := BuildConfigWithReport(*opt, input)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if len(options.Endpoints) != 1 {
+		t.Fatalf("endpoints = %+v", options.Endpoints)
+	}
+	if detour := options.Endpoints[0].Options.(*option.WireGuardEndpointOptions).Detour; detour != OutboundWarpTag {
+		t.Errorf("endpoint detour = %s", detour)
+	}
+	// прокси со своим detour остаётся как есть и попадает в отчёт
+	if detour := outboundDetour(findOutbound(options, "chained")); detour != "second" {
+		t.Errorf("chained detour = %s", detour)
+	}
+	ignored := false
+	for _, item := range report.Ignored {
+		ignored = ignored || item.Option == "warp.mode" && item.Target == "chained"
+	}
+	if !ignored {
+		t.Errorf("chained proxy is not reported: %+v", report.Ignored)
+	}
+}
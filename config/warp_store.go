@@ -0,0 +1,229 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/v2/db"
+	"github.com/bepass-org/warp-plus/warp"
+)
+
+// ErrWarpDeviceRemains — аккаунт убран из хранилища, но его устройство
+// осталось у Cloudflare.
+var ErrWarpDeviceRemains = errors.New("warp device is not deleted at cloudflare")
+
+// ErrWarpAccountNotFound — warp://<key> ссылается на аккаунт, которого нет ни в
+// хранилище, ни в настройках; создаётся он только явно через CreateWarpAccount.
+var ErrWarpAccountNotFound = errors.New("warp account not found")
+
+// CreateWarpAccount регистрирует новое устройство Warp (с лицензией, если задана)
+// и сохраняет его под именем name. Существующий аккаунт с тем же именем
+// заменяется; его устройство удаляется у Cloudflare только после регистрации
+// нового, чтобы при ошибке регистрации старый аккаунт продолжал работать.
+func CreateWarpAccount(name string, license string) (*db.WarpAccountRecord, error) {
+	if name == "" {
+		return nil, fmt.Errorf("warp account name is empty")
+	}
+	old := db.GetWarpAccount(name)
+	identity, _, wgConfig, err := GenerateWarpInfo(license, "", "")
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	account := &db.WarpAccountRecord{
+		Id:          name,
+		License:     license,
+		AccountID:   identity.ID,
+		AccessToken: identity.Token,
+		Created:     now,
+		Updated:     now,
+	}
+	setWarpAccountConfig(account, *wgConfig)
+	setWarpAccountStatus(account, identity.Account)
+	if err := db.WarpAccounts().UpdateInsert(account); err != nil {
+		return nil, err
+	}
+	if old != nil {
+		// новый аккаунт уже сохранён: неудачное удаление оставит лишь лишнее устройство
+		if err := deleteWarpDevice(old.AccountID, old.AccessToken); err != nil {
+			fmt.Printf("Error in removing old device: %v\n", err)
+		}
+	}
+	return account, nil
+}
+
+// BindWarpLicense привязывает лицензию (Warp+) к сохранённому аккаунту.
+func BindWarpLicense(name string, license string) (*db.WarpAccountRecord, error) {
+	account, err := storedWarpAccount(name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := warp.UpdateAccount(account.AccessToken, account.AccountID, license)
+	if err != nil {
+		return nil, fmt.Errorf("warp account %s: bind license: %w", name, err)
+	}
+	account.License = license
+	setWarpAccountStatus(account, status)
+	return account, saveWarpAccount(account)
+}
+
+// RotateWarpKey выпускает новый ключ устройства, аккаунт и лицензия сохраняются.
+func RotateWarpKey(name string) (*db.WarpAccountRecord, error) {
+	account, err := storedWarpAccount(name)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := warp.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	identity, err := warp.UpdateSourceDevice(account.AccessToken, account.AccountID, privateKey.PublicKey().String())
+	if err != nil {
+		return nil, fmt.Errorf("warp account %s: rotate key: %w", name, err)
+	}
+	account.PrivateKey = privateKey.String()
+	if len(identity.Config.Peers) > 0 {
+		account.PeerPublicKey = identity.Config.Peers[0].PublicKey
+	}
+	if identity.Config.Interface.Addresses.V4 != "" {
+		account.LocalAddressIPv4 = identity.Config.Interface.Addresses.V4
+		account.LocalAddressIPv6 = identity.Config.Interface.Addresses.V6
+	}
+	if identity.Config.ClientID != "" {
+		account.ClientID = identity.Config.ClientID
+	}
+	return account, saveWarpAccount(account)
+}
+
+// RefreshWarpAccount запрашивает у Cloudflare тип аккаунта, статус Warp+ и квоту.
+func RefreshWarpAccount(name string) (*db.WarpAccountRecord, error) {
+	account, err := storedWarpAccount(name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := warp.GetAccount(account.AccessToken, account.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("warp account %s: get status: %w", name, err)
+	}
+	setWarpAccountStatus(account, status)
+	return account, saveWarpAccount(account)
+}
+
+// DeleteWarpAccount удаляет устройство у Cloudflare так же, как GenerateWarpInfo
+// при перевыпуске, и убирает аккаунт из хранилища. Локальная запись удаляется
+// и при ошибке Cloudflare: тогда возвращается ошибка с ErrWarpDeviceRemains.
+func DeleteWarpAccount(name string) error {
+	account, err := storedWarpAccount(name)
+	if err != nil {
+		return err
+	}
+	remoteErr := deleteWarpDevice(account.AccountID, account.AccessToken)
+	if err := db.WarpAccounts().Delete(name); err != nil {
+		return err
+	}
+	if remoteErr != nil {
+		return fmt.Errorf("warp account %s: %w: %w", name, ErrWarpDeviceRemains, remoteErr)
+	}
+	return nil
+}
+
+// WarpAccountWireguardConfig — WireGuard-параметры аккаунта для генерации аутбаунда.
+func WarpAccountWireguardConfig(account *db.WarpAccountRecord) WarpWireguardConfig {
+	return WarpWireguardConfig{
+		PrivateKey:       account.PrivateKey,
+		LocalAddressIPv4: account.LocalAddressIPv4,
+		LocalAddressIPv6: account.LocalAddressIPv6,
+		PeerPublicKey:    account.PeerPublicKey,
+		ClientID:         account.ClientID,
+	}
+}
+
+// ResolveWarpAccount возвращает WireGuard-ключи аккаунта по имени (по умолчанию p1)
+// так же, как при разборе warp://<name>.
+func ResolveWarpAccount(name string, configOpt *RostovVPNOptions) (WarpWireguardConfig, error) {
+	if configOpt == nil {
+		configOpt = DefaultRostovVPNOptions()
+	}
+	if name == "" {
+		name = "p1"
+	}
+	return resolveWarpKey(name, configOpt, nil)
+}
+
+// warpAccounts — сохранённые аккаунты, прочитанные из хранилища один раз за
+// сборку; nil читает хранилище при каждом обращении.
+type warpAccounts struct {
+	loaded bool
+	byName map[string]*db.WarpAccountRecord
+}
+
+func (w *warpAccounts) get(name string) *db.WarpAccountRecord {
+	if w == nil {
+		return db.GetWarpAccount(name)
+	}
+	if !w.loaded {
+		w.loaded = true
+		w.byName = make(map[string]*db.WarpAccountRecord)
+		accounts, _ := db.ListWarpAccounts()
+		for _, account := range accounts {
+			w.byName[account.Id] = account
+		}
+	}
+	return w.byName[name]
+}
+
+// resolveWarpKey находит WireGuard-конфиг для warp://<key>. Явные ключи
+// Warp/Warp2 из настроек важнее аккаунта p1/p2 из хранилища; затем хранилище
+// и сохранённые раньше p1/p2. Новые устройства здесь не регистрируются.
+func resolveWarpKey(key string, configOpt *RostovVPNOptions, accounts *warpAccounts) (WarpWireguardConfig, error) {
+	var warpOpt *WarpOptions
+	switch key {
+	case "p1":
+		warpOpt = &configOpt.Warp
+	case "p2":
+		warpOpt = &configOpt.Warp2
+	}
+	if warpOpt != nil && warpOpt.WireguardConfig.PrivateKey != "" {
+		return warpOpt.WireguardConfig, nil
+	}
+	if account := accounts.get(key); account != nil && account.PrivateKey != "" {
+		return WarpAccountWireguardConfig(account), nil
+	}
+	if warpOpt != nil {
+		warpOpt.Id = key
+		if wgConfig := savedWarpConfig(warpOpt); wgConfig.PrivateKey != "" {
+			return wgConfig, nil
+		}
+	}
+	return WarpWireguardConfig{}, fmt.Errorf("%w: %s", ErrWarpAccountNotFound, key)
+}
+
+func storedWarpAccount(name string) (*db.WarpAccountRecord, error) {
+	account := db.GetWarpAccount(name)
+	if account == nil {
+		return nil, fmt.Errorf("warp account %s not found", name)
+	}
+	return account, nil
+}
+
+func saveWarpAccount(account *db.WarpAccountRecord) error {
+	account.Updated = time.Now()
+	return db.WarpAccounts().UpdateInsert(account)
+}
+
+func setWarpAccountConfig(account *db.WarpAccountRecord, wgConfig WarpWireguardConfig) {
+	account.PrivateKey = wgConfig.PrivateKey
+	account.PeerPublicKey = wgConfig.PeerPublicKey
+	account.LocalAddressIPv4 = wgConfig.LocalAddressIPv4
+	account.LocalAddressIPv6 = wgConfig.LocalAddressIPv6
+	account.ClientID = wgConfig.ClientID
+}
+
+func setWarpAccountStatus(account *db.WarpAccountRecord, status warp.IdentityAccount) {
+	account.AccountType = status.AccountType
+	account.WarpPlus = status.WarpPlus
+	account.Quota = status.Quota
+	account.Usage = status.Usage
+	account.PremiumData = status.PremiumData
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Darkmen203/rostovvpn-core/v2/db"
+)
+
+func TestWarpAccountsReadOnce(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := db.WarpAccounts().UpdateInsert(&db.WarpAccountRecord{Id: "home", PrivateKey: "key"}); err != nil {
+		t.Fatal(err)
+	}
+	accounts := &warpAccounts{}
+	if account := accounts.get("home"); account == nil || account.PrivateKey != "key" {
+		t.Fatalf("home = %+v", account)
+	}
+	// хранилище читается один раз за сборку
+	if err := db.WarpAccounts().UpdateInsert(&db.WarpAccountRecord{Id: "work", PrivateKey: "key"}); err != nil {
+		t.Fatal(err)
+	}
+	if accounts.get("work") != nil {
+		t.Errorf("accounts must be read once")
+	}
+	var direct *warpAccounts
+	if direct.get("work") == nil {
+		t.Errorf("nil cache must read the store")
+	}
+}
+
+func TestResolveWarpKey(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := db.WarpAccounts().UpdateInsert(&db.WarpAccountRecord{Id: "p1", PrivateKey: "stored"}); err != nil {
+		t.Fatal(err)
+	}
+	opt := DefaultRostovVPNOptions()
+	if config, err := resolveWarpKey("p1", opt, nil); err != nil || config.PrivateKey != "stored" {
+		t.Errorf("p1 = %+v, %v", config, err)
+	}
+	// явные ключи из настроек важнее аккаунта в хранилище
+	opt.Warp.WireguardConfig.PrivateKey = "explicit"
+	if config, err := resolveWarpKey("p1", opt, nil); err != nil || config.PrivateKey != "explicit" {
+		t.Errorf("p1 = %+v, %v", config, err)
+	}
+	// неизвестный аккаунт не регистрируется у Cloudflare
+	for _, key := range []string{"p2", "p3"} {
+		if _, err := resolveWarpKey(key, opt, &warpAccounts{}); !errors.Is(err, ErrWarpAccountNotFound) {
+			t.Errorf("%s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package config
+
+import "testing"
+
+func TestWarpReserved(t *testing.T) {
+	cases := map[string][3]byte{
+		"":         {},
+		"AQID":     {1, 2, 3},
+		"AQIDBA==": {1, 2, 3},
+		"AQ==":     {1, 0, 0},
+	}
+	for clientID, want := range cases {
+		if reserved := (WarpWireguardConfig{ClientID: clientID}).Reserved(); reserved != want {
+			t.Errorf("%q: %v, want %v", clientID, reserved, want)
+		}
+	}
+}
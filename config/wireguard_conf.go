@@ -0,0 +1,543 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	singjson "github.com/sagernet/sing/common/json"
+)
+
+const (
+	wireGuardDefaultTag = "WireGuard"
+	warpConfEndpoint    = "engage.cloudflareclient.com:2408"
+	warpConfMTU         = 1280
+)
+
+// WireGuardConfig — содержимое wg-quick .conf: секция [Interface] и любое число [Peer].
+type WireGuardConfig struct {
+	Name      string // из комментария "# Name = ..." (так пишут некоторые клиенты)
+	Interface WireGuardInterface
+	Peers     []WireGuardPeer
+}
+
+type WireGuardInterface struct {
+	PrivateKey string
+	Address    []string
+	DNS        []string
+	MTU        uint32
+	ListenPort uint16
+}
+
+type WireGuardPeer struct {
+	PublicKey           string
+	PresharedKey        string
+	AllowedIPs          []string
+	Endpoint            string
+	PersistentKeepalive uint16
+	Reserved            []int // нестандартный ключ, встречается в конфигах Warp
+}
+
+func ReadWireGuardConfig(path string) (*WireGuardConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWireGuardConfig(string(content))
+}
+
+// isWireGuardConfig — есть ли в тексте секция [Interface] в начале строки.
+func isWireGuardConfig(content string) bool {
+	for _, line := range strings.Split(content, "\n") {
+		if strings.EqualFold(strings.TrimSpace(line), "[Interface]") {
+			return true
+		}
+	}
+	return false
+}
+
+func ParseWireGuardConfig(content string) (*WireGuardConfig, error) {
+	var (
+		wgConfig WireGuardConfig
+		section  string
+		lineNum  int
+	)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			if key, value, ok := splitWireGuardLine(strings.TrimSpace(line[1:])); ok && strings.EqualFold(key, "Name") && wgConfig.Name == "" {
+				wgConfig.Name = value
+			}
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.ToLower(strings.TrimSpace(line[1 : len(line)-1]))
+			if section == "peer" {
+				wgConfig.Peers = append(wgConfig.Peers, WireGuardPeer{})
+			}
+			continue
+		}
+		key, value, ok := splitWireGuardLine(line)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNum)
+		}
+		var err error
+		switch section {
+		case "interface":
+			err = parseInterfaceConfig(&wgConfig.Interface, key, value)
+		case "peer":
+			err = parsePeerConfig(&wgConfig.Peers[len(wgConfig.Peers)-1], key, value)
+		default:
+			err = fmt.Errorf("key %s outside of section", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if wgConfig.Interface.PrivateKey == "" {
+		return nil, fmt.Errorf("missing PrivateKey in [Interface]")
+	}
+	if len(wgConfig.Peers) == 0 {
+		return nil, fmt.Errorf("no [Peer] sections")
+	}
+	for i, peer := range wgConfig.Peers {
+		if peer.PublicKey == "" {
+			return nil, fmt.Errorf("peer %d: missing PublicKey", i+1)
+		}
+	}
+	return &wgConfig, nil
+}
+
+func splitWireGuardLine(line string) (string, string, bool) {
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return "", "", false
+	}
+	// ключи base64 оканчиваются на "=", поэтому режем только по первому
+	return strings.TrimSpace(key), strings.TrimSpace(value), true
+}
+
+func splitWireGuardList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseInterfaceConfig(interfaceConfig *WireGuardInterface, key string, value string) error {
+	switch strings.ToLower(key) {
+	case "privatekey":
+		interfaceConfig.PrivateKey = value
+	case "address":
+		interfaceConfig.Address = append(interfaceConfig.Address, splitWireGuardList(value)...)
+	case "dns":
+		interfaceConfig.DNS = append(interfaceConfig.DNS, splitWireGuardList(value)...)
+	case "mtu":
+		mtu, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid MTU: %s", value)
+		}
+		interfaceConfig.MTU = uint32(mtu)
+	case "listenport":
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid ListenPort: %s", value)
+		}
+		interfaceConfig.ListenPort = uint16(port)
+	}
+	// Table, PreUp, PostDown и т.п. относятся к wg-quick на хосте — пропускаем
+	return nil
+}
+
+func parsePeerConfig(peerConfig *WireGuardPeer, key string, value string) error {
+	switch strings.ToLower(key) {
+	case "publickey":
+		peerConfig.PublicKey = value
+	case "presharedkey":
+		peerConfig.PresharedKey = value
+	case "allowedips":
+		peerConfig.AllowedIPs = append(peerConfig.AllowedIPs, splitWireGuardList(value)...)
+	case "endpoint":
+		peerConfig.Endpoint = value
+	case "persistentkeepalive":
+		if strings.EqualFold(value, "off") {
+			peerConfig.PersistentKeepalive = 0
+			return nil
+		}
+		interval, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid PersistentKeepalive: %s", value)
+		}
+		peerConfig.PersistentKeepalive = uint16(interval)
+	case "reserved":
+		peerConfig.Reserved = nil
+		for _, item := range splitWireGuardList(value) {
+			b, err := strconv.ParseUint(item, 10, 8)
+			if err != nil {
+				return fmt.Errorf("invalid Reserved: %s", value)
+			}
+			peerConfig.Reserved = append(peerConfig.Reserved, int(b))
+		}
+	}
+	return nil
+}
+
+// ToEndpoint собирает wireguard-эндпоинт sing-box. В отличие от устаревшего
+// wireguard-аутбаунда он хранит у каждого пира AllowedIPs и PersistentKeepalive;
+// пир без AllowedIPs получает весь трафик, как при импорте в клиентах.
+func (c *WireGuardConfig) ToEndpoint(tag string) (outboundMap, error) {
+	if tag == "" {
+		tag = c.Name
+	}
+	if tag == "" {
+		tag = wireGuardDefaultTag
+	}
+	var addresses []string
+	for _, address := range c.Interface.Address {
+		prefix, err := parseWireGuardPrefix(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Address %s: %w", address, err)
+		}
+		addresses = append(addresses, prefix.String())
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("missing Address in [Interface]")
+	}
+	obj := outboundMap{
+		"type":        C.TypeWireGuard,
+		"tag":         tag,
+		"address":     addresses,
+		"private_key": c.Interface.PrivateKey,
+	}
+	if c.Interface.MTU != 0 {
+		obj["mtu"] = c.Interface.MTU
+	}
+	if c.Interface.ListenPort != 0 {
+		obj["listen_port"] = c.Interface.ListenPort
+	}
+	peers := make([]map[string]any, 0, len(c.Peers))
+	for i, peer := range c.Peers {
+		if peer.Endpoint == "" {
+			return nil, fmt.Errorf("peer %d: missing Endpoint", i+1)
+		}
+		host, port, err := splitWireGuardEndpoint(peer.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("peer %d: %w", i+1, err)
+		}
+		allowedIPs := []string{"0.0.0.0/0", "::/0"}
+		if len(peer.AllowedIPs) > 0 {
+			allowedIPs = nil
+			for _, allowedIP := range peer.AllowedIPs {
+				prefix, err := parseWireGuardPrefix(allowedIP)
+				if err != nil {
+					return nil, fmt.Errorf("peer %d: invalid AllowedIPs %s: %w", i+1, allowedIP, err)
+				}
+				allowedIPs = append(allowedIPs, prefix.String())
+			}
+		}
+		peerObj := map[string]any{
+			"address":     host,
+			"port":        port,
+			"public_key":  peer.PublicKey,
+			"allowed_ips": allowedIPs,
+		}
+		if peer.PresharedKey != "" {
+			peerObj["pre_shared_key"] = peer.PresharedKey
+		}
+		if peer.PersistentKeepalive != 0 {
+			peerObj["persistent_keepalive_interval"] = peer.PersistentKeepalive
+		}
+		if len(peer.Reserved) > 0 {
+			peerObj["reserved"] = peer.Reserved
+		}
+		peers = append(peers, peerObj)
+	}
+	obj["peers"] = peers
+	return obj, nil
+}
+
+// String — текст в формате wg-quick.
+func (c *WireGuardConfig) String() string {
+	var sb strings.Builder
+	if c.Name != "" {
+		fmt.Fprintf(&sb, "# Name = %s\n", c.Name)
+	}
+	sb.WriteString("[Interface]\n")
+	fmt.Fprintf(&sb, "PrivateKey = %s\n", c.Interface.PrivateKey)
+	if len(c.Interface.Address) > 0 {
+		fmt.Fprintf(&sb, "Address = %s\n", strings.Join(c.Interface.Address, ", "))
+	}
+	if len(c.Interface.DNS) > 0 {
+		fmt.Fprintf(&sb, "DNS = %s\n", strings.Join(c.Interface.DNS, ", "))
+	}
+	if c.Interface.MTU != 0 {
+		fmt.Fprintf(&sb, "MTU = %d\n", c.Interface.MTU)
+	}
+	if c.Interface.ListenPort != 0 {
+		fmt.Fprintf(&sb, "ListenPort = %d\n", c.Interface.ListenPort)
+	}
+	for _, peer := range c.Peers {
+		sb.WriteString("\n[Peer]\n")
+		fmt.Fprintf(&sb, "PublicKey = %s\n", peer.PublicKey)
+		if peer.PresharedKey != "" {
+			fmt.Fprintf(&sb, "PresharedKey = %s\n", peer.PresharedKey)
+		}
+		allowedIPs := peer.AllowedIPs
+		if len(allowedIPs) == 0 {
+			allowedIPs = []string{"0.0.0.0/0", "::/0"}
+		}
+		fmt.Fprintf(&sb, "AllowedIPs = %s\n", strings.Join(allowedIPs, ", "))
+		if peer.Endpoint != "" {
+			fmt.Fprintf(&sb, "Endpoint = %s\n", peer.Endpoint)
+		}
+		if peer.PersistentKeepalive != 0 {
+			fmt.Fprintf(&sb, "PersistentKeepalive = %d\n", peer.PersistentKeepalive)
+		}
+		if len(peer.Reserved) > 0 {
+			reserved := make([]string, len(peer.Reserved))
+			for i, b := range peer.Reserved {
+				reserved[i] = strconv.Itoa(b)
+			}
+			fmt.Fprintf(&sb, "Reserved = %s\n", strings.Join(reserved, ","))
+		}
+	}
+	return sb.String()
+}
+
+// WireGuardConfigFromOutbound превращает wireguard-аутбаунд обратно в .conf.
+// Хосты auto/auto4/auto6 (Warp) заменяются сохранённым чистым эндпоинтом
+// или стандартным engage.cloudflareclient.com.
+func WireGuardConfigFromOutbound(outbound option.Outbound) (*WireGuardConfig, error) {
+	if outbound.Type != C.TypeWireGuard {
+		return nil, fmt.Errorf("outbound %s is %s, not wireguard", outbound.Tag, outbound.Type)
+	}
+	wgOptions, ok := outbound.Options.(*option.LegacyWireGuardOutboundOptions)
+	if !ok || wgOptions == nil {
+		return nil, fmt.Errorf("outbound %s: unexpected wireguard options", outbound.Tag)
+	}
+	wgConfig := &WireGuardConfig{
+		Name: outbound.Tag,
+		Interface: WireGuardInterface{
+			PrivateKey: wgOptions.PrivateKey,
+			MTU:        wgOptions.MTU,
+		},
+	}
+	for _, prefix := range wgOptions.LocalAddress {
+		wgConfig.Interface.Address = append(wgConfig.Interface.Address, prefix.String())
+	}
+	if len(wgOptions.Peers) == 0 {
+		endpoint, err := wireGuardConfEndpoint(wgOptions.Server, wgOptions.ServerPort)
+		if err != nil {
+			return nil, fmt.Errorf("outbound %s: %w", outbound.Tag, err)
+		}
+		wgConfig.Peers = append(wgConfig.Peers, WireGuardPeer{
+			PublicKey:    wgOptions.PeerPublicKey,
+			PresharedKey: wgOptions.PreSharedKey,
+			Endpoint:     endpoint,
+			Reserved:     reservedInts(wgOptions.Reserved),
+		})
+	}
+	for _, peer := range wgOptions.Peers {
+		endpoint, err := wireGuardConfEndpoint(peer.Server, peer.ServerPort)
+		if err != nil {
+			return nil, fmt.Errorf("outbound %s: %w", outbound.Tag, err)
+		}
+		confPeer := WireGuardPeer{
+			PublicKey:    peer.PublicKey,
+			PresharedKey: peer.PreSharedKey,
+			Endpoint:     endpoint,
+			Reserved:     reservedInts(peer.Reserved),
+		}
+		for _, prefix := range peer.AllowedIPs {
+			confPeer.AllowedIPs = append(confPeer.AllowedIPs, prefix.String())
+		}
+		wgConfig.Peers = append(wgConfig.Peers, confPeer)
+	}
+	return wgConfig, nil
+}
+
+// WireGuardConfigFromEndpoint превращает wireguard-эндпоинт обратно в .conf.
+func WireGuardConfigFromEndpoint(endpoint option.Endpoint) (*WireGuardConfig, error) {
+	if endpoint.Type != C.TypeWireGuard {
+		return nil, fmt.Errorf("endpoint %s is %s, not wireguard", endpoint.Tag, endpoint.Type)
+	}
+	wgOptions, ok := endpoint.Options.(*option.WireGuardEndpointOptions)
+	if !ok || wgOptions == nil {
+		return nil, fmt.Errorf("endpoint %s: unexpected wireguard options", endpoint.Tag)
+	}
+	wgConfig := &WireGuardConfig{
+		Name: endpoint.Tag,
+		Interface: WireGuardInterface{
+			PrivateKey: wgOptions.PrivateKey,
+			MTU:        wgOptions.MTU,
+			ListenPort: wgOptions.ListenPort,
+		},
+	}
+	for _, prefix := range wgOptions.Address {
+		wgConfig.Interface.Address = append(wgConfig.Interface.Address, prefix.String())
+	}
+	for _, peer := range wgOptions.Peers {
+		address, err := wireGuardConfEndpoint(peer.Address, peer.Port)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %s: %w", endpoint.Tag, err)
+		}
+		confPeer := WireGuardPeer{
+			PublicKey:           peer.PublicKey,
+			PresharedKey:        peer.PreSharedKey,
+			Endpoint:            address,
+			PersistentKeepalive: peer.PersistentKeepaliveInterval,
+			Reserved:            reservedInts(peer.Reserved),
+		}
+		for _, prefix := range peer.AllowedIPs {
+			confPeer.AllowedIPs = append(confPeer.AllowedIPs, prefix.String())
+		}
+		wgConfig.Peers = append(wgConfig.Peers, confPeer)
+	}
+	return wgConfig, nil
+}
+
+func reservedInts(reserved []uint8) []int {
+	if len(reserved) == 0 {
+		return nil
+	}
+	result := make([]int, len(reserved))
+	for i, b := range reserved {
+		result[i] = int(b)
+	}
+	return result
+}
+
+// WarpWireGuardConf — .conf для учётки Warp, пригодный для других клиентов.
+func WarpWireGuardConf(name string, wgConfig WarpWireguardConfig) *WireGuardConfig {
+	conf := &WireGuardConfig{
+		Name: name,
+		Interface: WireGuardInterface{
+			PrivateKey: wgConfig.PrivateKey,
+			DNS:        []string{"1.1.1.1", "1.0.0.1", "2606:4700:4700::1111", "2606:4700:4700::1001"},
+			MTU:        warpConfMTU,
+		},
+	}
+	if wgConfig.LocalAddressIPv4 != "" {
+		conf.Interface.Address = append(conf.Interface.Address, wgConfig.LocalAddressIPv4+"/32")
+	}
+	if wgConfig.LocalAddressIPv6 != "" {
+		conf.Interface.Address = append(conf.Interface.Address, wgConfig.LocalAddressIPv6+"/128")
+	}
+	endpoint, _ := wireGuardConfEndpoint("auto", 0)
+	reserved := wgConfig.Reserved()
+	conf.Peers = []WireGuardPeer{{
+		PublicKey:  wgConfig.PeerPublicKey,
+		AllowedIPs: []string{"0.0.0.0/0", "::/0"},
+		Endpoint:   endpoint,
+		// без reserved Cloudflare не узнает устройство
+		Reserved: []int{int(reserved[0]), int(reserved[1]), int(reserved[2])},
+	}}
+	return conf
+}
+
+// ExportWarpAccountConf возвращает .conf сохранённого аккаунта Warp (p1/p2 или имя из хранилища).
+func ExportWarpAccountConf(name string, configOpt *RostovVPNOptions) (string, error) {
+	wgConfig, err := ResolveWarpAccount(name, configOpt)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		name = "p1"
+	}
+	return WarpWireGuardConf(name, wgConfig).String(), nil
+}
+
+func wireGuardConfEndpoint(server string, port uint16) (string, error) {
+	switch server {
+	case "auto", "auto4", "auto6":
+		if address, cleanPort, ok := bestWarpCleanEndpoint(server); ok {
+			return net.JoinHostPort(address, strconv.Itoa(int(cleanPort))), nil
+		}
+		return warpConfEndpoint, nil
+	case "":
+		return "", fmt.Errorf("missing server")
+	}
+	if port == 0 {
+		return "", fmt.Errorf("missing server port for %s", server)
+	}
+	return net.JoinHostPort(server, strconv.Itoa(int(port))), nil
+}
+
+func splitWireGuardEndpoint(endpoint string) (string, uint16, error) {
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid Endpoint %s: %w", endpoint, err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return "", 0, fmt.Errorf("invalid Endpoint port %s", endpoint)
+	}
+	return host, uint16(port), nil
+}
+
+// parseWireGuardPrefix принимает адрес с маской или без (тогда /32 или /128).
+func parseWireGuardPrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		return netip.ParsePrefix(value)
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// ExportWireGuardConfs разбирает профиль любого поддерживаемого формата и
+// возвращает .conf для каждого wireguard-аутбаунда и эндпоинта (ключ — тег).
+func ExportWireGuardConfs(content string, configOpt *RostovVPNOptions) (map[string]string, error) {
+	parsed, err := ParseConfigContent(content, false, configOpt, false)
+	if err != nil {
+		return nil, err
+	}
+	options, err := singjson.UnmarshalExtendedContext[option.Options](decodeContext(), parsed)
+	if err != nil {
+		return nil, err
+	}
+	confs := make(map[string]string)
+	for _, endpoint := range options.Endpoints {
+		if endpoint.Type != C.TypeWireGuard {
+			continue
+		}
+		wgConfig, err := WireGuardConfigFromEndpoint(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		confs[endpoint.Tag] = wgConfig.String()
+	}
+	for _, outbound := range options.Outbounds {
+		if outbound.Type != C.TypeWireGuard {
+			continue
+		}
+		wgConfig, err := WireGuardConfigFromOutbound(outbound)
+		if err != nil {
+			return nil, err
+		}
+		confs[outbound.Tag] = wgConfig.String()
+	}
+	if len(confs) == 0 {
+		return nil, fmt.Errorf("no wireguard outbounds found")
+	}
+	return confs, nil
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+	singjson "github.com/sagernet/sing/common/json"
+)
+
+const wireGuardTestConf = `# Name = home
+[Interface]
+PrivateKey = yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=
+Address = 10.0.0.2/32, fd00::2/128
+DNS = 1.1.1.1
+MTU = 1420
+
+[Peer]
+PublicKey = xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=
+PresharedKey = 4Zq1W2xvL6CK5l5r0TNhgYy1B3Lh8dZxY1Y3pfWcSbE=
+AllowedIPs = 10.0.0.0/24, 192.168.1.0/24
+Endpoint = vpn.example.com:51820
+PersistentKeepalive = 25
+Reserved = 1, 2, 3
+`
+
+func wireGuardTestEndpoint(t *testing.T, wgConfig *WireGuardConfig) option.Endpoint {
+	t.Helper()
+	obj, err := wgConfig.ToEndpoint("")
+	if err != nil {
+		t.Fatalf("to endpoint: %v", err)
+	}
+	content, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint, err := singjson.UnmarshalExtendedContext[option.Endpoint](decodeContext(), content)
+	if err != nil {
+		t.Fatalf("decode endpoint: %v", err)
+	}
+	return endpoint
+}
+
+func TestWireGuardConfEndpoint(t *testing.T) {
+	wgConfig, err := ParseWireGuardConfig(wireGuardTestConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint := wireGuardTestEndpoint(t, wgConfig)
+	if endpoint.Type != C.TypeWireGuard || endpoint.Tag != "home" {
+		t.Fatalf("endpoint = %s/%s", endpoint.Type, endpoint.Tag)
+	}
+	wgOptions := endpoint.Options.(*option.WireGuardEndpointOptions)
+	if len(wgOptions.Peers) != 1 {
+		t.Fatalf("peers = %d", len(wgOptions.Peers))
+	}
+	peer := wgOptions.Peers[0]
+	if peer.Address != "vpn.example.com" || peer.Port != 51820 {
+		t.Errorf("peer address = %s:%d", peer.Address, peer.Port)
+	}
+	if peer.PersistentKeepaliveInterval != 25 {
+		t.Errorf("keepalive = %d", peer.PersistentKeepaliveInterval)
+	}
+	if !reflect.DeepEqual(peer.Reserved, []uint8{1, 2, 3}) {
+		t.Errorf("reserved = %v", peer.Reserved)
+	}
+	// единственный пир не схлопывается: AllowedIPs сохраняются
+	if len(peer.AllowedIPs) != 2 || peer.AllowedIPs[1].String() != "192.168.1.0/24" {
+		t.Errorf("allowed ips = %v", peer.AllowedIPs)
+	}
+}
+
+func TestWireGuardConfRoundTrip(t *testing.T) {
+	wgConfig, err := ParseWireGuardConfig(wireGuardTestConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exported, err := WireGuardConfigFromEndpoint(wireGuardTestEndpoint(t, wgConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reparsed, err := ParseWireGuardConfig(exported.String())
+	if err != nil {
+		t.Fatalf("parse exported: %v\n%s", err, exported)
+	}
+	// DNS в эндпоинте sing-box не хранится
+	want := *wgConfig
+	want.Interface.DNS = nil
+	if !reflect.DeepEqual(reparsed, &want) {
+		t.Errorf("round trip:\n%+v\nwant\n%+v", reparsed, &want)
+	}
+}
+
+func TestWireGuardConfFromOutboundReserved(t *testing.T) {
+	content := `{"type": "wireguard", "tag": "legacy", "server": "198.51.100.1", "server_port": 2408,
+		"local_address": ["172.16.0.2/32"], "private_key": "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=",
+		"peer_public_key": "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=", "reserved": [7, 8, 9]}`
+	outbound, err := singjson.UnmarshalExtendedContext[option.Outbound](decodeContext(), []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wgConfig, err := WireGuardConfigFromOutbound(outbound)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reparsed, err := ParseWireGuardConfig(wgConfig.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reparsed.Peers) != 1 || !reflect.DeepEqual(reparsed.Peers[0].Reserved, []int{7, 8, 9}) {
+		t.Errorf("peers = %+v", reparsed.Peers)
+	}
+}
+
+func TestWarpWireGuardConfReserved(t *testing.T) {
+	conf := WarpWireGuardConf("p1", WarpWireguardConfig{
+		PrivateKey:       "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=",
+		PeerPublicKey:    "bmXOC+F1FxEMF9dyiK2H5/1SUtzH0JuVo51h2wPfgyo=",
+		LocalAddressIPv4: "172.16.0.2",
+		ClientID:         "AQID",
+	})
+	content := conf.String()
+	if !strings.Contains(content, "Reserved = 1,2,3\n") {
+		t.Errorf("no reserved:\n%s", content)
+	}
+	reparsed, err := ParseWireGuardConfig(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reparsed.Peers[0].Reserved, []int{1, 2, 3}) {
+		t.Errorf("reserved = %v", reparsed.Peers[0].Reserved)
+	}
+}
@@ -0,0 +1,299 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	C "github.com/sagernet/sing-box/constant"
+)
+
+// Клиентский конфиг Xray: outbounds[] с protocol/settings/streamSettings.
+// Разбираются только поля, которые имеют смысл в sing-box.
+type xrayConfig struct {
+	Outbounds []xrayOutbound `json:"outbounds"`
+}
+
+type xrayOutbound struct {
+	Tag            string              `json:"tag"`
+	Protocol       string              `json:"protocol"`
+	Settings       xraySettings        `json:"settings"`
+	StreamSettings *xrayStreamSettings `json:"streamSettings"`
+}
+
+type xraySettings struct {
+	Vnext   []xrayServer `json:"vnext"`
+	Servers []xrayServer `json:"servers"`
+}
+
+type xrayServer struct {
+	Address  string     `json:"address"`
+	Port     uint16     `json:"port"`
+	Users    []xrayUser `json:"users"`
+	Password string     `json:"password"`
+	Method   string     `json:"method"`
+	Flow     string     `json:"flow"`
+}
+
+type xrayUser struct {
+	ID       string `json:"id"`
+	Flow     string `json:"flow"`
+	Security string `json:"security"`
+	AlterID  int    `json:"alterId"`
+	User     string `json:"user"`
+	Pass     string `json:"pass"`
+}
+
+type xrayStreamSettings struct {
+	Network             string            `json:"network"`
+	Security            string            `json:"security"`
+	TLSSettings         *xrayTLS          `json:"tlsSettings"`
+	RealitySettings     *xrayReality      `json:"realitySettings"`
+	WSSettings          *xrayPathSettings `json:"wsSettings"`
+	HTTPUpgradeSettings *xrayPathSettings `json:"httpupgradeSettings"`
+	GRPCSettings        *struct {
+		ServiceName string `json:"serviceName"`
+	} `json:"grpcSettings"`
+	HTTPSettings *struct {
+		Host []string `json:"host"`
+		Path string   `json:"path"`
+	} `json:"httpSettings"`
+}
+
+type xrayTLS struct {
+	ServerName    string   `json:"serverName"`
+	AllowInsecure bool     `json:"allowInsecure"`
+	ALPN          []string `json:"alpn"`
+	Fingerprint   string   `json:"fingerprint"`
+}
+
+type xrayReality struct {
+	ServerName  string `json:"serverName"`
+	Fingerprint string `json:"fingerprint"`
+	PublicKey   string `json:"publicKey"`
+	ShortID     string `json:"shortId"`
+}
+
+type xrayPathSettings struct {
+	Path    string            `json:"path"`
+	Host    string            `json:"host"`
+	Headers map[string]string `json:"headers"`
+}
+
+// служебные outbound'ы Xray, у RostovVPN для них свои
+var xrayServiceProtocols = map[string]bool{
+	"freedom":   true,
+	"blackhole": true,
+	"dns":       true,
+	"loopback":  true,
+}
+
+// isXrayConfig — хотя бы один outbound описан в стиле Xray (protocol вместо type).
+func isXrayConfig(obj map[string]any) bool {
+	outbounds, ok := obj["outbounds"].([]any)
+	if !ok {
+		return false
+	}
+	for _, raw := range outbounds {
+		outbound, _ := raw.(map[string]any)
+		if _, hasType := outbound["type"]; hasType {
+			return false
+		}
+		if _, hasProtocol := outbound["protocol"]; hasProtocol {
+			return true
+		}
+	}
+	return false
+}
+
+// XrayToOutbounds конвертирует outbounds Xray в sing-box. Неподдерживаемые
+// протоколы и транспорты попадают в report.Rejected.
+func XrayToOutbounds(content []byte, report *ParseReport) ([]any, error) {
+	var config xrayConfig
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, err
+	}
+	var outbounds []any
+	for i, outbound := range config.Outbounds {
+		if xrayServiceProtocols[outbound.Protocol] {
+			continue
+		}
+		tag := outbound.Tag
+		if tag == "" {
+			tag = fmt.Sprintf("%s-%d", outbound.Protocol, i+1)
+		}
+		converted, err := outbound.toOutbounds(tag)
+		if err != nil {
+			report.reject(0, "", tag, outbound.Protocol, err)
+			continue
+		}
+		outbounds = append(outbounds, converted...)
+	}
+	return outbounds, nil
+}
+
+func (o *xrayOutbound) toOutbounds(tag string) ([]any, error) {
+	var outboundType string
+	servers := o.Settings.Servers
+	switch o.Protocol {
+	case "vless":
+		outboundType, servers = C.TypeVLESS, o.Settings.Vnext
+	case "vmess":
+		outboundType, servers = C.TypeVMess, o.Settings.Vnext
+	case "trojan":
+		outboundType = C.TypeTrojan
+	case "shadowsocks":
+		outboundType = C.TypeShadowsocks
+	case "socks":
+		outboundType = C.TypeSOCKS
+	case "http":
+		outboundType = C.TypeHTTP
+	default:
+		return nil, fmt.Errorf("unsupported protocol: %s", o.Protocol)
+	}
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("no servers in settings")
+	}
+	var outbounds []any
+	for _, server := range servers {
+		users := server.Users
+		if len(users) == 0 {
+			users = []xrayUser{{}}
+		}
+		for _, user := range users {
+			obj := outboundMap{
+				"type":        outboundType,
+				"server":      server.Address,
+				"server_port": server.Port,
+			}
+			switch o.Protocol {
+			case "vless":
+				obj["uuid"] = user.ID
+				if user.Flow != "" {
+					obj["flow"] = user.Flow
+				}
+			case "vmess":
+				obj["uuid"] = user.ID
+				obj["alter_id"] = user.AlterID
+				obj["security"] = user.Security
+				if user.Security == "" {
+					obj["security"] = "auto"
+				}
+			case "trojan":
+				obj["password"] = server.Password
+			case "shadowsocks":
+				obj["method"] = server.Method
+				obj["password"] = server.Password
+			case "socks", "http":
+				if user.User != "" {
+					obj["username"] = user.User
+					obj["password"] = user.Pass
+				}
+			}
+			if err := o.StreamSettings.apply(obj); err != nil {
+				return nil, err
+			}
+			outbounds = append(outbounds, map[string]any(obj))
+		}
+	}
+	for i, raw := range outbounds {
+		obj := raw.(map[string]any)
+		obj["tag"] = tag
+		if len(outbounds) > 1 {
+			obj["tag"] = fmt.Sprintf("%s %d", tag, i+1)
+		}
+	}
+	return outbounds, nil
+}
+
+func (s *xrayStreamSettings) apply(obj outboundMap) error {
+	if s == nil {
+		return nil
+	}
+	switch s.Security {
+	case "", "none":
+	case "tls":
+		tls := map[string]any{"enabled": true}
+		if s.TLSSettings != nil {
+			setXrayTLS(tls, s.TLSSettings.ServerName, s.TLSSettings.Fingerprint)
+			if s.TLSSettings.AllowInsecure {
+				tls["insecure"] = true
+			}
+			if len(s.TLSSettings.ALPN) > 0 {
+				tls["alpn"] = s.TLSSettings.ALPN
+			}
+		}
+		obj["tls"] = tls
+	case "reality":
+		if s.RealitySettings == nil {
+			return fmt.Errorf("reality without realitySettings")
+		}
+		tls := map[string]any{"enabled": true}
+		fingerprint := s.RealitySettings.Fingerprint
+		if fingerprint == "" {
+			fingerprint = "chrome" // reality в sing-box требует uTLS
+		}
+		setXrayTLS(tls, s.RealitySettings.ServerName, fingerprint)
+		tls["reality"] = map[string]any{
+			"enabled":    true,
+			"public_key": s.RealitySettings.PublicKey,
+			"short_id":   s.RealitySettings.ShortID,
+		}
+		obj["tls"] = tls
+	default:
+		return fmt.Errorf("unsupported security: %s", s.Security)
+	}
+
+	switch strings.ToLower(s.Network) {
+	case "", "tcp", "raw":
+	case "ws", "websocket":
+		transport := map[string]any{"type": C.V2RayTransportTypeWebsocket}
+		if s.WSSettings != nil {
+			transport["path"] = s.WSSettings.Path
+			host := s.WSSettings.Host
+			if host == "" {
+				host = s.WSSettings.Headers["Host"]
+			}
+			if host != "" {
+				transport["headers"] = map[string]any{"Host": host}
+			}
+		}
+		obj["transport"] = transport
+	case "httpupgrade":
+		transport := map[string]any{"type": C.V2RayTransportTypeHTTPUpgrade}
+		if s.HTTPUpgradeSettings != nil {
+			transport["path"] = s.HTTPUpgradeSettings.Path
+			if s.HTTPUpgradeSettings.Host != "" {
+				transport["host"] = s.HTTPUpgradeSettings.Host
+			}
+		}
+		obj["transport"] = transport
+	case "grpc", "gun":
+		transport := map[string]any{"type": C.V2RayTransportTypeGRPC}
+		if s.GRPCSettings != nil {
+			transport["service_name"] = s.GRPCSettings.ServiceName
+		}
+		obj["transport"] = transport
+	case "h2", "http":
+		transport := map[string]any{"type": C.V2RayTransportTypeHTTP}
+		if s.HTTPSettings != nil {
+			transport["path"] = s.HTTPSettings.Path
+			if len(s.HTTPSettings.Host) > 0 {
+				transport["host"] = s.HTTPSettings.Host
+			}
+		}
+		obj["transport"] = transport
+	default:
+		return fmt.Errorf("unsupported network: %s", s.Network)
+	}
+	return nil
+}
+
+func setXrayTLS(tls map[string]any, serverName string, fingerprint string) {
+	if serverName != "" {
+		tls["server_name"] = serverName
+	}
+	if fingerprint != "" {
+		tls["utls"] = map[string]any{"enabled": true, "fingerprint": fingerprint}
+	}
+}
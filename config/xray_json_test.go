@@ -0,0 +1,99 @@
+package config
+
+import (
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+const xrayTestConfig = `{
+  "log": {"loglevel": "warning"},
+  "inbounds": [{"port": 10808, "protocol": "socks"}],
+  "outbounds": [
+    {
+      "tag": "vless-ws", "protocol": "vless",
+      "settings": {"vnext": [{"address": "vless.example.com", "port": 443,
+        "users": [{"id": "b831381d-6324-4d53-ad4f-8cda48b30811", "encryption": "none"}]}]},
+      "streamSettings": {"network": "ws", "security": "tls",
+        "tlsSettings": {"serverName": "cdn.example.com", "allowInsecure": true},
+        "wsSettings": {"path": "/ray", "headers": {"Host": "cdn.example.com"}}}
+    },
+    {
+      "tag": "vmess-grpc", "protocol": "vmess",
+      "settings": {"vnext": [{"address": "vmess.example.com", "port": 8443,
+        "users": [{"id": "b831381d-6324-4d53-ad4f-8cda48b30811", "alterId": 0, "security": "aes-128-gcm"}]}]},
+      "streamSettings": {"network": "grpc", "security": "tls", "grpcSettings": {"serviceName": "svc"}}
+    },
+    {
+      "tag": "trojan", "protocol": "trojan",
+      "settings": {"servers": [{"address": "trojan.example.com", "port": 443, "password": "trojan-pass"}]},
+      "streamSettings": {"security": "tls"}
+    },
+    {"tag": "xhttp", "protocol": "vless",
+      "settings": {"vnext": [{"address": "x.example.com", "port": 443, "users": [{"id": "b831381d-6324-4d53-ad4f-8cda48b30811"}]}]},
+      "streamSettings": {"network": "xhttp"}},
+    {"tag": "wg", "protocol": "wireguard", "settings": {}},
+    {"tag": "direct", "protocol": "freedom"},
+    {"tag": "block", "protocol": "blackhole"}
+  ]
+}`
+
+func TestXrayToOutboundsReality(t *testing.T) {
+	content := []byte(`{"outbounds": [{"protocol": "vless",
+	  "settings": {"vnext": [{"address": "198.51.100.10", "port": 443,
+	    "users": [{"id": "b831381d-6324-4d53-ad4f-8cda48b30811", "flow": "xtls-rprx-vision"}]}]},
+	  "streamSettings": {"network": "tcp", "security": "reality",
+	    "realitySettings": {"serverName": "www.example.com", "publicKey": "jNXHt1yRo0vDuchQlIP6Z0ZvjT3KtzVI-T4E7RoLJS0", "shortId": "0123abcd"}}}]}`)
+	outbounds, err := XrayToOutbounds(content, &ParseReport{})
+	if err != nil || len(outbounds) != 1 {
+		t.Fatalf("convert: %v, %v", outbounds, err)
+	}
+	obj := outbounds[0].(map[string]any)
+	if obj["tag"] != "vless-1" || obj["flow"] != "xtls-rprx-vision" {
+		t.Errorf("unexpected outbound: %v", obj)
+	}
+	tls := obj["tls"].(map[string]any)
+	reality := tls["reality"].(map[string]any)
+	if tls["server_name"] != "www.example.com" || reality["short_id"] != "0123abcd" || tls["utls"] == nil {
+		t.Errorf("unexpected tls: %v", tls)
+	}
+}
+
+func TestParseXrayConfig(t *testing.T) {
+	content, report, err := ParseConfigContentWithReport(xrayTestConfig, false, nil, false)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if report.Format != ParseFormatXray {
+		t.Errorf("format = %q", report.Format)
+	}
+	rejected := make(map[string]bool)
+	for _, entry := range report.Rejected {
+		rejected[entry.Tag] = true
+	}
+	if len(rejected) != 2 || !rejected["xhttp"] || !rejected["wg"] {
+		t.Errorf("expected xhttp and wg to be rejected, got %+v", report.Rejected)
+	}
+	outbounds, err := ParseOutbounds(string(content), nil)
+	if err != nil {
+		t.Fatalf("parse result: %v", err)
+	}
+	byTag := make(map[string]option.Outbound)
+	for _, outbound := range outbounds {
+		byTag[outbound.Tag] = outbound
+	}
+	vless, ok := byTag["vless-ws"].Options.(*option.VLESSOutboundOptions)
+	if !ok || vless.Server != "vless.example.com" || vless.TLS == nil || !vless.TLS.Insecure ||
+		vless.Transport == nil || vless.Transport.Type != C.V2RayTransportTypeWebsocket || vless.Transport.WebsocketOptions.Path != "/ray" {
+		t.Errorf("vless-ws: %+v", byTag["vless-ws"])
+	}
+	vmess, ok := byTag["vmess-grpc"].Options.(*option.VMessOutboundOptions)
+	if !ok || vmess.Security != "aes-128-gcm" || vmess.Transport == nil || vmess.Transport.GRPCOptions.ServiceName != "svc" {
+		t.Errorf("vmess-grpc: %+v", byTag["vmess-grpc"])
+	}
+	trojan, ok := byTag["trojan"].Options.(*option.TrojanOutboundOptions)
+	if !ok || trojan.Password != "trojan-pass" || trojan.TLS == nil || !trojan.TLS.Enabled {
+		t.Errorf("trojan: %+v", byTag["trojan"])
+	}
+}
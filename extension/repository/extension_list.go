@@ -2,5 +2,6 @@ package repository
 
 import (
 	_ "github.com/Darkmen203/rostovvpn-app-demo-extension/rostovvpn_extension"
+	_ "github.com/Darkmen203/rostovvpn-core/extension/warp_scanner"
 	_ "github.com/Darkmen203/rostovvpn-ip-scanner-extension/rostovvpn_extension"
 )
@@ -0,0 +1,308 @@
+// Package warp_scanner — встроенное расширение: поиск чистых эндпоинтов Warp
+// с выводом прогресса в консоль расширения.
+package warp_scanner
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	ex "github.com/Darkmen203/rostovvpn-core/extension"
+	"github.com/Darkmen203/rostovvpn-core/extension/ui"
+	"github.com/Darkmen203/rostovvpn-core/v2/warpscan"
+)
+
+const (
+	accountKey     = "accountKey"
+	concurrencyKey = "concurrencyKey"
+	maxRTTKey      = "maxRTTKey"
+	countKey       = "countKey"
+	limitKey       = "limitKey"
+	ipv6Key        = "ipv6Key"
+	resultKey      = "resultKey"
+
+	consoleLines = 200
+)
+
+type WarpScannerData struct {
+	Account     string   `json:"account"`
+	Concurrency int      `json:"concurrency"`
+	MaxRTTms    int      `json:"maxRTTms"`
+	Count       int      `json:"count"`
+	Limit       int      `json:"limit"`
+	IPv6        bool     `json:"ipv6"`
+	Endpoints   []string `json:"endpoints"`
+}
+
+type WarpScannerExtension struct {
+	ex.Base[WarpScannerData]
+	cancel context.CancelFunc
+	// число идущих сканирований: отменённое ещё может дописывать итог, пока стартует новое
+	running atomic.Int32
+
+	consoleAccess sync.Mutex
+	console       []string
+}
+
+func NewWarpScannerExtension() ex.Extension {
+	return &WarpScannerExtension{
+		Base: ex.Base[WarpScannerData]{
+			Data: WarpScannerData{
+				Account:     "p1",
+				Concurrency: warpscan.DefaultConcurrency,
+				MaxRTTms:    int(warpscan.DefaultMaxRTT.Milliseconds()),
+				Count:       warpscan.DefaultCount,
+				Limit:       warpscan.DefaultLimit,
+			},
+		},
+	}
+}
+
+func (e *WarpScannerExtension) GetUI() ui.Form {
+	if e.running.Load() > 0 {
+		return ui.Form{
+			Title:       "Warp Scanner",
+			Description: "Scanning...",
+			Fields: [][]ui.FormField{
+				{{
+					Type:     ui.FieldConsole,
+					Readonly: true,
+					Key:      resultKey,
+					Label:    "Progress",
+					Value:    e.consoleString(),
+					Lines:    20,
+				}},
+				{{Type: ui.FieldButton, Key: ui.ButtonCancel, Label: "Cancel"}},
+			},
+		}
+	}
+	return ui.Form{
+		Title:       "Warp Scanner",
+		Description: "Finds reachable Warp endpoints with WireGuard handshakes and uses the best one as clean IP",
+		Fields: [][]ui.FormField{
+			{{
+				Type:        ui.FieldInput,
+				Key:         accountKey,
+				Label:       "Warp account",
+				Placeholder: "p1",
+				Value:       e.Base.Data.Account,
+			}},
+			{
+				{
+					Type:      ui.FieldInput,
+					Key:       concurrencyKey,
+					Label:     "Concurrency",
+					Value:     strconv.Itoa(e.Base.Data.Concurrency),
+					Validator: ui.ValidatorDigitsOnly,
+				},
+				{
+					Type:      ui.FieldInput,
+					Key:       maxRTTKey,
+					Label:     "Max RTT (ms)",
+					Value:     strconv.Itoa(e.Base.Data.MaxRTTms),
+					Validator: ui.ValidatorDigitsOnly,
+				},
+			},
+			{
+				{
+					Type:      ui.FieldInput,
+					Key:       countKey,
+					Label:     "Endpoints to keep",
+					Value:     strconv.Itoa(e.Base.Data.Count),
+					Validator: ui.ValidatorDigitsOnly,
+				},
+				{
+					Type:      ui.FieldInput,
+					Key:       limitKey,
+					Label:     "Max probes",
+					Value:     strconv.Itoa(e.Base.Data.Limit),
+					Validator: ui.ValidatorDigitsOnly,
+				},
+			},
+			{{
+				Type:  ui.FieldSwitch,
+				Key:   ipv6Key,
+				Label: "Scan IPv6",
+				Value: strconv.FormatBool(e.Base.Data.IPv6),
+			}},
+			{{
+				Type:     ui.FieldConsole,
+				Readonly: true,
+				Key:      resultKey,
+				Label:    "Result",
+				Value:    e.consoleString(),
+				Lines:    10,
+			}},
+			{{Type: ui.FieldButton, Key: ui.ButtonSubmit, Label: "Scan"}},
+		},
+	}
+}
+
+func (e *WarpScannerExtension) SubmitData(button string, data map[string]string) error {
+	switch button {
+	case ui.ButtonDialogOk, ui.ButtonDialogClose:
+		return nil
+	case ui.ButtonCancel:
+		return e.stop()
+	case ui.ButtonSubmit:
+		if err := e.setFormData(data); err != nil {
+			e.ShowMessage("Invalid data", err.Error())
+			return err
+		}
+		e.StoreData()
+		if e.cancel != nil {
+			e.cancel()
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		e.cancel = cancel
+		e.running.Add(1)
+		go e.runScan(ctx)
+		return nil
+	default:
+		return e.ShowMessage("Button "+button+" is pressed", "No action is defined for this button")
+	}
+}
+
+func (e *WarpScannerExtension) Close() error {
+	return e.stop()
+}
+
+func (e *WarpScannerExtension) stop() error {
+	if e.cancel != nil {
+		e.cancel()
+		e.cancel = nil
+	}
+	return nil
+}
+
+func (e *WarpScannerExtension) runScan(ctx context.Context) {
+	e.clearConsole()
+	defer func() {
+		e.running.Add(-1)
+		e.UpdateUI(e.GetUI())
+	}()
+
+	data := e.Base.Data
+	wgConfig, err := config.ResolveWarpAccount(data.Account, nil)
+	if err != nil {
+		e.print("warp account: " + err.Error())
+		return
+	}
+	e.print("scanning with account " + data.Account + "...")
+	e.UpdateUI(e.GetUI())
+
+	lastUpdate := time.Now()
+	results, err := warpscan.Scan(ctx, warpscan.Options{
+		PrivateKey:    wgConfig.PrivateKey,
+		PeerPublicKey: wgConfig.PeerPublicKey,
+		Reserved:      wgConfig.Reserved(),
+		IPv4:          true,
+		IPv6:          data.IPv6,
+		Concurrency:   data.Concurrency,
+		MaxRTT:        time.Duration(data.MaxRTTms) * time.Millisecond,
+		Limit:         data.Limit,
+		Count:         data.Count,
+	}, func(progress warpscan.Progress) {
+		if progress.Err == nil {
+			e.print(fmt.Sprintf("[%d/%d] %s %d ms", progress.Probed, progress.Total, progress.Last.Endpoint, progress.Last.RTT.Milliseconds()))
+		}
+		// не заваливаем UI обновлениями на каждую неудачную попытку
+		if progress.Err == nil || time.Since(lastUpdate) > time.Second {
+			lastUpdate = time.Now()
+			e.UpdateUI(e.GetUI())
+		}
+	})
+	if err != nil {
+		e.print("scan failed: " + err.Error())
+		return
+	}
+	if ctx.Err() != nil && len(results) == 0 {
+		e.print("canceled")
+		return
+	}
+	if len(results) == 0 {
+		e.print("no reachable endpoints found")
+		return
+	}
+	if err := config.SaveWarpScanResults(results); err != nil {
+		e.print("save: " + err.Error())
+		return
+	}
+	e.Base.Data.Endpoints = nil
+	e.print("best endpoints:")
+	for _, result := range results {
+		e.Base.Data.Endpoints = append(e.Base.Data.Endpoints, result.Endpoint.String())
+		e.print(fmt.Sprintf("  %s %d ms", result.Endpoint, result.RTT.Milliseconds()))
+	}
+	e.StoreData()
+	e.print("saved, warp outbounds without clean ip will use " + results[0].Endpoint.String())
+}
+
+func (e *WarpScannerExtension) setFormData(data map[string]string) error {
+	if val, ok := data[accountKey]; ok && strings.TrimSpace(val) != "" {
+		e.Base.Data.Account = strings.TrimSpace(val)
+	}
+	for key, target := range map[string]*int{
+		concurrencyKey: &e.Base.Data.Concurrency,
+		maxRTTKey:      &e.Base.Data.MaxRTTms,
+		countKey:       &e.Base.Data.Count,
+		limitKey:       &e.Base.Data.Limit,
+	} {
+		val, ok := data[key]
+		if !ok {
+			continue
+		}
+		intValue, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil || intValue <= 0 {
+			return fmt.Errorf("invalid number: %s", val)
+		}
+		*target = intValue
+	}
+	if val, ok := data[ipv6Key]; ok {
+		selected, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		e.Base.Data.IPv6 = selected
+	}
+	return nil
+}
+
+func (e *WarpScannerExtension) print(line string) {
+	e.consoleAccess.Lock()
+	defer e.consoleAccess.Unlock()
+	e.console = append(e.console, line)
+	if len(e.console) > consoleLines {
+		e.console = e.console[len(e.console)-consoleLines:]
+	}
+}
+
+func (e *WarpScannerExtension) clearConsole() {
+	e.consoleAccess.Lock()
+	defer e.consoleAccess.Unlock()
+	e.console = nil
+}
+
+func (e *WarpScannerExtension) consoleString() string {
+	e.consoleAccess.Lock()
+	defer e.consoleAccess.Unlock()
+	if len(e.console) == 0 && len(e.Base.Data.Endpoints) > 0 {
+		return "last result:\n" + strings.Join(e.Base.Data.Endpoints, "\n")
+	}
+	return strings.Join(e.console, "\n")
+}
+
+func init() {
+	ex.RegisterExtension(
+		ex.ExtensionFactory{
+			Id:          "github.com/Darkmen203/rostovvpn-core/extension/warp_scanner",
+			Title:       "Warp Scanner",
+			Description: "Finds clean Warp endpoints",
+			Builder:     NewWarpScannerExtension,
+		})
+}
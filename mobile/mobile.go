@@ -9,7 +9,6 @@ import (
 	"github.com/Darkmen203/rostovvpn-core/v2"
 
 	_ "github.com/sagernet/gomobile"
-	"github.com/sagernet/sing-box/experimental/libbox"
 	"github.com/sagernet/sing-box/option"
 	singjson "github.com/sagernet/sing/common/json"
 )
@@ -33,7 +32,7 @@ func BuildConfig(path string, RostovVPNOptionsJson string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	ctx := libbox.BaseContext(nil)
+	ctx := config.BaseContext()
 	options, err := singjson.UnmarshalExtendedContext[option.Options](ctx, fileContent)
 	if err != nil {
 		return "", err
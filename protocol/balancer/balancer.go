@@ -0,0 +1,400 @@
+// Package balancer реализует группы load-balance и fallback для sing-box.
+// load-balance распределяет соединения между равноценными серверами вместо
+// того, чтобы, как urltest, отправлять всех на единственный самый быстрый;
+// fallback ведёт всё через первый по порядку живой сервер.
+package balancer
+
+import (
+	"container/list"
+	"context"
+	"hash/fnv"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/adapter/outbound"
+	"github.com/sagernet/sing-box/common/urltest"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/protocol/group"
+	"github.com/sagernet/sing/common"
+	E "github.com/sagernet/sing/common/exceptions"
+	"github.com/sagernet/sing/common/json/badoption"
+	"github.com/sagernet/sing/common/logger"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+	"github.com/sagernet/sing/service"
+)
+
+const (
+	TypeLoadBalance = "load-balance"
+	TypeFallback    = "fallback"
+)
+
+const (
+	StrategyRoundRobin       = "round-robin"
+	StrategyLeastConnections = "least-connections"
+	StrategyConsistentHash   = "consistent-hash"
+
+	// только для группы fallback
+	strategyFallback = "fallback"
+)
+
+const (
+	defaultStickyTTL = 10 * time.Minute
+	maxStickyEntries = 4096
+)
+
+// Options — опции load-balance; fallback использует только участников и
+// параметры URL-теста.
+type Options struct {
+	Outbounds   []string           `json:"outbounds"`
+	Strategy    string             `json:"strategy,omitempty"`
+	Sticky      bool               `json:"sticky,omitempty"`
+	StickyTTL   badoption.Duration `json:"sticky_ttl,omitempty"`
+	URL         string             `json:"url,omitempty"`
+	Interval    badoption.Duration `json:"interval,omitempty"`
+	IdleTimeout badoption.Duration `json:"idle_timeout,omitempty"`
+}
+
+func Register(registry *outbound.Registry) {
+	outbound.Register[Options](registry, TypeLoadBalance, NewLoadBalance)
+	outbound.Register[Options](registry, TypeFallback, NewFallback)
+}
+
+var (
+	_ adapter.OutboundGroup             = (*LoadBalance)(nil)
+	_ adapter.ConnectionHandlerEx       = (*LoadBalance)(nil)
+	_ adapter.PacketConnectionHandlerEx = (*LoadBalance)(nil)
+)
+
+type stickyEntry struct {
+	key     string
+	tag     string
+	expires time.Time
+}
+
+type LoadBalance struct {
+	outbound.Adapter
+	ctx         context.Context
+	outbound    adapter.OutboundManager
+	connection  adapter.ConnectionManager
+	logger      logger.ContextLogger
+	tags        []string
+	strategy    string
+	stickyTTL   time.Duration
+	link        string
+	interval    time.Duration
+	idleTimeout time.Duration
+
+	outbounds []adapter.Outbound
+	// Проверкой здоровья занимается обычная urltest-группа: результаты пишутся
+	// в общую историю, которой пользуются auto и UI.
+	health *group.URLTestGroup
+	active map[string]*atomic.Int32
+	next   atomic.Uint32
+	last   common.TypedValue[adapter.Outbound]
+
+	// привязки назначений: LRU, давно не использованные вытесняются первыми
+	stickyAccess sync.Mutex
+	sticky       map[string]*list.Element
+	stickyLRU    *list.List
+}
+
+func NewLoadBalance(ctx context.Context, router adapter.Router, logger log.ContextLogger, tag string, options Options) (adapter.Outbound, error) {
+	switch options.Strategy {
+	case "":
+		options.Strategy = StrategyRoundRobin
+	case StrategyRoundRobin, StrategyLeastConnections, StrategyConsistentHash:
+	default:
+		return nil, E.New("unknown load-balance strategy: ", options.Strategy)
+	}
+	return newGroup(ctx, logger, TypeLoadBalance, tag, options)
+}
+
+// NewFallback создаёт группу, которая держит порядок участников: соединения идут
+// через первого с успешным URL-тестом и возвращаются к нему, как только он ожил.
+func NewFallback(ctx context.Context, router adapter.Router, logger log.ContextLogger, tag string, options Options) (adapter.Outbound, error) {
+	options.Strategy = strategyFallback
+	options.Sticky = false
+	return newGroup(ctx, logger, TypeFallback, tag, options)
+}
+
+func newGroup(ctx context.Context, logger log.ContextLogger, outboundType string, tag string, options Options) (*LoadBalance, error) {
+	if len(options.Outbounds) == 0 {
+		return nil, E.New("missing tags")
+	}
+	lb := &LoadBalance{
+		Adapter:     outbound.NewAdapter(outboundType, tag, []string{N.NetworkTCP, N.NetworkUDP}, options.Outbounds),
+		ctx:         ctx,
+		outbound:    service.FromContext[adapter.OutboundManager](ctx),
+		connection:  service.FromContext[adapter.ConnectionManager](ctx),
+		logger:      logger,
+		tags:        options.Outbounds,
+		strategy:    options.Strategy,
+		link:        options.URL,
+		interval:    time.Duration(options.Interval),
+		idleTimeout: time.Duration(options.IdleTimeout),
+		active:      make(map[string]*atomic.Int32),
+		sticky:      make(map[string]*list.Element),
+		stickyLRU:   list.New(),
+	}
+	if options.Sticky {
+		lb.stickyTTL = time.Duration(options.StickyTTL)
+		if lb.stickyTTL <= 0 {
+			lb.stickyTTL = defaultStickyTTL
+		}
+	}
+	return lb, nil
+}
+
+func (b *LoadBalance) Start() error {
+	outbounds := make([]adapter.Outbound, 0, len(b.tags))
+	for i, tag := range b.tags {
+		detour, loaded := b.outbound.Outbound(tag)
+		if !loaded {
+			return E.New("outbound ", i, " not found: ", tag)
+		}
+		outbounds = append(outbounds, detour)
+		b.active[tag] = new(atomic.Int32)
+	}
+	b.outbounds = outbounds
+	health, err := group.NewURLTestGroup(b.ctx, b.outbound, b.logger, outbounds, b.link, b.interval, 0, b.idleTimeout, false)
+	if err != nil {
+		return err
+	}
+	b.health = health
+	return nil
+}
+
+func (b *LoadBalance) PostStart() error {
+	b.health.PostStart()
+	return nil
+}
+
+func (b *LoadBalance) Close() error {
+	return common.Close(common.PtrOrNil(b.health))
+}
+
+func (b *LoadBalance) Now() string {
+	if b.strategy == strategyFallback && b.outbounds != nil {
+		if candidates := b.candidates(N.NetworkTCP); len(candidates) > 0 {
+			return candidates[0].Tag()
+		}
+	}
+	if last := b.last.Load(); last != nil {
+		return last.Tag()
+	}
+	return b.tags[0]
+}
+
+func (b *LoadBalance) All() []string {
+	return b.tags
+}
+
+func (b *LoadBalance) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	detour, err := b.pick(N.NetworkName(network), destination)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := detour.DialContext(ctx, network, destination)
+	if err != nil {
+		b.forget(destination)
+		return nil, err
+	}
+	counter := b.active[detour.Tag()]
+	counter.Add(1)
+	return &countedConn{Conn: conn, counter: counter}, nil
+}
+
+func (b *LoadBalance) ListenPacket(ctx context.Context, destination M.Socksaddr) (net.PacketConn, error) {
+	detour, err := b.pick(N.NetworkUDP, destination)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := detour.ListenPacket(ctx, destination)
+	if err != nil {
+		b.forget(destination)
+		return nil, err
+	}
+	counter := b.active[detour.Tag()]
+	counter.Add(1)
+	return &countedPacketConn{PacketConn: conn, counter: counter}, nil
+}
+
+func (b *LoadBalance) NewConnectionEx(ctx context.Context, conn net.Conn, metadata adapter.InboundContext, onClose N.CloseHandlerFunc) {
+	detour, err := b.pick(N.NetworkTCP, metadata.Destination)
+	if err != nil {
+		b.logger.ErrorContext(ctx, err)
+		N.CloseOnHandshakeFailure(conn, onClose, err)
+		return
+	}
+	onClose = b.track(detour, onClose)
+	if handler, isHandler := detour.(adapter.ConnectionHandlerEx); isHandler {
+		handler.NewConnectionEx(ctx, conn, metadata, onClose)
+	} else {
+		b.connection.NewConnection(ctx, detour, conn, metadata, onClose)
+	}
+}
+
+func (b *LoadBalance) NewPacketConnectionEx(ctx context.Context, conn N.PacketConn, metadata adapter.InboundContext, onClose N.CloseHandlerFunc) {
+	detour, err := b.pick(N.NetworkUDP, metadata.Destination)
+	if err != nil {
+		b.logger.ErrorContext(ctx, err)
+		N.CloseOnHandshakeFailure(conn, onClose, err)
+		return
+	}
+	onClose = b.track(detour, onClose)
+	if handler, isHandler := detour.(adapter.PacketConnectionHandlerEx); isHandler {
+		handler.NewPacketConnectionEx(ctx, conn, metadata, onClose)
+	} else {
+		b.connection.NewPacketConnection(ctx, detour, conn, metadata, onClose)
+	}
+}
+
+func (b *LoadBalance) track(detour adapter.Outbound, onClose N.CloseHandlerFunc) N.CloseHandlerFunc {
+	counter := b.active[detour.Tag()]
+	counter.Add(1)
+	return N.AppendClose(onClose, N.OnceClose(func(error) {
+		counter.Add(-1)
+	}))
+}
+
+// pick выбирает сервер для назначения: сначала sticky-привязка, затем стратегия.
+// Здоровыми считаются участники с актуальным результатом URL-теста; если таких
+// нет (ещё не проверяли или все упали), выбираем из всех.
+func (b *LoadBalance) pick(network string, destination M.Socksaddr) (adapter.Outbound, error) {
+	b.health.Touch()
+	candidates := b.candidates(network)
+	if len(candidates) == 0 {
+		return nil, E.New("missing supported outbound")
+	}
+	key := destination.AddrString()
+	if b.stickyTTL > 0 && key != "" {
+		if detour := b.loadSticky(key, candidates); detour != nil {
+			b.last.Store(detour)
+			return detour, nil
+		}
+	}
+	var detour adapter.Outbound
+	switch b.strategy {
+	case strategyFallback:
+		// candidates сохраняет порядок участников
+		detour = candidates[0]
+	case StrategyLeastConnections:
+		detour = candidates[0]
+		for _, candidate := range candidates[1:] {
+			if b.active[candidate.Tag()].Load() < b.active[detour.Tag()].Load() {
+				detour = candidate
+			}
+		}
+	case StrategyConsistentHash:
+		if key == "" {
+			detour = candidates[int(b.next.Add(1)-1)%len(candidates)]
+			break
+		}
+		// rendezvous hashing: при выпадении сервера переезжают только его назначения
+		var best uint64
+		for _, candidate := range candidates {
+			hash := fnv.New64a()
+			hash.Write([]byte(key))
+			hash.Write([]byte{0})
+			hash.Write([]byte(candidate.Tag()))
+			if score := hash.Sum64(); detour == nil || score > best {
+				detour, best = candidate, score
+			}
+		}
+	default:
+		detour = candidates[int(b.next.Add(1)-1)%len(candidates)]
+	}
+	if b.stickyTTL > 0 && key != "" {
+		b.storeSticky(key, detour.Tag())
+	}
+	b.last.Store(detour)
+	return detour, nil
+}
+
+func (b *LoadBalance) candidates(network string) []adapter.Outbound {
+	history := b.historyStorage()
+	var healthy, supported []adapter.Outbound
+	for _, detour := range b.outbounds {
+		if !common.Contains(detour.Network(), network) {
+			continue
+		}
+		supported = append(supported, detour)
+		if history != nil && history.LoadURLTestHistory(group.RealTag(detour)) != nil {
+			healthy = append(healthy, detour)
+		}
+	}
+	if len(healthy) > 0 {
+		return healthy
+	}
+	return supported
+}
+
+// historyStorage ищет историю так же, как urltest-группа, чтобы видеть те же результаты.
+func (b *LoadBalance) historyStorage() adapter.URLTestHistoryStorage {
+	if history := service.PtrFromContext[urltest.HistoryStorage](b.ctx); history != nil {
+		return history
+	}
+	if clashServer := service.FromContext[adapter.ClashServer](b.ctx); clashServer != nil {
+		return clashServer.HistoryStorage()
+	}
+	return nil
+}
+
+func (b *LoadBalance) loadSticky(key string, candidates []adapter.Outbound) adapter.Outbound {
+	b.stickyAccess.Lock()
+	defer b.stickyAccess.Unlock()
+	element, loaded := b.sticky[key]
+	if !loaded {
+		return nil
+	}
+	entry := element.Value.(*stickyEntry)
+	if time.Now().After(entry.expires) {
+		b.removeSticky(element)
+		return nil
+	}
+	for _, candidate := range candidates {
+		if candidate.Tag() == entry.tag {
+			entry.expires = time.Now().Add(b.stickyTTL)
+			b.stickyLRU.MoveToFront(element)
+			return candidate
+		}
+	}
+	return nil
+}
+
+func (b *LoadBalance) storeSticky(key string, tag string) {
+	b.stickyAccess.Lock()
+	defer b.stickyAccess.Unlock()
+	expires := time.Now().Add(b.stickyTTL)
+	if element, loaded := b.sticky[key]; loaded {
+		entry := element.Value.(*stickyEntry)
+		entry.tag, entry.expires = tag, expires
+		b.stickyLRU.MoveToFront(element)
+		return
+	}
+	b.sticky[key] = b.stickyLRU.PushFront(&stickyEntry{key: key, tag: tag, expires: expires})
+	for b.stickyLRU.Len() > maxStickyEntries {
+		b.removeSticky(b.stickyLRU.Back())
+	}
+}
+
+func (b *LoadBalance) removeSticky(element *list.Element) {
+	b.stickyLRU.Remove(element)
+	delete(b.sticky, element.Value.(*stickyEntry).key)
+}
+
+// forget снимает привязку назначения, если соединение через выбранный сервер не удалось.
+func (b *LoadBalance) forget(destination M.Socksaddr) {
+	if b.stickyTTL == 0 {
+		return
+	}
+	b.stickyAccess.Lock()
+	if element, loaded := b.sticky[destination.AddrString()]; loaded {
+		b.removeSticky(element)
+	}
+	b.stickyAccess.Unlock()
+}
@@ -0,0 +1,208 @@
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/adapter/outbound"
+	"github.com/sagernet/sing-box/common/urltest"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/protocol/group"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+	"github.com/sagernet/sing/service"
+)
+
+type testOutbound struct {
+	outbound.Adapter
+}
+
+func (o *testOutbound) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	return nil, net.ErrClosed
+}
+
+func (o *testOutbound) ListenPacket(ctx context.Context, destination M.Socksaddr) (net.PacketConn, error) {
+	return nil, net.ErrClosed
+}
+
+// newTestGroup собирает группу без менеджера outbound'ов: участники и история
+// URL-тестов подставляются напрямую.
+func newTestGroup(t *testing.T, create func(context.Context, adapter.Router, log.ContextLogger, string, Options) (adapter.Outbound, error), options Options) (*LoadBalance, *urltest.HistoryStorage) {
+	t.Helper()
+	history := urltest.NewHistoryStorage()
+	ctx := service.ContextWithPtr(context.Background(), history)
+	logger := log.NewNOPFactory().Logger()
+	created, err := create(ctx, nil, logger, "group", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb := created.(*LoadBalance)
+	for _, tag := range options.Outbounds {
+		lb.outbounds = append(lb.outbounds, &testOutbound{outbound.NewAdapter(C.TypeDirect, tag, []string{N.NetworkTCP, N.NetworkUDP}, nil)})
+		lb.active[tag] = new(atomic.Int32)
+	}
+	lb.health, err = group.NewURLTestGroup(ctx, nil, logger, lb.outbounds, "", 0, 0, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lb, history
+}
+
+func pickTag(t *testing.T, lb *LoadBalance, host string) string {
+	t.Helper()
+	detour, err := lb.pick(N.NetworkTCP, M.ParseSocksaddrHostPort(host, 443))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return detour.Tag()
+}
+
+func TestFallbackOrder(t *testing.T) {
+	lb, history := newTestGroup(t, NewFallback, Options{Outbounds: []string{"a", "b", "c"}, Strategy: StrategyRoundRobin, Sticky: true})
+	if lb.Type() != TypeFallback || lb.stickyTTL != 0 {
+		t.Fatalf("fallback must ignore load-balance options: %s %v", lb.Type(), lb.stickyTTL)
+	}
+	// до первых тестов — первый по порядку
+	if tag := pickTag(t, lb, "example.com"); tag != "a" {
+		t.Errorf("untested: %s", tag)
+	}
+	history.StoreURLTestHistory("b", &adapter.URLTestHistory{Delay: 300})
+	history.StoreURLTestHistory("c", &adapter.URLTestHistory{Delay: 10})
+	for range 3 {
+		if tag := pickTag(t, lb, "example.com"); tag != "b" {
+			t.Errorf("first healthy must win over the fastest: %s", tag)
+		}
+	}
+	if lb.Now() != "b" {
+		t.Errorf("now = %s", lb.Now())
+	}
+	history.StoreURLTestHistory("a", &adapter.URLTestHistory{Delay: 500})
+	if tag := pickTag(t, lb, "example.com"); tag != "a" {
+		t.Errorf("recovered first member must be preferred again: %s", tag)
+	}
+	history.DeleteURLTestHistory("a")
+	history.DeleteURLTestHistory("b")
+	if tag := pickTag(t, lb, "example.com"); tag != "c" {
+		t.Errorf("after failures: %s", tag)
+	}
+}
+
+func TestLoadBalanceStrategy(t *testing.T) {
+	logger := log.NewNOPFactory().Logger()
+	for _, strategy := range []string{strategyFallback, "random"} {
+		if _, err := NewLoadBalance(context.Background(), nil, logger, "group", Options{Outbounds: []string{"a"}, Strategy: strategy}); err == nil {
+			t.Errorf("strategy %s must be rejected", strategy)
+		}
+	}
+	if _, err := NewLoadBalance(context.Background(), nil, logger, "group", Options{}); err == nil {
+		t.Errorf("group without members must be rejected")
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	lb, history := newTestGroup(t, NewLoadBalance, Options{Outbounds: []string{"a", "b", "c"}})
+	seen := make(map[string]int)
+	for range 6 {
+		seen[pickTag(t, lb, "example.com")]++
+	}
+	if seen["a"] != 2 || seen["b"] != 2 || seen["c"] != 2 {
+		t.Errorf("untested members must share load: %v", seen)
+	}
+	// с результатами тестов участвуют только живые
+	history.StoreURLTestHistory("a", &adapter.URLTestHistory{Delay: 100})
+	history.StoreURLTestHistory("c", &adapter.URLTestHistory{Delay: 100})
+	for range 4 {
+		if tag := pickTag(t, lb, "example.com"); tag == "b" {
+			t.Errorf("unhealthy member picked")
+		}
+	}
+}
+
+func TestLeastConnections(t *testing.T) {
+	lb, _ := newTestGroup(t, NewLoadBalance, Options{Outbounds: []string{"a", "b", "c"}, Strategy: StrategyLeastConnections})
+	lb.active["a"].Add(3)
+	lb.active["b"].Add(1)
+	lb.active["c"].Add(2)
+	if tag := pickTag(t, lb, "example.com"); tag != "b" {
+		t.Errorf("picked %s", tag)
+	}
+	lb.active["b"].Add(5)
+	if tag := pickTag(t, lb, "example.com"); tag != "c" {
+		t.Errorf("picked %s", tag)
+	}
+}
+
+func TestConsistentHash(t *testing.T) {
+	lb, history := newTestGroup(t, NewLoadBalance, Options{Outbounds: []string{"a", "b", "c"}, Strategy: StrategyConsistentHash})
+	for _, tag := range []string{"a", "b", "c"} {
+		history.StoreURLTestHistory(tag, &adapter.URLTestHistory{Delay: 100})
+	}
+	hosts := []string{"one.example", "two.example", "three.example", "four.example", "five.example", "six.example"}
+	before := make(map[string]string)
+	for _, host := range hosts {
+		before[host] = pickTag(t, lb, host)
+		if again := pickTag(t, lb, host); again != before[host] {
+			t.Errorf("%s: %s then %s", host, before[host], again)
+		}
+	}
+	// выпадение сервера переносит только его назначения
+	history.DeleteURLTestHistory("b")
+	for _, host := range hosts {
+		after := pickTag(t, lb, host)
+		if before[host] != "b" && after != before[host] {
+			t.Errorf("%s moved from %s to %s", host, before[host], after)
+		}
+		if after == "b" {
+			t.Errorf("%s: unhealthy member picked", host)
+		}
+	}
+}
+
+func TestSticky(t *testing.T) {
+	lb, _ := newTestGroup(t, NewLoadBalance, Options{Outbounds: []string{"a", "b"}, Sticky: true})
+	if lb.stickyTTL != defaultStickyTTL {
+		t.Fatalf("sticky ttl = %v", lb.stickyTTL)
+	}
+	first := pickTag(t, lb, "example.com")
+	for range 3 {
+		if tag := pickTag(t, lb, "example.com"); tag != first {
+			t.Errorf("sticky destination moved from %s to %s", first, tag)
+		}
+	}
+	if other := pickTag(t, lb, "other.example"); other == first {
+		t.Errorf("new destination must follow round-robin")
+	}
+	// неудачное соединение снимает привязку
+	lb.forget(M.ParseSocksaddrHostPort("example.com", 443))
+	if _, kept := lb.sticky["example.com"]; kept {
+		t.Errorf("forgotten destination is still sticky")
+	}
+}
+
+func TestStickyEviction(t *testing.T) {
+	lb, _ := newTestGroup(t, NewLoadBalance, Options{Outbounds: []string{"a", "b"}, Sticky: true})
+	for i := range maxStickyEntries {
+		lb.storeSticky(fmt.Sprintf("host-%d.example", i), "a")
+	}
+	// использованная привязка становится свежей и переживает вытеснение
+	if detour := lb.loadSticky("host-0.example", lb.outbounds); detour == nil {
+		t.Fatalf("host-0 is not sticky")
+	}
+	lb.storeSticky("new.example", "b")
+	if len(lb.sticky) != maxStickyEntries || lb.stickyLRU.Len() != maxStickyEntries {
+		t.Fatalf("sticky entries = %d/%d", len(lb.sticky), lb.stickyLRU.Len())
+	}
+	if _, kept := lb.sticky["host-1.example"]; kept {
+		t.Errorf("oldest destination is not evicted")
+	}
+	for _, host := range []string{"host-0.example", "new.example"} {
+		if _, kept := lb.sticky[host]; !kept {
+			t.Errorf("%s is evicted", host)
+		}
+	}
+}
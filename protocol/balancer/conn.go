@@ -0,0 +1,38 @@
+package balancer
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// countedConn уменьшает счётчик активных соединений сервера при закрытии.
+type countedConn struct {
+	net.Conn
+	counter *atomic.Int32
+	once    sync.Once
+}
+
+func (c *countedConn) Close() error {
+	c.once.Do(func() { c.counter.Add(-1) })
+	return c.Conn.Close()
+}
+
+func (c *countedConn) Upstream() any {
+	return c.Conn
+}
+
+type countedPacketConn struct {
+	net.PacketConn
+	counter *atomic.Int32
+	once    sync.Once
+}
+
+func (c *countedPacketConn) Close() error {
+	c.once.Do(func() { c.counter.Add(-1) })
+	return c.PacketConn.Close()
+}
+
+func (c *countedPacketConn) Upstream() any {
+	return c.PacketConn
+}
@@ -0,0 +1,127 @@
+// Package blocklist — цели правил своих списков блокировки. Outbound и
+// DNS-сервер отвечают так же, как block и predefined без записей, но
+// считают, сколько раз правило списка сработало: у каждого списка свой тег.
+package blocklist
+
+import (
+	"context"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/adapter/outbound"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/common/logger"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+
+	mDNS "github.com/miekg/dns"
+)
+
+const Type = "blocklist"
+
+func RegisterOutbound(registry *outbound.Registry) {
+	outbound.Register[option.StubOptions](registry, Type, NewOutbound)
+}
+
+func RegisterTransport(registry *dns.TransportRegistry) {
+	dns.RegisterTransport[option.StubOptions](registry, Type, NewTransport)
+}
+
+// Counter — срабатывания списка с запуска ядра.
+type Counter struct {
+	DNS         uint64
+	Connections uint64
+	LastHit     time.Time
+}
+
+var (
+	countersAccess sync.Mutex
+	counters       = map[string]*Counter{}
+)
+
+// Hits — срабатывания списка с тегом tag; переживают перезапуск sing-box.
+func Hits(tag string) Counter {
+	countersAccess.Lock()
+	defer countersAccess.Unlock()
+	if counter := counters[tag]; counter != nil {
+		return *counter
+	}
+	return Counter{}
+}
+
+func hit(tag string, isDNS bool) {
+	countersAccess.Lock()
+	defer countersAccess.Unlock()
+	counter := counters[tag]
+	if counter == nil {
+		counter = &Counter{}
+		counters[tag] = counter
+	}
+	if isDNS {
+		counter.DNS++
+	} else {
+		counter.Connections++
+	}
+	counter.LastHit = time.Now()
+}
+
+var _ adapter.Outbound = (*Outbound)(nil)
+
+type Outbound struct {
+	outbound.Adapter
+	logger logger.ContextLogger
+}
+
+func NewOutbound(ctx context.Context, router adapter.Router, logger log.ContextLogger, tag string, _ option.StubOptions) (adapter.Outbound, error) {
+	return &Outbound{
+		Adapter: outbound.NewAdapter(Type, tag, []string{N.NetworkTCP, N.NetworkUDP}, nil),
+		logger:  logger,
+	}, nil
+}
+
+func (h *Outbound) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	hit(h.Tag(), false)
+	h.logger.InfoContext(ctx, "blocked connection to ", destination)
+	return nil, syscall.EPERM
+}
+
+func (h *Outbound) ListenPacket(ctx context.Context, destination M.Socksaddr) (net.PacketConn, error) {
+	hit(h.Tag(), false)
+	h.logger.InfoContext(ctx, "blocked packet connection to ", destination)
+	return nil, syscall.EPERM
+}
+
+var _ adapter.DNSTransport = (*Transport)(nil)
+
+// Transport отвечает NOERROR без записей: приложение не получает адрес и не
+// переспрашивает другой сервер, как после SERVFAIL или REFUSED.
+type Transport struct {
+	dns.TransportAdapter
+}
+
+func NewTransport(ctx context.Context, logger log.ContextLogger, tag string, _ option.StubOptions) (adapter.DNSTransport, error) {
+	return &Transport{TransportAdapter: dns.NewTransportAdapter(Type, tag, nil)}, nil
+}
+
+func (t *Transport) Start(stage adapter.StartStage) error {
+	return nil
+}
+
+func (t *Transport) Close() error {
+	return nil
+}
+
+func (t *Transport) Reset() {
+}
+
+func (t *Transport) Exchange(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+	hit(t.Tag(), true)
+	response := new(mDNS.Msg)
+	response.SetReply(message)
+	return response, nil
+}
@@ -0,0 +1,61 @@
+package blocklist
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+
+	mDNS "github.com/miekg/dns"
+)
+
+func TestOutboundCountsConnections(t *testing.T) {
+	logger := log.NewNOPFactory().Logger()
+	created, err := NewOutbound(context.Background(), nil, logger, "blocklist-ads", option.StubOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	destination := M.ParseSocksaddr("ads.example.com:443")
+	if _, err := created.DialContext(context.Background(), N.NetworkTCP, destination); !errors.Is(err, syscall.EPERM) {
+		t.Errorf("dial err = %v", err)
+	}
+	if _, err := created.ListenPacket(context.Background(), destination); !errors.Is(err, syscall.EPERM) {
+		t.Errorf("listen err = %v", err)
+	}
+	hits := Hits("blocklist-ads")
+	if hits.Connections != 2 || hits.DNS != 0 || hits.LastHit.Before(start) {
+		t.Errorf("hits = %+v", hits)
+	}
+	if other := Hits("blocklist-other"); other != (Counter{}) {
+		t.Errorf("other list hits = %+v", other)
+	}
+}
+
+func TestTransportCountsQueries(t *testing.T) {
+	created, err := NewTransport(context.Background(), log.NewNOPFactory().Logger(), "blocklist-trackers", option.StubOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := new(mDNS.Msg)
+	query.SetQuestion("tracker.example.com.", mDNS.TypeA)
+	for i := 0; i < 3; i++ {
+		response, err := created.Exchange(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// как predefined без записей: NOERROR на тот же вопрос
+		if response.Id != query.Id || response.Rcode != mDNS.RcodeSuccess || len(response.Answer) != 0 || len(response.Question) != 1 {
+			t.Errorf("response = %v", response)
+		}
+	}
+	if hits := Hits("blocklist-trackers"); hits.DNS != 3 || hits.Connections != 0 {
+		t.Errorf("hits = %+v", hits)
+	}
+}
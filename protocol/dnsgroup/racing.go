@@ -0,0 +1,294 @@
+// Package dnsgroup реализует DNS-сервер racing для sing-box: запрос уходит
+// в несколько upstream'ов сразу (parallel) или с задержкой друг за другом
+// (staggered), побеждает первый нормальный ответ. Для каждого upstream'а
+// считаются задержка и ошибки, упавшие подряд max_failures раз выводятся
+// из ротации на cooldown.
+package dnsgroup
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/log"
+	E "github.com/sagernet/sing/common/exceptions"
+	"github.com/sagernet/sing/common/json/badoption"
+	"github.com/sagernet/sing/common/logger"
+	"github.com/sagernet/sing/service"
+
+	mDNS "github.com/miekg/dns"
+)
+
+const TypeRacing = "racing"
+
+const (
+	ModeParallel  = "parallel"
+	ModeStaggered = "staggered"
+)
+
+const (
+	defaultStagger     = 200 * time.Millisecond
+	defaultMaxFailures = 3
+	defaultCooldown    = 60 * time.Second
+)
+
+type Options struct {
+	Servers     []string           `json:"servers"`
+	Mode        string             `json:"mode,omitempty"`
+	Stagger     badoption.Duration `json:"stagger,omitempty"`
+	MaxFailures int                `json:"max_failures,omitempty"`
+	Cooldown    badoption.Duration `json:"cooldown,omitempty"`
+}
+
+func Register(registry *dns.TransportRegistry) {
+	dns.RegisterTransport[Options](registry, TypeRacing, NewRacing)
+}
+
+var _ adapter.DNSTransport = (*Racing)(nil)
+
+type Racing struct {
+	dns.TransportAdapter
+	ctx         context.Context
+	logger      logger.ContextLogger
+	tags        []string
+	mode        string
+	stagger     time.Duration
+	maxFailures int
+	cooldown    time.Duration
+
+	upstreams []*upstream
+}
+
+type upstream struct {
+	transport adapter.DNSTransport
+
+	access       sync.Mutex
+	queries      uint64
+	failures     uint64
+	consecutive  int
+	latency      time.Duration // EWMA по успешным ответам, 0 — ещё не мерили
+	lastError    string
+	demotedUntil time.Time
+}
+
+func NewRacing(ctx context.Context, logger log.ContextLogger, tag string, options Options) (adapter.DNSTransport, error) {
+	if len(options.Servers) == 0 {
+		return nil, E.New("missing servers")
+	}
+	mode := options.Mode
+	switch mode {
+	case "":
+		mode = ModeStaggered
+	case ModeParallel, ModeStaggered:
+	default:
+		return nil, E.New("unknown racing mode: ", options.Mode)
+	}
+	r := &Racing{
+		TransportAdapter: dns.NewTransportAdapter(TypeRacing, tag, options.Servers),
+		ctx:              ctx,
+		logger:           logger,
+		tags:             options.Servers,
+		mode:             mode,
+		stagger:          time.Duration(options.Stagger),
+		maxFailures:      options.MaxFailures,
+		cooldown:         time.Duration(options.Cooldown),
+	}
+	if r.stagger <= 0 {
+		r.stagger = defaultStagger
+	}
+	if r.maxFailures <= 0 {
+		r.maxFailures = defaultMaxFailures
+	}
+	if r.cooldown <= 0 {
+		r.cooldown = defaultCooldown
+	}
+	return r, nil
+}
+
+func (r *Racing) Start(stage adapter.StartStage) error {
+	if stage != adapter.StartStateStart {
+		return nil
+	}
+	manager := service.FromContext[adapter.DNSTransportManager](r.ctx)
+	if manager == nil {
+		return E.New("missing DNS transport manager")
+	}
+	upstreams := make([]*upstream, 0, len(r.tags))
+	for i, tag := range r.tags {
+		transport, loaded := manager.Transport(tag)
+		if !loaded {
+			return E.New("server ", i, " not found: ", tag)
+		}
+		upstreams = append(upstreams, &upstream{transport: transport})
+	}
+	r.upstreams = upstreams
+	register(r)
+	return nil
+}
+
+func (r *Racing) Close() error {
+	unregister(r)
+	return nil
+}
+
+// Reset вызывается при смене сети: старые ошибки больше ничего не значат.
+func (r *Racing) Reset() {
+	for _, u := range r.upstreams {
+		u.access.Lock()
+		u.consecutive = 0
+		u.demotedUntil = time.Time{}
+		u.access.Unlock()
+	}
+}
+
+type raceResult struct {
+	response *mDNS.Msg
+	err      error
+}
+
+func (r *Racing) Exchange(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+	candidates := r.candidates()
+	if len(candidates) == 0 {
+		return nil, E.New("dns/", TypeRacing, "[", r.Tag(), "]: not started")
+	}
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var finished atomic.Bool
+	results := make(chan raceResult, len(candidates))
+	launch := func(u *upstream) {
+		go func() {
+			start := time.Now()
+			response, err := u.transport.Exchange(raceCtx, message.Copy())
+			if err == nil && response != nil && !isValidResponse(response) {
+				err = E.New("rcode ", mDNS.RcodeToString[response.Rcode])
+			}
+			// проигравшие гонку отменены нами — это не ошибка upstream'а,
+			// а вот таймаут всего запроса — ошибка
+			if err == nil || !finished.Load() {
+				r.record(u, time.Since(start), err)
+			} else {
+				r.recordLoss(u, time.Since(start))
+			}
+			results <- raceResult{response, err}
+		}()
+	}
+
+	next, pending := 0, 0
+	if r.mode == ModeParallel {
+		for _, u := range candidates {
+			launch(u)
+		}
+		next, pending = len(candidates), len(candidates)
+	} else {
+		launch(candidates[0])
+		next, pending = 1, 1
+	}
+	timer := time.NewTimer(r.stagger)
+	defer timer.Stop()
+
+	var lastResponse *mDNS.Msg
+	var lastErr error
+	for pending > 0 {
+		select {
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				finished.Store(true)
+				return result.response, nil
+			}
+			if result.response != nil {
+				lastResponse = result.response
+			}
+			lastErr = result.err
+			// ошибка — не ждём таймера, сразу зовём следующего
+			if next < len(candidates) {
+				launch(candidates[next])
+				next++
+				pending++
+				timer.Reset(r.stagger)
+			}
+		case <-timer.C:
+			if next < len(candidates) {
+				launch(candidates[next])
+				next++
+				pending++
+				timer.Reset(r.stagger)
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if lastResponse != nil {
+		return lastResponse, nil
+	}
+	return nil, lastErr
+}
+
+// SERVFAIL/REFUSED — upstream не смог ответить, NXDOMAIN — нормальный ответ.
+func isValidResponse(response *mDNS.Msg) bool {
+	return response.Rcode != mDNS.RcodeServerFailure && response.Rcode != mDNS.RcodeRefused
+}
+
+// candidates — здоровые upstream'ы, сначала не мерянные, потом по задержке.
+// Если выведены все, пробуем всех: лучше медленный ответ, чем никакого.
+func (r *Racing) candidates() []*upstream {
+	now := time.Now()
+	var healthy []*upstream
+	latency := make(map[*upstream]time.Duration, len(r.upstreams))
+	for _, u := range r.upstreams {
+		u.access.Lock()
+		latency[u] = u.latency
+		demoted := now.Before(u.demotedUntil)
+		u.access.Unlock()
+		if !demoted {
+			healthy = append(healthy, u)
+		}
+	}
+	if len(healthy) == 0 {
+		healthy = append(healthy, r.upstreams...)
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return latency[healthy[i]] < latency[healthy[j]]
+	})
+	return healthy
+}
+
+func (r *Racing) record(u *upstream, elapsed time.Duration, err error) {
+	u.access.Lock()
+	defer u.access.Unlock()
+	u.queries++
+	if err == nil {
+		u.consecutive = 0
+		if u.latency == 0 {
+			u.latency = elapsed
+		} else {
+			u.latency = (u.latency*7 + elapsed) / 8
+		}
+		return
+	}
+	u.failures++
+	u.consecutive++
+	u.lastError = err.Error()
+	if u.consecutive >= r.maxFailures {
+		u.consecutive = 0
+		u.demotedUntil = time.Now().Add(r.cooldown)
+		r.logger.Warn("dns/", u.transport.Tag(), " demoted for ", r.cooldown, ": ", err)
+	}
+}
+
+// recordLoss — проигравший отвечает не быстрее elapsed. Без этого upstream,
+// который всегда проигрывает, так и остался бы не мерянным и шёл первым.
+func (r *Racing) recordLoss(u *upstream, elapsed time.Duration) {
+	u.access.Lock()
+	defer u.access.Unlock()
+	u.queries++
+	if u.latency == 0 {
+		u.latency = elapsed
+	} else if elapsed > u.latency {
+		u.latency = (u.latency*7 + elapsed) / 8
+	}
+}
@@ -0,0 +1,290 @@
+package dnsgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mDNS "github.com/miekg/dns"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing/common/json/badoption"
+)
+
+// testTransport отвечает через delay кодом rcode или ошибкой err.
+type testTransport struct {
+	dns.TransportAdapter
+	delay time.Duration
+	rcode int
+	err   error
+	calls atomic.Int32
+}
+
+func newTestTransport(tag string, delay time.Duration) *testTransport {
+	return &testTransport{TransportAdapter: dns.NewTransportAdapter("test", tag, nil), delay: delay}
+}
+
+func (t *testTransport) Start(stage adapter.StartStage) error { return nil }
+func (t *testTransport) Close() error                         { return nil }
+func (t *testTransport) Reset()                               {}
+
+func (t *testTransport) Exchange(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+	t.calls.Add(1)
+	select {
+	case <-time.After(t.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if t.err != nil {
+		return nil, t.err
+	}
+	response := new(mDNS.Msg)
+	response.SetReply(message)
+	response.Rcode = t.rcode
+	response.Id = uint16(t.delay / time.Millisecond) // по нему видно, кто ответил
+	return response, nil
+}
+
+// newTestRacing собирает группу без DNS-менеджера: upstream'ы подставляются напрямую.
+func newTestRacing(t *testing.T, options Options, transports ...*testTransport) *Racing {
+	t.Helper()
+	for _, transport := range transports {
+		options.Servers = append(options.Servers, transport.Tag())
+	}
+	created, err := NewRacing(context.Background(), log.NewNOPFactory().Logger(), "racing", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := created.(*Racing)
+	for _, transport := range transports {
+		r.upstreams = append(r.upstreams, &upstream{transport: transport})
+	}
+	return r
+}
+
+func testQuery() *mDNS.Msg {
+	message := new(mDNS.Msg)
+	message.SetQuestion("example.com.", mDNS.TypeA)
+	return message
+}
+
+type upstreamSnapshot struct {
+	queries      uint64
+	failures     uint64
+	consecutive  int
+	latency      time.Duration
+	demotedUntil time.Time
+}
+
+func upstreamState(r *Racing, index int) upstreamSnapshot {
+	u := r.upstreams[index]
+	u.access.Lock()
+	defer u.access.Unlock()
+	return upstreamSnapshot{u.queries, u.failures, u.consecutive, u.latency, u.demotedUntil}
+}
+
+// waitQueries ждёт, пока проигравший запишет свой результат: это происходит
+// уже после ответа группы.
+func waitQueries(t *testing.T, r *Racing, index int, queries uint64) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for upstreamState(r, index).queries < queries {
+		if time.Now().After(deadline) {
+			t.Fatalf("upstream %d: queries = %d, want %d", index, upstreamState(r, index).queries, queries)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRacingParallel(t *testing.T) {
+	slow, fast := newTestTransport("slow", 300*time.Millisecond), newTestTransport("fast", 10*time.Millisecond)
+	r := newTestRacing(t, Options{Mode: ModeParallel}, slow, fast)
+	start := time.Now()
+	response, err := r.Exchange(context.Background(), testQuery())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Id != 10 || time.Since(start) > 200*time.Millisecond {
+		t.Errorf("answered by %d after %s", response.Id, time.Since(start))
+	}
+	if slow.calls.Load() != 1 || fast.calls.Load() != 1 {
+		t.Errorf("calls: slow %d, fast %d", slow.calls.Load(), fast.calls.Load())
+	}
+	// отменённый проигравший не считается ошибкой, но получает задержку
+	waitQueries(t, r, 0, 1)
+	if state := upstreamState(r, 0); state.failures != 0 || state.latency == 0 {
+		t.Errorf("loser state = %+v", state)
+	}
+	if state := upstreamState(r, 1); state.failures != 0 || state.latency == 0 {
+		t.Errorf("winner state = %+v", state)
+	}
+}
+
+func TestRacingStaggered(t *testing.T) {
+	first, second := newTestTransport("first", 10*time.Millisecond), newTestTransport("second", 10*time.Millisecond)
+	r := newTestRacing(t, Options{Stagger: badoption.Duration(100 * time.Millisecond)}, first, second)
+	if _, err := r.Exchange(context.Background(), testQuery()); err != nil {
+		t.Fatal(err)
+	}
+	if second.calls.Load() != 0 {
+		t.Errorf("second upstream called although the first answered within stagger")
+	}
+
+	// первый не успел за stagger — следом запускается второй и выигрывает
+	slow, fast := newTestTransport("slow", 500*time.Millisecond), newTestTransport("fast", 10*time.Millisecond)
+	r = newTestRacing(t, Options{Stagger: badoption.Duration(50 * time.Millisecond)}, slow, fast)
+	response, err := r.Exchange(context.Background(), testQuery())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Id != 10 || fast.calls.Load() != 1 {
+		t.Errorf("answered by %d, fast calls %d", response.Id, fast.calls.Load())
+	}
+}
+
+func TestRacingErrorSkipsStagger(t *testing.T) {
+	broken, backup := newTestTransport("broken", 0), newTestTransport("backup", 10*time.Millisecond)
+	broken.err = errors.New("connection refused")
+	r := newTestRacing(t, Options{Stagger: badoption.Duration(time.Second)}, broken, backup)
+	start := time.Now()
+	if _, err := r.Exchange(context.Background(), testQuery()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("backup waited for stagger: %s", elapsed)
+	}
+	if state := upstreamState(r, 0); state.failures != 1 || state.consecutive != 1 {
+		t.Errorf("broken state = %+v", state)
+	}
+}
+
+func TestRacingFailedResponses(t *testing.T) {
+	servfail, refused := newTestTransport("servfail", 0), newTestTransport("refused", 5*time.Millisecond)
+	servfail.rcode = mDNS.RcodeServerFailure
+	refused.rcode = mDNS.RcodeRefused
+	r := newTestRacing(t, Options{Mode: ModeParallel}, servfail, refused)
+	// оба ответили плохо — возвращается последний ответ, а не ошибка
+	response, err := r.Exchange(context.Background(), testQuery())
+	if err != nil || response == nil || isValidResponse(response) {
+		t.Fatalf("response = %v, err = %v", response, err)
+	}
+	if upstreamState(r, 0).failures != 1 || upstreamState(r, 1).failures != 1 {
+		t.Errorf("failures: %+v, %+v", upstreamState(r, 0), upstreamState(r, 1))
+	}
+
+	nxdomain := newTestTransport("nxdomain", 0)
+	nxdomain.rcode = mDNS.RcodeNameError
+	r = newTestRacing(t, Options{}, nxdomain)
+	if _, err := r.Exchange(context.Background(), testQuery()); err != nil || upstreamState(r, 0).failures != 0 {
+		t.Errorf("nxdomain is a valid answer: %v, %+v", err, upstreamState(r, 0))
+	}
+}
+
+func TestRacingDemotion(t *testing.T) {
+	broken, healthy := newTestTransport("broken", 0), newTestTransport("healthy", 20*time.Millisecond)
+	broken.err = errors.New("timeout")
+	r := newTestRacing(t, Options{Mode: ModeParallel, MaxFailures: 2, Cooldown: badoption.Duration(time.Minute)}, broken, healthy)
+	for i := 0; i < 2; i++ {
+		if _, err := r.Exchange(context.Background(), testQuery()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if state := upstreamState(r, 0); !time.Now().Before(state.demotedUntil) || state.consecutive != 0 {
+		t.Fatalf("broken is not demoted: %+v", state)
+	}
+	candidates := r.candidates()
+	if len(candidates) != 1 || candidates[0].transport != healthy {
+		t.Errorf("candidates = %d", len(candidates))
+	}
+	if _, err := r.Exchange(context.Background(), testQuery()); err != nil {
+		t.Fatal(err)
+	}
+	if broken.calls.Load() != 2 {
+		t.Errorf("demoted upstream called %d times", broken.calls.Load())
+	}
+
+	// выведены все — пробуем всех
+	r.upstreams[1].access.Lock()
+	r.upstreams[1].demotedUntil = time.Now().Add(time.Minute)
+	r.upstreams[1].access.Unlock()
+	if len(r.candidates()) != 2 {
+		t.Errorf("all demoted: candidates = %d", len(r.candidates()))
+	}
+
+	// смена сети возвращает всех в ротацию
+	r.Reset()
+	if state := upstreamState(r, 0); !state.demotedUntil.IsZero() {
+		t.Errorf("reset kept demotion: %+v", state)
+	}
+}
+
+func TestRacingLatencyOrder(t *testing.T) {
+	a, b, c := newTestTransport("a", 0), newTestTransport("b", 0), newTestTransport("c", 0)
+	r := newTestRacing(t, Options{}, a, b, c)
+	r.upstreams[0].latency = 80 * time.Millisecond
+	r.upstreams[1].latency = 20 * time.Millisecond
+	// c ещё не мерили: идёт первым, чтобы узнать его задержку
+	var order []string
+	for _, u := range r.candidates() {
+		order = append(order, u.transport.Tag())
+	}
+	if len(order) != 3 || order[0] != "c" || order[1] != "b" || order[2] != "a" {
+		t.Errorf("order = %v", order)
+	}
+
+	// EWMA: новое значение входит с весом 1/8
+	r.record(r.upstreams[1], 100*time.Millisecond, nil)
+	if latency := upstreamState(r, 1).latency; latency != 30*time.Millisecond {
+		t.Errorf("latency = %s", latency)
+	}
+	// проигрыш только поднимает оценку
+	r.recordLoss(r.upstreams[0], 10*time.Millisecond)
+	if latency := upstreamState(r, 0).latency; latency != 80*time.Millisecond {
+		t.Errorf("loss lowered latency to %s", latency)
+	}
+	r.recordLoss(r.upstreams[2], 40*time.Millisecond)
+	if latency := upstreamState(r, 2).latency; latency != 40*time.Millisecond {
+		t.Errorf("first loss latency = %s", latency)
+	}
+}
+
+func TestRacingStats(t *testing.T) {
+	r := newTestRacing(t, Options{Mode: ModeParallel}, newTestTransport("a", 0))
+	register(r)
+	defer unregister(r)
+	if _, err := r.Exchange(context.Background(), testQuery()); err != nil {
+		t.Fatal(err)
+	}
+	stats := Stats()
+	if len(stats) != 1 || stats[0].Tag != "racing" || stats[0].Mode != ModeParallel {
+		t.Fatalf("stats = %+v", stats)
+	}
+	if upstreams := stats[0].Upstreams; len(upstreams) != 1 || upstreams[0].Tag != "a" || upstreams[0].Queries != 1 {
+		t.Errorf("upstreams = %+v", upstreams)
+	}
+	unregister(r)
+	if len(Stats()) != 0 {
+		t.Errorf("unregistered group is still reported")
+	}
+}
+
+func TestNewRacingOptions(t *testing.T) {
+	logger := log.NewNOPFactory().Logger()
+	if _, err := NewRacing(context.Background(), logger, "racing", Options{}); err == nil {
+		t.Errorf("missing servers must fail")
+	}
+	if _, err := NewRacing(context.Background(), logger, "racing", Options{Servers: []string{"a"}, Mode: "random"}); err == nil {
+		t.Errorf("unknown mode must fail")
+	}
+	created, err := NewRacing(context.Background(), logger, "racing", Options{Servers: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := created.(*Racing)
+	if r.mode != ModeStaggered || r.stagger != defaultStagger || r.maxFailures != defaultMaxFailures || r.cooldown != defaultCooldown {
+		t.Errorf("defaults = %s %s %d %s", r.mode, r.stagger, r.maxFailures, r.cooldown)
+	}
+}
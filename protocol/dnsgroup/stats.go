@@ -0,0 +1,72 @@
+package dnsgroup
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// UpstreamStats — снимок состояния upstream'а внутри группы.
+type UpstreamStats struct {
+	Tag          string
+	Queries      uint64
+	Failures     uint64
+	Latency      time.Duration
+	LastError    string
+	DemotedUntil time.Time
+}
+
+type GroupStats struct {
+	Tag       string
+	Mode      string
+	Upstreams []UpstreamStats
+}
+
+var (
+	groupsAccess sync.Mutex
+	groups       = map[string]*Racing{}
+)
+
+func register(r *Racing) {
+	groupsAccess.Lock()
+	defer groupsAccess.Unlock()
+	groups[r.Tag()] = r
+}
+
+func unregister(r *Racing) {
+	groupsAccess.Lock()
+	defer groupsAccess.Unlock()
+	if groups[r.Tag()] == r {
+		delete(groups, r.Tag())
+	}
+}
+
+// Stats — статистика всех запущенных racing-групп, по тегу.
+func Stats() []GroupStats {
+	groupsAccess.Lock()
+	racings := make([]*Racing, 0, len(groups))
+	for _, r := range groups {
+		racings = append(racings, r)
+	}
+	groupsAccess.Unlock()
+	sort.Slice(racings, func(i, j int) bool { return racings[i].Tag() < racings[j].Tag() })
+
+	result := make([]GroupStats, 0, len(racings))
+	for _, r := range racings {
+		group := GroupStats{Tag: r.Tag(), Mode: r.mode}
+		for _, u := range r.upstreams {
+			u.access.Lock()
+			group.Upstreams = append(group.Upstreams, UpstreamStats{
+				Tag:          u.transport.Tag(),
+				Queries:      u.queries,
+				Failures:     u.failures,
+				Latency:      u.latency,
+				LastError:    u.lastError,
+				DemotedUntil: u.demotedUntil,
+			})
+			u.access.Unlock()
+		}
+		result = append(result, group)
+	}
+	return result
+}
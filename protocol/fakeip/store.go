@@ -0,0 +1,421 @@
+// Package fakeip — fake-IP для sing-box с вытеснением давно не использованных
+// доменов (LRU) и сохранением соответствий между перезапусками. Стандартный
+// store раздаёт адреса по кругу и после рестарта всё забывает: приложения,
+// закэшировавшие fake-IP, стучатся в адреса, которых ядро уже не знает.
+package fakeip
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sagernet/sing-box/adapter"
+	E "github.com/sagernet/sing/common/exceptions"
+	"github.com/sagernet/sing/common/logger"
+	"github.com/sagernet/sing/service/filemanager"
+)
+
+// CacheFileName — файл с соответствиями в рабочей директории ядра.
+const CacheFileName = "fakeip.jsonl"
+
+const (
+	defaultCapacity = 65536 // на семейство адресов
+	saveDelay       = 10 * time.Second
+	compactRatio    = 2
+	compactSlack    = 1024
+)
+
+var _ adapter.FakeIPStore = (*Store)(nil)
+
+type Store struct {
+	logger     logger.Logger
+	path       string
+	inet4Range netip.Prefix
+	inet6Range netip.Prefix
+
+	access sync.Mutex
+	// по семейству: [0] — IPv4, [1] — IPv6; в начале списка — свежие
+	lru       [2]*list.List
+	capacity  [2]int
+	current   [2]netip.Addr
+	byAddress map[netip.Addr]*list.Element
+	byDomain  [2]map[string]*list.Element
+	// выданные и использованные с прошлой записи в журнал
+	dirty    map[*list.Element]struct{}
+	sequence uint64
+	written  int  // записей в журнале
+	compact  bool // журнал надо переписать целиком
+	saving   bool
+	closed   bool
+}
+
+type entry struct {
+	domain  string
+	address netip.Addr
+	used    uint64 // порядок использования — для дозаписи в журнал
+}
+
+func NewStore(ctx context.Context, logger logger.Logger, inet4Range netip.Prefix, inet6Range netip.Prefix) *Store {
+	s := &Store{
+		logger:     logger,
+		path:       filemanager.BasePath(ctx, CacheFileName),
+		inet4Range: inet4Range,
+		inet6Range: inet6Range,
+		byAddress:  make(map[netip.Addr]*list.Element),
+		dirty:      make(map[*list.Element]struct{}),
+		compact:    true,
+	}
+	for i, prefix := range []netip.Prefix{inet4Range, inet6Range} {
+		s.lru[i] = list.New()
+		s.byDomain[i] = make(map[string]*list.Element)
+		s.capacity[i] = rangeCapacity(prefix)
+	}
+	return s
+}
+
+// rangeCapacity — сколько доменов держим: не больше defaultCapacity и не
+// больше, чем адресов в диапазоне (без сети и первого адреса — шлюза).
+func rangeCapacity(prefix netip.Prefix) int {
+	if !prefix.IsValid() {
+		return 0
+	}
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if hostBits >= 31 {
+		return defaultCapacity
+	}
+	return min(defaultCapacity, 1<<hostBits-2)
+}
+
+func (s *Store) Start() error {
+	s.access.Lock()
+	defer s.access.Unlock()
+	for i, prefix := range []netip.Prefix{s.inet4Range, s.inet6Range} {
+		if prefix.IsValid() {
+			s.current[i] = prefix.Addr().Next()
+		}
+	}
+	if err := s.load(); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn("load fakeip cache: ", err)
+	}
+	register(s)
+	return nil
+}
+
+func (s *Store) Close() error {
+	unregister(s)
+	s.access.Lock()
+	defer s.access.Unlock()
+	s.closed = true
+	return s.save()
+}
+
+func (s *Store) Contains(address netip.Addr) bool {
+	return s.inet4Range.Contains(address) || s.inet6Range.Contains(address)
+}
+
+func (s *Store) Create(domain string, isIPv6 bool) (netip.Addr, error) {
+	family := 0
+	if isIPv6 {
+		family = 1
+	}
+	s.access.Lock()
+	defer s.access.Unlock()
+	if element, loaded := s.byDomain[family][domain]; loaded {
+		s.lru[family].MoveToFront(element)
+		s.touch(element)
+		return element.Value.(*entry).address, nil
+	}
+	if s.capacity[family] == 0 {
+		if isIPv6 {
+			return netip.Addr{}, E.New("missing IPv6 fakeip address range")
+		}
+		return netip.Addr{}, E.New("missing IPv4 fakeip address range")
+	}
+	var address netip.Addr
+	if s.lru[family].Len() >= s.capacity[family] {
+		// вытесняем самый давний домен и отдаём его адрес
+		oldest := s.lru[family].Back()
+		address = oldest.Value.(*entry).address
+		s.remove(family, oldest)
+	} else {
+		address = s.allocate(family)
+	}
+	s.touch(s.add(family, domain, address))
+	return address, nil
+}
+
+// allocate — следующий свободный адрес после курсора. Свободный есть всегда:
+// занятых меньше capacity, а capacity меньше размера диапазона.
+func (s *Store) allocate(family int) netip.Addr {
+	prefix := s.inet4Range
+	if family == 1 {
+		prefix = s.inet6Range
+	}
+	address := s.current[family]
+	for {
+		address = address.Next()
+		if !prefix.Contains(address) {
+			address = prefix.Addr().Next().Next()
+		}
+		if _, used := s.byAddress[address]; !used {
+			s.current[family] = address
+			return address
+		}
+	}
+}
+
+func (s *Store) add(family int, domain string, address netip.Addr) *list.Element {
+	element := s.lru[family].PushFront(&entry{domain: domain, address: address})
+	s.byDomain[family][domain] = element
+	s.byAddress[address] = element
+	return element
+}
+
+func (s *Store) remove(family int, element *list.Element) {
+	value := element.Value.(*entry)
+	s.lru[family].Remove(element)
+	delete(s.byDomain[family], value.domain)
+	delete(s.byAddress, value.address)
+	delete(s.dirty, element)
+}
+
+// touch отмечает домен для дозаписи в журнал.
+func (s *Store) touch(element *list.Element) {
+	s.sequence++
+	element.Value.(*entry).used = s.sequence
+	s.dirty[element] = struct{}{}
+	s.scheduleSave()
+}
+
+// Lookup — соединение на fake-IP тоже считается использованием домена.
+func (s *Store) Lookup(address netip.Addr) (string, bool) {
+	s.access.Lock()
+	defer s.access.Unlock()
+	element, loaded := s.byAddress[address]
+	if !loaded {
+		return "", false
+	}
+	family := 0
+	if address.Is6() {
+		family = 1
+	}
+	s.lru[family].MoveToFront(element)
+	s.touch(element)
+	return element.Value.(*entry).domain, true
+}
+
+func (s *Store) Reset() error {
+	s.access.Lock()
+	defer s.access.Unlock()
+	s.reset()
+	s.written, s.compact = 0, true
+	err := os.Remove(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *Store) reset() {
+	for i := range s.lru {
+		s.lru[i].Init()
+		s.byDomain[i] = make(map[string]*list.Element)
+	}
+	s.byAddress = make(map[netip.Addr]*list.Element)
+	clear(s.dirty)
+}
+
+// Файл — журнал в JSON Lines: заголовок с диапазонами, затем записи
+// «домен → адрес» от давних к свежим. Раз в saveDelay дописываются только
+// выданные и использованные с прошлой записи домены; вытеснения отдельно не
+// пишутся — при чтении их повторяет тот же LRU. Когда журнал вырастает
+// больше чем в compactRatio раз относительно числа доменов, он переписывается
+// целиком.
+type cacheHeader struct {
+	Inet4Range string `json:"inet4_range,omitempty"`
+	Inet6Range string `json:"inet6_range,omitempty"`
+}
+
+type cacheRecord struct {
+	Domain  string     `json:"domain"`
+	Address netip.Addr `json:"address"`
+}
+
+// load — только если диапазоны не поменялись, иначе адреса уже не наши.
+// Оборванная при падении последняя строка отбрасывается.
+func (s *Store) load() error {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	var header cacheHeader
+	if err = decoder.Decode(&header); err != nil {
+		return err
+	}
+	if header.Inet4Range != prefixString(s.inet4Range) || header.Inet6Range != prefixString(s.inet6Range) {
+		return nil
+	}
+	var records int
+	for {
+		var record cacheRecord
+		if err = decoder.Decode(&record); err != nil {
+			break
+		}
+		records++
+		s.replay(record)
+	}
+	s.written = records
+	s.compact = !errors.Is(err, io.EOF)
+	return nil
+}
+
+// replay повторяет выдачу адреса: запись свежее всех прочитанных до неё.
+func (s *Store) replay(record cacheRecord) {
+	family := 0
+	prefix := s.inet4Range
+	if record.Address.Is6() {
+		family = 1
+		prefix = s.inet6Range
+	}
+	if !prefix.Contains(record.Address) || record.Address == prefix.Addr() || record.Address == prefix.Addr().Next() {
+		return
+	}
+	if element, used := s.byDomain[family][record.Domain]; used {
+		s.remove(family, element)
+	}
+	if element, used := s.byAddress[record.Address]; used {
+		s.remove(family, element)
+	}
+	if s.lru[family].Len() >= s.capacity[family] {
+		s.remove(family, s.lru[family].Back())
+	}
+	s.add(family, record.Domain, record.Address)
+	s.current[family] = record.Address
+}
+
+// save дописывает изменения в журнал или переписывает его целиком.
+func (s *Store) save() error {
+	var size int
+	for _, lru := range s.lru {
+		size += lru.Len()
+	}
+	if s.compact || s.written+len(s.dirty) > compactRatio*size+compactSlack {
+		return s.rewrite()
+	}
+	if len(s.dirty) == 0 {
+		return nil
+	}
+	// без O_CREATE: пропавший файл пишется заново вместе с заголовком
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if os.IsNotExist(err) {
+		return s.rewrite()
+	} else if err != nil {
+		return err
+	}
+	dirty := make([]*entry, 0, len(s.dirty))
+	for element := range s.dirty {
+		dirty = append(dirty, element.Value.(*entry))
+	}
+	sort.Slice(dirty, func(i, j int) bool {
+		return dirty[i].used < dirty[j].used
+	})
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, value := range dirty {
+		if err = encoder.Encode(cacheRecord{Domain: value.domain, Address: value.address}); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// недописанную строку отбросит load, а следующая запись перепишет файл
+		s.compact = true
+		return err
+	}
+	s.written += len(dirty)
+	clear(s.dirty)
+	return nil
+}
+
+// rewrite — снимок всех соответствий от давних к свежим во временный файл и rename.
+func (s *Store) rewrite() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	temp := s.path + ".tmp"
+	file, err := os.Create(temp)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	err = encoder.Encode(cacheHeader{Inet4Range: prefixString(s.inet4Range), Inet6Range: prefixString(s.inet6Range)})
+	var records int
+	for _, lru := range s.lru {
+		for element := lru.Back(); element != nil && err == nil; element = element.Prev() {
+			value := element.Value.(*entry)
+			err = encoder.Encode(cacheRecord{Domain: value.domain, Address: value.address})
+			records++
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp, s.path)
+	}
+	if err != nil {
+		os.Remove(temp)
+		return err
+	}
+	s.written = records
+	s.compact = false
+	clear(s.dirty)
+	return nil
+}
+
+// scheduleSave — пишем на диск не чаще раза в saveDelay.
+func (s *Store) scheduleSave() {
+	if s.saving {
+		return
+	}
+	s.saving = true
+	time.AfterFunc(saveDelay, func() {
+		s.access.Lock()
+		defer s.access.Unlock()
+		s.saving = false
+		// после Close файлом владеет уже новый store
+		if s.closed {
+			return
+		}
+		if err := s.save(); err != nil {
+			s.logger.Warn("save fakeip cache: ", err)
+		}
+	})
+}
+
+func prefixString(prefix netip.Prefix) string {
+	if !prefix.IsValid() {
+		return ""
+	}
+	return prefix.String()
+}
@@ -0,0 +1,244 @@
+package fakeip
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing/service/filemanager"
+)
+
+// /29 — шесть адресов под домены: .2-.7
+var (
+	testInet4Range = netip.MustParsePrefix("198.18.0.0/29")
+	testInet6Range = netip.MustParsePrefix("fc00::/120")
+)
+
+func newTestStore(t *testing.T, dir string, inet4Range netip.Prefix) *Store {
+	t.Helper()
+	ctx := filemanager.WithDefault(context.Background(), dir, dir, os.Getuid(), os.Getgid())
+	s := NewStore(ctx, log.NewNOPFactory().Logger(), inet4Range, testInet6Range)
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func mustCreate(t *testing.T, s *Store, domain string, isIPv6 bool) netip.Addr {
+	t.Helper()
+	address, err := s.Create(domain, isIPv6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return address
+}
+
+func saveStore(t *testing.T, s *Store) {
+	t.Helper()
+	s.access.Lock()
+	defer s.access.Unlock()
+	if err := s.save(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func journalLines(t *testing.T, s *Store) int {
+	t.Helper()
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Count(content, []byte("\n"))
+}
+
+func TestStoreCreate(t *testing.T) {
+	s := newTestStore(t, t.TempDir(), testInet4Range)
+	first := mustCreate(t, s, "a.example", false)
+	if first != netip.MustParseAddr("198.18.0.2") {
+		t.Errorf("first address = %s", first)
+	}
+	if again := mustCreate(t, s, "a.example", false); again != first {
+		t.Errorf("same domain got %s and %s", first, again)
+	}
+	if second := mustCreate(t, s, "b.example", false); second == first {
+		t.Errorf("different domains share %s", first)
+	}
+	if address := mustCreate(t, s, "a.example", true); !address.Is6() || !testInet6Range.Contains(address) {
+		t.Errorf("IPv6 address = %s", address)
+	}
+	if domain, loaded := s.Lookup(first); !loaded || domain != "a.example" {
+		t.Errorf("lookup = %q, %v", domain, loaded)
+	}
+	if _, loaded := s.Lookup(netip.MustParseAddr("198.18.0.7")); loaded {
+		t.Errorf("unused address resolved")
+	}
+
+	noIPv6 := NewStore(context.Background(), log.NewNOPFactory().Logger(), testInet4Range, netip.Prefix{})
+	if _, err := noIPv6.Create("a.example", true); err == nil {
+		t.Errorf("missing IPv6 range must fail")
+	}
+}
+
+func TestStoreEviction(t *testing.T) {
+	s := newTestStore(t, t.TempDir(), testInet4Range)
+	addresses := make([]netip.Addr, 6)
+	for i := range addresses {
+		addresses[i] = mustCreate(t, s, fmt.Sprintf("%d.example", i), false)
+	}
+	// соединение на адрес — тоже использование: 0 становится свежим
+	s.Lookup(addresses[0])
+	mustCreate(t, s, "1.example", false)
+
+	// вытесняется самый давний — 2, его адрес переходит новому домену
+	if address := mustCreate(t, s, "new.example", false); address != addresses[2] {
+		t.Errorf("new domain got %s, want the address of the oldest %s", address, addresses[2])
+	}
+	if _, loaded := s.Lookup(addresses[2]); !loaded {
+		t.Errorf("reused address is not resolved")
+	}
+	if domain, _ := s.Lookup(addresses[2]); domain != "new.example" {
+		t.Errorf("reused address resolves to %s", domain)
+	}
+	for _, i := range []int{0, 1, 3, 4, 5} {
+		if domain, loaded := s.Lookup(addresses[i]); !loaded || domain != fmt.Sprintf("%d.example", i) {
+			t.Errorf("%s = %q, %v", addresses[i], domain, loaded)
+		}
+	}
+}
+
+func TestStorePersistence(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStore(t, dir, testInet4Range)
+	addresses := make(map[string]netip.Addr)
+	for _, domain := range []string{"a.example", "b.example", "c.example"} {
+		addresses[domain] = mustCreate(t, s, domain, false)
+	}
+	addresses["v6.example"] = mustCreate(t, s, "v6.example", true)
+	s.Lookup(addresses["a.example"])
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// порядок LRU восстановлен: a использован последним, поэтому новые
+	// домены вытесняют b, хотя он выдан позже a
+	s = newTestStore(t, dir, testInet4Range)
+	for i := 0; i < 4; i++ {
+		mustCreate(t, s, fmt.Sprintf("%d.example", i), false)
+	}
+	if domain, _ := s.Lookup(addresses["b.example"]); domain == "b.example" {
+		t.Errorf("b.example survived eviction")
+	}
+	delete(addresses, "b.example")
+	for domain, address := range addresses {
+		if loaded, _ := s.Lookup(address); loaded != domain {
+			t.Errorf("%s resolves to %q after reload, want %s", address, loaded, domain)
+		}
+		if again := mustCreate(t, s, domain, address.Is6()); again != address {
+			t.Errorf("%s got %s after reload, want %s", domain, again, address)
+		}
+	}
+}
+
+func TestStoreIncrementalSave(t *testing.T) {
+	s := newTestStore(t, t.TempDir(), netip.MustParsePrefix("198.18.0.0/16"))
+	for i := 0; i < 100; i++ {
+		mustCreate(t, s, fmt.Sprintf("%d.example", i), false)
+	}
+	saveStore(t, s)
+	if lines := journalLines(t, s); lines != 101 {
+		t.Fatalf("journal = %d lines, want header and 100 records", lines)
+	}
+	// дописывается только использованный домен, не весь снимок
+	mustCreate(t, s, "5.example", false)
+	saveStore(t, s)
+	if lines := journalLines(t, s); lines != 102 {
+		t.Errorf("journal = %d lines after one touch", lines)
+	}
+	saveStore(t, s)
+	if lines := journalLines(t, s); lines != 102 {
+		t.Errorf("nothing changed, but journal = %d lines", lines)
+	}
+	// журнал не растёт бесконечно: переписывается снимком
+	for round := 0; round < 30; round++ {
+		for i := 0; i < 100; i++ {
+			mustCreate(t, s, fmt.Sprintf("%d.example", i), false)
+		}
+		saveStore(t, s)
+	}
+	if lines := journalLines(t, s); lines > compactRatio*100+compactSlack+1 {
+		t.Errorf("journal = %d lines for 100 domains", lines)
+	}
+}
+
+func TestStoreReloadDamagedJournal(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStore(t, dir, testInet4Range)
+	a := mustCreate(t, s, "a.example", false)
+	s.Close()
+	// падение посреди дозаписи оставляет оборванную строку
+	file, err := os.OpenFile(filepath.Join(dir, CacheFileName), os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString(`{"domain":"b.exa`)
+	file.Close()
+
+	s = newTestStore(t, dir, testInet4Range)
+	if domain, _ := s.Lookup(a); domain != "a.example" {
+		t.Errorf("records before the damaged line are lost: %q", domain)
+	}
+	// оборванная строка не должна склеиться со следующей записью
+	saveStore(t, s)
+	s.Close()
+	s = newTestStore(t, dir, testInet4Range)
+	if domain, _ := s.Lookup(a); domain != "a.example" || s.compact {
+		t.Errorf("journal is not repaired: %q, compact %v", domain, s.compact)
+	}
+}
+
+func TestStoreRangeChange(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStore(t, dir, testInet4Range)
+	address := mustCreate(t, s, "a.example", false)
+	s.Close()
+
+	s = newTestStore(t, dir, netip.MustParsePrefix("198.18.0.0/30"))
+	if _, loaded := s.Lookup(address); loaded {
+		t.Errorf("mapping from another range is loaded")
+	}
+	mustCreate(t, s, "b.example", false)
+	saveStore(t, s)
+	s.Close()
+	// журнал переписан под новый диапазон, старые записи в него не попали
+	s = newTestStore(t, dir, netip.MustParsePrefix("198.18.0.0/30"))
+	if len(s.byAddress) != 1 {
+		t.Errorf("loaded %d mappings, want 1", len(s.byAddress))
+	}
+}
+
+func TestClear(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStore(t, dir, testInet4Range)
+	address := mustCreate(t, s, "a.example", false)
+	saveStore(t, s)
+	if err := Clear(s.path); err != nil {
+		t.Fatal(err)
+	}
+	if _, loaded := s.Lookup(address); loaded {
+		t.Errorf("running store kept its mappings")
+	}
+	if _, err := os.Stat(s.path); !os.IsNotExist(err) {
+		t.Errorf("cache file is not removed: %v", err)
+	}
+	s.Close()
+	s = newTestStore(t, dir, testInet4Range)
+	if _, loaded := s.Lookup(address); loaded {
+		t.Errorf("cleared mapping reloaded")
+	}
+}
@@ -0,0 +1,104 @@
+package fakeip
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"sync"
+
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	E "github.com/sagernet/sing/common/exceptions"
+
+	mDNS "github.com/miekg/dns"
+)
+
+// Register подменяет стандартный fakeip-сервер: тип и опции те же,
+// поэтому работает и legacy-конфиг dns.fakeip.
+func Register(registry *dns.TransportRegistry) {
+	dns.RegisterTransport[option.FakeIPDNSServerOptions](registry, C.DNSTypeFakeIP, NewTransport)
+}
+
+var _ adapter.FakeIPTransport = (*Transport)(nil)
+
+type Transport struct {
+	dns.TransportAdapter
+	store *Store
+}
+
+func NewTransport(ctx context.Context, logger log.ContextLogger, tag string, options option.FakeIPDNSServerOptions) (adapter.DNSTransport, error) {
+	return &Transport{
+		TransportAdapter: dns.NewTransportAdapter(C.DNSTypeFakeIP, tag, nil),
+		store:            NewStore(ctx, logger, options.Inet4Range.Build(netip.Prefix{}), options.Inet6Range.Build(netip.Prefix{})),
+	}, nil
+}
+
+func (t *Transport) Start(stage adapter.StartStage) error {
+	if stage != adapter.StartStateStart {
+		return nil
+	}
+	return t.store.Start()
+}
+
+func (t *Transport) Close() error {
+	return t.store.Close()
+}
+
+func (t *Transport) Reset() {
+}
+
+func (t *Transport) Exchange(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+	question := message.Question[0]
+	if question.Qtype != mDNS.TypeA && question.Qtype != mDNS.TypeAAAA {
+		return nil, E.New("only IP queries are supported by fakeip")
+	}
+	address, err := t.store.Create(dns.FqdnToDomain(question.Name), question.Qtype == mDNS.TypeAAAA)
+	if err != nil {
+		return nil, err
+	}
+	return dns.FixedResponse(message.Id, question, []netip.Addr{address}, C.DefaultDNSTTL), nil
+}
+
+func (t *Transport) Store() adapter.FakeIPStore {
+	return t.store
+}
+
+var (
+	storesAccess sync.Mutex
+	stores       = map[*Store]struct{}{}
+)
+
+func register(s *Store) {
+	storesAccess.Lock()
+	defer storesAccess.Unlock()
+	stores[s] = struct{}{}
+}
+
+func unregister(s *Store) {
+	storesAccess.Lock()
+	defer storesAccess.Unlock()
+	delete(stores, s)
+}
+
+// Clear сбрасывает соответствия запущенного ядра и удаляет файл path —
+// сохранённый кэш, если ядро не запущено.
+func Clear(path string) error {
+	storesAccess.Lock()
+	running := make([]*Store, 0, len(stores))
+	for s := range stores {
+		running = append(running, s)
+	}
+	storesAccess.Unlock()
+	for _, s := range running {
+		if err := s.Reset(); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
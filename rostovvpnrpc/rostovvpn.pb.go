@@ -154,6 +154,55 @@ func (MessageType) EnumDescriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{1}
 }
 
+type ExportFormat int32
+
+const (
+	ExportFormat_LINKS        ExportFormat = 0
+	ExportFormat_SUBSCRIPTION ExportFormat = 1
+	ExportFormat_CLASH        ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "LINKS",
+		1: "SUBSCRIPTION",
+		2: "CLASH",
+	}
+	ExportFormat_value = map[string]int32{
+		"LINKS":        0,
+		"SUBSCRIPTION": 1,
+		"CLASH":        2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rostovvpn_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_rostovvpn_proto_enumTypes[2]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{2}
+}
+
 type LogLevel int32
 
 const (
@@ -193,11 +242,11 @@ func (x LogLevel) String() string {
 }
 
 func (LogLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_rostovvpn_proto_enumTypes[2].Descriptor()
+	return file_rostovvpn_proto_enumTypes[3].Descriptor()
 }
 
 func (LogLevel) Type() protoreflect.EnumType {
-	return &file_rostovvpn_proto_enumTypes[2]
+	return &file_rostovvpn_proto_enumTypes[3]
 }
 
 func (x LogLevel) Number() protoreflect.EnumNumber {
@@ -206,7 +255,7 @@ func (x LogLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogLevel.Descriptor instead.
 func (LogLevel) EnumDescriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{2}
+	return file_rostovvpn_proto_rawDescGZIP(), []int{3}
 }
 
 type LogType int32
@@ -242,11 +291,11 @@ func (x LogType) String() string {
 }
 
 func (LogType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rostovvpn_proto_enumTypes[3].Descriptor()
+	return file_rostovvpn_proto_enumTypes[4].Descriptor()
 }
 
 func (LogType) Type() protoreflect.EnumType {
-	return &file_rostovvpn_proto_enumTypes[3]
+	return &file_rostovvpn_proto_enumTypes[4]
 }
 
 func (x LogType) Number() protoreflect.EnumNumber {
@@ -255,7 +304,7 @@ func (x LogType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogType.Descriptor instead.
 func (LogType) EnumDescriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{3}
+	return file_rostovvpn_proto_rawDescGZIP(), []int{4}
 }
 
 type CoreInfoResponse struct {
@@ -332,6 +381,7 @@ type StartRequest struct {
 	DelayStart             bool   `protobuf:"varint,4,opt,name=delay_start,json=delayStart,proto3" json:"delay_start,omitempty"`
 	EnableOldCommandServer bool   `protobuf:"varint,5,opt,name=enable_old_command_server,json=enableOldCommandServer,proto3" json:"enable_old_command_server,omitempty"`
 	EnableRawConfig        bool   `protobuf:"varint,6,opt,name=enable_raw_config,json=enableRawConfig,proto3" json:"enable_raw_config,omitempty"`
+	ProfileId              string `protobuf:"bytes,7,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"` // постоянный id профиля в приложении: по нему хранится выбор в селекторах
 }
 
 func (x *StartRequest) Reset() {
@@ -408,6 +458,13 @@ func (x *StartRequest) GetEnableRawConfig() bool {
 	return false
 }
 
+func (x *StartRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
 type SetupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -642,10 +699,12 @@ type OutboundGroupItem struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag          string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	UrlTestTime  int64  `protobuf:"varint,3,opt,name=url_test_time,json=urlTestTime,proto3" json:"url_test_time,omitempty"`
-	UrlTestDelay int32  `protobuf:"varint,4,opt,name=url_test_delay,json=urlTestDelay,proto3" json:"url_test_delay,omitempty"`
+	Tag          string    `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type         string    `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	UrlTestTime  int64     `protobuf:"varint,3,opt,name=url_test_time,json=urlTestTime,proto3" json:"url_test_time,omitempty"`
+	UrlTestDelay int32     `protobuf:"varint,4,opt,name=url_test_delay,json=urlTestDelay,proto3" json:"url_test_delay,omitempty"`
+	Country      string    `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"` // ISO-код страны сервера: GeoIP, иначе по тегу
+	Exit         *ExitInfo `protobuf:"bytes,6,opt,name=exit,proto3" json:"exit,omitempty"`       // последняя проверка CheckExit, если была
 }
 
 func (x *OutboundGroupItem) Reset() {
@@ -708,19 +767,37 @@ func (x *OutboundGroupItem) GetUrlTestDelay() int32 {
 	return 0
 }
 
-type OutboundGroup struct {
+func (x *OutboundGroupItem) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *OutboundGroupItem) GetExit() *ExitInfo {
+	if x != nil {
+		return x.Exit
+	}
+	return nil
+}
+
+type ExitInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tag      string               `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Type     string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Selected string               `protobuf:"bytes,3,opt,name=selected,proto3" json:"selected,omitempty"`
-	Items    []*OutboundGroupItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Tag       string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Ip        string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Colo      string `protobuf:"bytes,3,opt,name=colo,proto3" json:"colo,omitempty"` // дата-центр Cloudflare, через который вышли
+	Country   string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Ipv6      bool   `protobuf:"varint,5,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	LatencyMs int32  `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	CheckedAt int64  `protobuf:"varint,7,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // unix seconds
+	Error     string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *OutboundGroup) Reset() {
-	*x = OutboundGroup{}
+func (x *ExitInfo) Reset() {
+	*x = ExitInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,13 +805,13 @@ func (x *OutboundGroup) Reset() {
 	}
 }
 
-func (x *OutboundGroup) String() string {
+func (x *ExitInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutboundGroup) ProtoMessage() {}
+func (*ExitInfo) ProtoMessage() {}
 
-func (x *OutboundGroup) ProtoReflect() protoreflect.Message {
+func (x *ExitInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,49 +823,77 @@ func (x *OutboundGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutboundGroup.ProtoReflect.Descriptor instead.
-func (*OutboundGroup) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExitInfo.ProtoReflect.Descriptor instead.
+func (*ExitInfo) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *OutboundGroup) GetTag() string {
+func (x *ExitInfo) GetTag() string {
 	if x != nil {
 		return x.Tag
 	}
 	return ""
 }
 
-func (x *OutboundGroup) GetType() string {
+func (x *ExitInfo) GetIp() string {
 	if x != nil {
-		return x.Type
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *OutboundGroup) GetSelected() string {
+func (x *ExitInfo) GetColo() string {
 	if x != nil {
-		return x.Selected
+		return x.Colo
 	}
 	return ""
 }
 
-func (x *OutboundGroup) GetItems() []*OutboundGroupItem {
+func (x *ExitInfo) GetCountry() string {
 	if x != nil {
-		return x.Items
+		return x.Country
 	}
-	return nil
+	return ""
 }
 
-type OutboundGroupList struct {
+func (x *ExitInfo) GetIpv6() bool {
+	if x != nil {
+		return x.Ipv6
+	}
+	return false
+}
+
+func (x *ExitInfo) GetLatencyMs() int32 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ExitInfo) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *ExitInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CheckExitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*OutboundGroup `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	OutboundTag string `protobuf:"bytes,1,opt,name=outbound_tag,json=outboundTag,proto3" json:"outbound_tag,omitempty"` // пусто — все прокси
 }
 
-func (x *OutboundGroupList) Reset() {
-	*x = OutboundGroupList{}
+func (x *CheckExitRequest) Reset() {
+	*x = CheckExitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,13 +901,13 @@ func (x *OutboundGroupList) Reset() {
 	}
 }
 
-func (x *OutboundGroupList) String() string {
+func (x *CheckExitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutboundGroupList) ProtoMessage() {}
+func (*CheckExitRequest) ProtoMessage() {}
 
-func (x *OutboundGroupList) ProtoReflect() protoreflect.Message {
+func (x *CheckExitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,29 +919,30 @@ func (x *OutboundGroupList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutboundGroupList.ProtoReflect.Descriptor instead.
-func (*OutboundGroupList) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckExitRequest.ProtoReflect.Descriptor instead.
+func (*CheckExitRequest) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *OutboundGroupList) GetItems() []*OutboundGroup {
+func (x *CheckExitRequest) GetOutboundTag() string {
 	if x != nil {
-		return x.Items
+		return x.OutboundTag
 	}
-	return nil
+	return ""
 }
 
-type WarpAccount struct {
+type CheckExitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId   string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ResponseCode ResponseCode `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Results      []*ExitInfo  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Message      string       `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *WarpAccount) Reset() {
-	*x = WarpAccount{}
+func (x *CheckExitResponse) Reset() {
+	*x = CheckExitResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,13 +950,13 @@ func (x *WarpAccount) Reset() {
 	}
 }
 
-func (x *WarpAccount) String() string {
+func (x *CheckExitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarpAccount) ProtoMessage() {}
+func (*CheckExitResponse) ProtoMessage() {}
 
-func (x *WarpAccount) ProtoReflect() protoreflect.Message {
+func (x *CheckExitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -862,39 +968,47 @@ func (x *WarpAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarpAccount.ProtoReflect.Descriptor instead.
-func (*WarpAccount) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckExitResponse.ProtoReflect.Descriptor instead.
+func (*CheckExitResponse) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *WarpAccount) GetAccountId() string {
+func (x *CheckExitResponse) GetResponseCode() ResponseCode {
 	if x != nil {
-		return x.AccountId
+		return x.ResponseCode
 	}
-	return ""
+	return ResponseCode_OK
 }
 
-func (x *WarpAccount) GetAccessToken() string {
+func (x *CheckExitResponse) GetResults() []*ExitInfo {
 	if x != nil {
-		return x.AccessToken
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CheckExitResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type WarpWireguardConfig struct {
+type DNSUpstreamStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrivateKey       string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	LocalAddressIpv4 string `protobuf:"bytes,2,opt,name=local_address_ipv4,json=localAddressIpv4,proto3" json:"local_address_ipv4,omitempty"`
-	LocalAddressIpv6 string `protobuf:"bytes,3,opt,name=local_address_ipv6,json=localAddressIpv6,proto3" json:"local_address_ipv6,omitempty"`
-	PeerPublicKey    string `protobuf:"bytes,4,opt,name=peer_public_key,json=peerPublicKey,proto3" json:"peer_public_key,omitempty"`
-	ClientId         string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Tag          string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Queries      uint64 `protobuf:"varint,2,opt,name=queries,proto3" json:"queries,omitempty"`
+	Failures     uint64 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	LatencyMs    int32  `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"` // сглаженная по успешным ответам, 0 — ещё не мерили
+	LastError    string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DemotedUntil int64  `protobuf:"varint,6,opt,name=demoted_until,json=demotedUntil,proto3" json:"demoted_until,omitempty"` // unix seconds, 0 — в ротации
 }
 
-func (x *WarpWireguardConfig) Reset() {
-	*x = WarpWireguardConfig{}
+func (x *DNSUpstreamStats) Reset() {
+	*x = DNSUpstreamStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,13 +1016,13 @@ func (x *WarpWireguardConfig) Reset() {
 	}
 }
 
-func (x *WarpWireguardConfig) String() string {
+func (x *DNSUpstreamStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarpWireguardConfig) ProtoMessage() {}
+func (*DNSUpstreamStats) ProtoMessage() {}
 
-func (x *WarpWireguardConfig) ProtoReflect() protoreflect.Message {
+func (x *DNSUpstreamStats) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -920,58 +1034,65 @@ func (x *WarpWireguardConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarpWireguardConfig.ProtoReflect.Descriptor instead.
-func (*WarpWireguardConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSUpstreamStats.ProtoReflect.Descriptor instead.
+func (*DNSUpstreamStats) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *WarpWireguardConfig) GetPrivateKey() string {
+func (x *DNSUpstreamStats) GetTag() string {
 	if x != nil {
-		return x.PrivateKey
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *WarpWireguardConfig) GetLocalAddressIpv4() string {
+func (x *DNSUpstreamStats) GetQueries() uint64 {
 	if x != nil {
-		return x.LocalAddressIpv4
+		return x.Queries
 	}
-	return ""
+	return 0
 }
 
-func (x *WarpWireguardConfig) GetLocalAddressIpv6() string {
+func (x *DNSUpstreamStats) GetFailures() uint64 {
 	if x != nil {
-		return x.LocalAddressIpv6
+		return x.Failures
 	}
-	return ""
+	return 0
 }
 
-func (x *WarpWireguardConfig) GetPeerPublicKey() string {
+func (x *DNSUpstreamStats) GetLatencyMs() int32 {
 	if x != nil {
-		return x.PeerPublicKey
+		return x.LatencyMs
 	}
-	return ""
+	return 0
 }
 
-func (x *WarpWireguardConfig) GetClientId() string {
+func (x *DNSUpstreamStats) GetLastError() string {
 	if x != nil {
-		return x.ClientId
+		return x.LastError
 	}
 	return ""
 }
 
-type WarpGenerationResponse struct {
+func (x *DNSUpstreamStats) GetDemotedUntil() int64 {
+	if x != nil {
+		return x.DemotedUntil
+	}
+	return 0
+}
+
+type DNSGroupStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account *WarpAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Log     string               `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
-	Config  *WarpWireguardConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Tag       string              `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Mode      string              `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Upstreams []*DNSUpstreamStats `protobuf:"bytes,3,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
 }
 
-func (x *WarpGenerationResponse) Reset() {
-	*x = WarpGenerationResponse{}
+func (x *DNSGroupStats) Reset() {
+	*x = DNSGroupStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -979,13 +1100,13 @@ func (x *WarpGenerationResponse) Reset() {
 	}
 }
 
-func (x *WarpGenerationResponse) String() string {
+func (x *DNSGroupStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarpGenerationResponse) ProtoMessage() {}
+func (*DNSGroupStats) ProtoMessage() {}
 
-func (x *WarpGenerationResponse) ProtoReflect() protoreflect.Message {
+func (x *DNSGroupStats) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -997,43 +1118,44 @@ func (x *WarpGenerationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarpGenerationResponse.ProtoReflect.Descriptor instead.
-func (*WarpGenerationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSGroupStats.ProtoReflect.Descriptor instead.
+func (*DNSGroupStats) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WarpGenerationResponse) GetAccount() *WarpAccount {
+func (x *DNSGroupStats) GetTag() string {
 	if x != nil {
-		return x.Account
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *WarpGenerationResponse) GetLog() string {
+func (x *DNSGroupStats) GetMode() string {
 	if x != nil {
-		return x.Log
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *WarpGenerationResponse) GetConfig() *WarpWireguardConfig {
+func (x *DNSGroupStats) GetUpstreams() []*DNSUpstreamStats {
 	if x != nil {
-		return x.Config
+		return x.Upstreams
 	}
 	return nil
 }
 
-type SystemProxyStatus struct {
+type DNSStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	Enabled   bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ResponseCode ResponseCode     `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Groups       []*DNSGroupStats `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Message      string           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SystemProxyStatus) Reset() {
-	*x = SystemProxyStatus{}
+func (x *DNSStatsResponse) Reset() {
+	*x = DNSStatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1041,13 +1163,13 @@ func (x *SystemProxyStatus) Reset() {
 	}
 }
 
-func (x *SystemProxyStatus) String() string {
+func (x *DNSStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemProxyStatus) ProtoMessage() {}
+func (*DNSStatsResponse) ProtoMessage() {}
 
-func (x *SystemProxyStatus) ProtoReflect() protoreflect.Message {
+func (x *DNSStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1059,38 +1181,53 @@ func (x *SystemProxyStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemProxyStatus.ProtoReflect.Descriptor instead.
-func (*SystemProxyStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSStatsResponse.ProtoReflect.Descriptor instead.
+func (*DNSStatsResponse) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SystemProxyStatus) GetAvailable() bool {
+func (x *DNSStatsResponse) GetResponseCode() ResponseCode {
 	if x != nil {
-		return x.Available
+		return x.ResponseCode
 	}
-	return false
+	return ResponseCode_OK
 }
 
-func (x *SystemProxyStatus) GetEnabled() bool {
+func (x *DNSStatsResponse) GetGroups() []*DNSGroupStats {
 	if x != nil {
-		return x.Enabled
+		return x.Groups
 	}
-	return false
+	return nil
 }
 
-type ParseRequest struct {
+func (x *DNSStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DNSQueryEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content    string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ConfigPath string `protobuf:"bytes,2,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
-	TempPath   string `protobuf:"bytes,3,opt,name=temp_path,json=tempPath,proto3" json:"temp_path,omitempty"`
-	Debug      bool   `protobuf:"varint,4,opt,name=debug,proto3" json:"debug,omitempty"`
-}
-
-func (x *ParseRequest) Reset() {
-	*x = ParseRequest{}
+	Time      int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // unix milliseconds
+	Domain    string   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Type      string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // A, AAAA, ... или lookup для резолва домена соединения
+	Inbound   string   `protobuf:"bytes,4,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	RuleIndex int32    `protobuf:"varint,5,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"` // индекс DNS-правила, -1 — final
+	Rule      string   `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	Upstream  string   `protobuf:"bytes,7,opt,name=upstream,proto3" json:"upstream,omitempty"` // тег DNS-сервера, predefined/reject; пусто — final
+	Answers   []string `protobuf:"bytes,8,rep,name=answers,proto3" json:"answers,omitempty"`
+	Rcode     string   `protobuf:"bytes,9,opt,name=rcode,proto3" json:"rcode,omitempty"`
+	CacheHit  bool     `protobuf:"varint,10,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`
+	LatencyMs int32    `protobuf:"varint,11,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error     string   `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DNSQueryEntry) Reset() {
+	*x = DNSQueryEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1098,13 +1235,13 @@ func (x *ParseRequest) Reset() {
 	}
 }
 
-func (x *ParseRequest) String() string {
+func (x *DNSQueryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseRequest) ProtoMessage() {}
+func (*DNSQueryEntry) ProtoMessage() {}
 
-func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+func (x *DNSQueryEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1116,51 +1253,107 @@ func (x *ParseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
-func (*ParseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSQueryEntry.ProtoReflect.Descriptor instead.
+func (*DNSQueryEntry) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ParseRequest) GetContent() string {
+func (x *DNSQueryEntry) GetTime() int64 {
 	if x != nil {
-		return x.Content
+		return x.Time
+	}
+	return 0
+}
+
+func (x *DNSQueryEntry) GetDomain() string {
+	if x != nil {
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *ParseRequest) GetConfigPath() string {
+func (x *DNSQueryEntry) GetType() string {
 	if x != nil {
-		return x.ConfigPath
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ParseRequest) GetTempPath() string {
+func (x *DNSQueryEntry) GetInbound() string {
 	if x != nil {
-		return x.TempPath
+		return x.Inbound
 	}
 	return ""
 }
 
-func (x *ParseRequest) GetDebug() bool {
+func (x *DNSQueryEntry) GetRuleIndex() int32 {
 	if x != nil {
-		return x.Debug
+		return x.RuleIndex
 	}
-	return false
+	return 0
 }
 
-type ParseResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DNSQueryEntry) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
 
-	ResponseCode ResponseCode `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
-	Content      string       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Message      string       `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+func (x *DNSQueryEntry) GetUpstream() string {
+	if x != nil {
+		return x.Upstream
+	}
+	return ""
 }
 
-func (x *ParseResponse) Reset() {
-	*x = ParseResponse{}
+func (x *DNSQueryEntry) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *DNSQueryEntry) GetRcode() string {
+	if x != nil {
+		return x.Rcode
+	}
+	return ""
+}
+
+func (x *DNSQueryEntry) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
+func (x *DNSQueryEntry) GetLatencyMs() int32 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *DNSQueryEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DNSDomainCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain   string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Queries  int32  `protobuf:"varint,2,opt,name=queries,proto3" json:"queries,omitempty"`
+	Failures int32  `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *DNSDomainCount) Reset() {
+	*x = DNSDomainCount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1168,13 +1361,13 @@ func (x *ParseResponse) Reset() {
 	}
 }
 
-func (x *ParseResponse) String() string {
+func (x *DNSDomainCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseResponse) ProtoMessage() {}
+func (*DNSDomainCount) ProtoMessage() {}
 
-func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+func (x *DNSDomainCount) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1186,42 +1379,44 @@ func (x *ParseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
-func (*ParseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSDomainCount.ProtoReflect.Descriptor instead.
+func (*DNSDomainCount) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ParseResponse) GetResponseCode() ResponseCode {
+func (x *DNSDomainCount) GetDomain() string {
 	if x != nil {
-		return x.ResponseCode
+		return x.Domain
 	}
-	return ResponseCode_OK
+	return ""
 }
 
-func (x *ParseResponse) GetContent() string {
+func (x *DNSDomainCount) GetQueries() int32 {
 	if x != nil {
-		return x.Content
+		return x.Queries
 	}
-	return ""
+	return 0
 }
 
-func (x *ParseResponse) GetMessage() string {
+func (x *DNSDomainCount) GetFailures() int32 {
 	if x != nil {
-		return x.Message
+		return x.Failures
 	}
-	return ""
+	return 0
 }
 
-type ChangeRostovVPNSettingsRequest struct {
+type DNSUpstreamCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RostovvpnSettingsJson string `protobuf:"bytes,1,opt,name=rostovvpn_settings_json,json=rostovvpnSettingsJson,proto3" json:"rostovvpn_settings_json,omitempty"`
+	Upstream string `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	Queries  int32  `protobuf:"varint,2,opt,name=queries,proto3" json:"queries,omitempty"`
+	Failures int32  `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (x *ChangeRostovVPNSettingsRequest) Reset() {
-	*x = ChangeRostovVPNSettingsRequest{}
+func (x *DNSUpstreamCount) Reset() {
+	*x = DNSUpstreamCount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1229,13 +1424,13 @@ func (x *ChangeRostovVPNSettingsRequest) Reset() {
 	}
 }
 
-func (x *ChangeRostovVPNSettingsRequest) String() string {
+func (x *DNSUpstreamCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeRostovVPNSettingsRequest) ProtoMessage() {}
+func (*DNSUpstreamCount) ProtoMessage() {}
 
-func (x *ChangeRostovVPNSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *DNSUpstreamCount) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1247,30 +1442,48 @@ func (x *ChangeRostovVPNSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeRostovVPNSettingsRequest.ProtoReflect.Descriptor instead.
-func (*ChangeRostovVPNSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSUpstreamCount.ProtoReflect.Descriptor instead.
+func (*DNSUpstreamCount) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ChangeRostovVPNSettingsRequest) GetRostovvpnSettingsJson() string {
+func (x *DNSUpstreamCount) GetUpstream() string {
 	if x != nil {
-		return x.RostovvpnSettingsJson
+		return x.Upstream
 	}
 	return ""
 }
 
-type GenerateConfigRequest struct {
+func (x *DNSUpstreamCount) GetQueries() int32 {
+	if x != nil {
+		return x.Queries
+	}
+	return 0
+}
+
+func (x *DNSUpstreamCount) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+type DNSQueryStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	TempPath string `protobuf:"bytes,2,opt,name=temp_path,json=tempPath,proto3" json:"temp_path,omitempty"`
-	Debug    bool   `protobuf:"varint,3,opt,name=debug,proto3" json:"debug,omitempty"`
+	ResponseCode  ResponseCode        `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Total         int32               `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // запросов в истории
+	Failures      int32               `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	CacheHitRatio float32             `protobuf:"fixed32,4,opt,name=cache_hit_ratio,json=cacheHitRatio,proto3" json:"cache_hit_ratio,omitempty"`
+	TopDomains    []*DNSDomainCount   `protobuf:"bytes,5,rep,name=top_domains,json=topDomains,proto3" json:"top_domains,omitempty"`
+	Upstreams     []*DNSUpstreamCount `protobuf:"bytes,6,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	Message       string              `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GenerateConfigRequest) Reset() {
-	*x = GenerateConfigRequest{}
+func (x *DNSQueryStats) Reset() {
+	*x = DNSQueryStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1278,13 +1491,13 @@ func (x *GenerateConfigRequest) Reset() {
 	}
 }
 
-func (x *GenerateConfigRequest) String() string {
+func (x *DNSQueryStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateConfigRequest) ProtoMessage() {}
+func (*DNSQueryStats) ProtoMessage() {}
 
-func (x *GenerateConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *DNSQueryStats) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1296,42 +1509,79 @@ func (x *GenerateConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateConfigRequest.ProtoReflect.Descriptor instead.
-func (*GenerateConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSQueryStats.ProtoReflect.Descriptor instead.
+func (*DNSQueryStats) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GenerateConfigRequest) GetPath() string {
+func (x *DNSQueryStats) GetResponseCode() ResponseCode {
 	if x != nil {
-		return x.Path
+		return x.ResponseCode
 	}
-	return ""
+	return ResponseCode_OK
 }
 
-func (x *GenerateConfigRequest) GetTempPath() string {
+func (x *DNSQueryStats) GetTotal() int32 {
 	if x != nil {
-		return x.TempPath
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateConfigRequest) GetDebug() bool {
+func (x *DNSQueryStats) GetFailures() int32 {
 	if x != nil {
-		return x.Debug
+		return x.Failures
 	}
-	return false
+	return 0
 }
 
-type GenerateConfigResponse struct {
+func (x *DNSQueryStats) GetCacheHitRatio() float32 {
+	if x != nil {
+		return x.CacheHitRatio
+	}
+	return 0
+}
+
+func (x *DNSQueryStats) GetTopDomains() []*DNSDomainCount {
+	if x != nil {
+		return x.TopDomains
+	}
+	return nil
+}
+
+func (x *DNSQueryStats) GetUpstreams() []*DNSUpstreamCount {
+	if x != nil {
+		return x.Upstreams
+	}
+	return nil
+}
+
+func (x *DNSQueryStats) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BlocklistStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConfigContent string `protobuf:"bytes,1,opt,name=config_content,json=configContent,proto3" json:"config_content,omitempty"`
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source         string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Enabled        bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Entries        int32  `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`                      // доменов в скомпилированном списке
+	Skipped        int32  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`                      // строк, которые не удалось разобрать
+	UpdatedAt      int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // unix-время последнего обновления, 0 — ещё не скачан
+	LastError      string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DnsHits        uint64 `protobuf:"varint,8,opt,name=dns_hits,json=dnsHits,proto3" json:"dns_hits,omitempty"`                      // заблокированных DNS-запросов
+	ConnectionHits uint64 `protobuf:"varint,9,opt,name=connection_hits,json=connectionHits,proto3" json:"connection_hits,omitempty"` // заблокированных соединений
+	LastHit        int64  `protobuf:"varint,10,opt,name=last_hit,json=lastHit,proto3" json:"last_hit,omitempty"`
 }
 
-func (x *GenerateConfigResponse) Reset() {
-	*x = GenerateConfigResponse{}
+func (x *BlocklistStats) Reset() {
+	*x = BlocklistStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1339,13 +1589,13 @@ func (x *GenerateConfigResponse) Reset() {
 	}
 }
 
-func (x *GenerateConfigResponse) String() string {
+func (x *BlocklistStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateConfigResponse) ProtoMessage() {}
+func (*BlocklistStats) ProtoMessage() {}
 
-func (x *GenerateConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *BlocklistStats) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1357,29 +1607,93 @@ func (x *GenerateConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateConfigResponse.ProtoReflect.Descriptor instead.
-func (*GenerateConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlocklistStats.ProtoReflect.Descriptor instead.
+func (*BlocklistStats) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GenerateConfigResponse) GetConfigContent() string {
+func (x *BlocklistStats) GetName() string {
 	if x != nil {
-		return x.ConfigContent
+		return x.Name
 	}
 	return ""
 }
 
-type SelectOutboundRequest struct {
+func (x *BlocklistStats) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BlocklistStats) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *BlocklistStats) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *BlocklistStats) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BlocklistStats) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *BlocklistStats) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BlocklistStats) GetDnsHits() uint64 {
+	if x != nil {
+		return x.DnsHits
+	}
+	return 0
+}
+
+func (x *BlocklistStats) GetConnectionHits() uint64 {
+	if x != nil {
+		return x.ConnectionHits
+	}
+	return 0
+}
+
+func (x *BlocklistStats) GetLastHit() int64 {
+	if x != nil {
+		return x.LastHit
+	}
+	return 0
+}
+
+type BlocklistStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupTag    string `protobuf:"bytes,1,opt,name=group_tag,json=groupTag,proto3" json:"group_tag,omitempty"`
-	OutboundTag string `protobuf:"bytes,2,opt,name=outbound_tag,json=outboundTag,proto3" json:"outbound_tag,omitempty"`
+	ResponseCode ResponseCode      `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Lists        []*BlocklistStats `protobuf:"bytes,2,rep,name=lists,proto3" json:"lists,omitempty"`
+	Message      string            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SelectOutboundRequest) Reset() {
-	*x = SelectOutboundRequest{}
+func (x *BlocklistStatsResponse) Reset() {
+	*x = BlocklistStatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rostovvpn_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1387,13 +1701,13 @@ func (x *SelectOutboundRequest) Reset() {
 	}
 }
 
-func (x *SelectOutboundRequest) String() string {
+func (x *BlocklistStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelectOutboundRequest) ProtoMessage() {}
+func (*BlocklistStatsResponse) ProtoMessage() {}
 
-func (x *SelectOutboundRequest) ProtoReflect() protoreflect.Message {
+func (x *BlocklistStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rostovvpn_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1405,50 +1719,1987 @@ func (x *SelectOutboundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelectOutboundRequest.ProtoReflect.Descriptor instead.
-func (*SelectOutboundRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlocklistStatsResponse.ProtoReflect.Descriptor instead.
+func (*BlocklistStatsResponse) Descriptor() ([]byte, []int) {
 	return file_rostovvpn_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SelectOutboundRequest) GetGroupTag() string {
+func (x *BlocklistStatsResponse) GetResponseCode() ResponseCode {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ResponseCode_OK
+}
+
+func (x *BlocklistStatsResponse) GetLists() []*BlocklistStats {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *BlocklistStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OutboundGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag      string               `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type     string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Selected string               `protobuf:"bytes,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	Items    []*OutboundGroupItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *OutboundGroup) Reset() {
+	*x = OutboundGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundGroup) ProtoMessage() {}
+
+func (x *OutboundGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundGroup.ProtoReflect.Descriptor instead.
+func (*OutboundGroup) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OutboundGroup) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *OutboundGroup) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OutboundGroup) GetSelected() string {
+	if x != nil {
+		return x.Selected
+	}
+	return ""
+}
+
+func (x *OutboundGroup) GetItems() []*OutboundGroupItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type OutboundGroupList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*OutboundGroup `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *OutboundGroupList) Reset() {
+	*x = OutboundGroupList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundGroupList) ProtoMessage() {}
+
+func (x *OutboundGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundGroupList.ProtoReflect.Descriptor instead.
+func (*OutboundGroupList) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OutboundGroupList) GetItems() []*OutboundGroup {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type WarpAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId   string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *WarpAccount) Reset() {
+	*x = WarpAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpAccount) ProtoMessage() {}
+
+func (x *WarpAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpAccount.ProtoReflect.Descriptor instead.
+func (*WarpAccount) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WarpAccount) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *WarpAccount) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type WarpWireguardConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey       string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	LocalAddressIpv4 string `protobuf:"bytes,2,opt,name=local_address_ipv4,json=localAddressIpv4,proto3" json:"local_address_ipv4,omitempty"`
+	LocalAddressIpv6 string `protobuf:"bytes,3,opt,name=local_address_ipv6,json=localAddressIpv6,proto3" json:"local_address_ipv6,omitempty"`
+	PeerPublicKey    string `protobuf:"bytes,4,opt,name=peer_public_key,json=peerPublicKey,proto3" json:"peer_public_key,omitempty"`
+	ClientId         string `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *WarpWireguardConfig) Reset() {
+	*x = WarpWireguardConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpWireguardConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpWireguardConfig) ProtoMessage() {}
+
+func (x *WarpWireguardConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpWireguardConfig.ProtoReflect.Descriptor instead.
+func (*WarpWireguardConfig) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WarpWireguardConfig) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *WarpWireguardConfig) GetLocalAddressIpv4() string {
+	if x != nil {
+		return x.LocalAddressIpv4
+	}
+	return ""
+}
+
+func (x *WarpWireguardConfig) GetLocalAddressIpv6() string {
+	if x != nil {
+		return x.LocalAddressIpv6
+	}
+	return ""
+}
+
+func (x *WarpWireguardConfig) GetPeerPublicKey() string {
+	if x != nil {
+		return x.PeerPublicKey
+	}
+	return ""
+}
+
+func (x *WarpWireguardConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type WarpGenerationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *WarpAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Log     string               `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	Config  *WarpWireguardConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *WarpGenerationResponse) Reset() {
+	*x = WarpGenerationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpGenerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpGenerationResponse) ProtoMessage() {}
+
+func (x *WarpGenerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpGenerationResponse.ProtoReflect.Descriptor instead.
+func (*WarpGenerationResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WarpGenerationResponse) GetAccount() *WarpAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *WarpGenerationResponse) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *WarpGenerationResponse) GetConfig() *WarpWireguardConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SystemProxyStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Enabled   bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SystemProxyStatus) Reset() {
+	*x = SystemProxyStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemProxyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemProxyStatus) ProtoMessage() {}
+
+func (x *SystemProxyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemProxyStatus.ProtoReflect.Descriptor instead.
+func (*SystemProxyStatus) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SystemProxyStatus) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SystemProxyStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ParseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content           string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ConfigPath        string `protobuf:"bytes,2,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
+	TempPath          string `protobuf:"bytes,3,opt,name=temp_path,json=tempPath,proto3" json:"temp_path,omitempty"`
+	Debug             bool   `protobuf:"varint,4,opt,name=debug,proto3" json:"debug,omitempty"`
+	ProfileFilterJson string `protobuf:"bytes,5,opt,name=profile_filter_json,json=profileFilterJson,proto3" json:"profile_filter_json,omitempty"` // ProfileFilterOptions профиля, поверх общих настроек
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ParseRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetTempPath() string {
+	if x != nil {
+		return x.TempPath
+	}
+	return ""
+}
+
+func (x *ParseRequest) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
+}
+
+func (x *ParseRequest) GetProfileFilterJson() string {
+	if x != nil {
+		return x.ProfileFilterJson
+	}
+	return ""
+}
+
+type ParseEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line    int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // строка в исходном профиле, 0 — неизвестна
+	Tag     string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Reason  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ParseEntry) Reset() {
+	*x = ParseEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseEntry) ProtoMessage() {}
+
+func (x *ParseEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseEntry.ProtoReflect.Descriptor instead.
+func (*ParseEntry) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ParseEntry) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ParseEntry) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ParseEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ParseEntry) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ParseEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ParseReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format   string        `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Accepted []*ParseEntry `protobuf:"bytes,2,rep,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected []*ParseEntry `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	Filtered []*ParseEntry `protobuf:"bytes,4,rep,name=filtered,proto3" json:"filtered,omitempty"`
+	Rules    *RuleImport   `protobuf:"bytes,5,opt,name=rules,proto3" json:"rules,omitempty"`
+	Warnings []*ParseEntry `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *ParseReport) Reset() {
+	*x = ParseReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseReport) ProtoMessage() {}
+
+func (x *ParseReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseReport.ProtoReflect.Descriptor instead.
+func (*ParseReport) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ParseReport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ParseReport) GetAccepted() []*ParseEntry {
+	if x != nil {
+		return x.Accepted
+	}
+	return nil
+}
+
+func (x *ParseReport) GetRejected() []*ParseEntry {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *ParseReport) GetFiltered() []*ParseEntry {
+	if x != nil {
+		return x.Filtered
+	}
+	return nil
+}
+
+func (x *ParseReport) GetRules() *RuleImport {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ParseReport) GetWarnings() []*ParseEntry {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// правила и группы из Clash/v2ray; settings — JSON с rules, outbound-groups и chains
+type RuleImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format       string        `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Settings     string        `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Unmapped     []*ParseEntry `protobuf:"bytes,3,rep,name=unmapped,proto3" json:"unmapped,omitempty"`
+	Approximated []*ParseEntry `protobuf:"bytes,4,rep,name=approximated,proto3" json:"approximated,omitempty"`
+}
+
+func (x *RuleImport) Reset() {
+	*x = RuleImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleImport) ProtoMessage() {}
+
+func (x *RuleImport) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleImport.ProtoReflect.Descriptor instead.
+func (*RuleImport) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RuleImport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *RuleImport) GetSettings() string {
+	if x != nil {
+		return x.Settings
+	}
+	return ""
+}
+
+func (x *RuleImport) GetUnmapped() []*ParseEntry {
+	if x != nil {
+		return x.Unmapped
+	}
+	return nil
+}
+
+func (x *RuleImport) GetApproximated() []*ParseEntry {
+	if x != nil {
+		return x.Approximated
+	}
+	return nil
+}
+
+type ImportRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ImportRulesRequest) Reset() {
+	*x = ImportRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRulesRequest) ProtoMessage() {}
+
+func (x *ImportRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRulesRequest.ProtoReflect.Descriptor instead.
+func (*ImportRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportRulesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseCode ResponseCode `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Message      string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Result       *RuleImport  `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ImportRulesResponse) Reset() {
+	*x = ImportRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRulesResponse) ProtoMessage() {}
+
+func (x *ImportRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRulesResponse.ProtoReflect.Descriptor instead.
+func (*ImportRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportRulesResponse) GetResponseCode() ResponseCode {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ResponseCode_OK
+}
+
+func (x *ImportRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportRulesResponse) GetResult() *RuleImport {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ExplainRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host    string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port    uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Network string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Process string `protobuf:"bytes,4,opt,name=process,proto3" json:"process,omitempty"`
+	Inbound string `protobuf:"bytes,5,opt,name=inbound,proto3" json:"inbound,omitempty"`
+}
+
+func (x *ExplainRouteRequest) Reset() {
+	*x = ExplainRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainRouteRequest) ProtoMessage() {}
+
+func (x *ExplainRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainRouteRequest.ProtoReflect.Descriptor instead.
+func (*ExplainRouteRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExplainRouteRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ExplainRouteRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ExplainRouteRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ExplainRouteRequest) GetProcess() string {
+	if x != nil {
+		return x.Process
+	}
+	return ""
+}
+
+func (x *ExplainRouteRequest) GetInbound() string {
+	if x != nil {
+		return x.Inbound
+	}
+	return ""
+}
+
+type RouteRuleMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Rule   string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Option string `protobuf:"bytes,5,opt,name=option,proto3" json:"option,omitempty"`
+}
+
+func (x *RouteRuleMatch) Reset() {
+	*x = RouteRuleMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteRuleMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteRuleMatch) ProtoMessage() {}
+
+func (x *RouteRuleMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteRuleMatch.ProtoReflect.Descriptor instead.
+func (*RouteRuleMatch) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RouteRuleMatch) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RouteRuleMatch) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RouteRuleMatch) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RouteRuleMatch) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RouteRuleMatch) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+type ExplainRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseCode ResponseCode    `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Message      string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule         *RouteRuleMatch `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Outbound     string          `protobuf:"bytes,4,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Path         []string        `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"`
+	DnsRule      *RouteRuleMatch `protobuf:"bytes,6,opt,name=dns_rule,json=dnsRule,proto3" json:"dns_rule,omitempty"`
+	DnsServer    string          `protobuf:"bytes,7,opt,name=dns_server,json=dnsServer,proto3" json:"dns_server,omitempty"`
+	Notes        []string        `protobuf:"bytes,8,rep,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *ExplainRouteResponse) Reset() {
+	*x = ExplainRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainRouteResponse) ProtoMessage() {}
+
+func (x *ExplainRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainRouteResponse.ProtoReflect.Descriptor instead.
+func (*ExplainRouteResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExplainRouteResponse) GetResponseCode() ResponseCode {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ResponseCode_OK
+}
+
+func (x *ExplainRouteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExplainRouteResponse) GetRule() *RouteRuleMatch {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *ExplainRouteResponse) GetOutbound() string {
+	if x != nil {
+		return x.Outbound
+	}
+	return ""
+}
+
+func (x *ExplainRouteResponse) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *ExplainRouteResponse) GetDnsRule() *RouteRuleMatch {
+	if x != nil {
+		return x.DnsRule
+	}
+	return nil
+}
+
+func (x *ExplainRouteResponse) GetDnsServer() string {
+	if x != nil {
+		return x.DnsServer
+	}
+	return ""
+}
+
+func (x *ExplainRouteResponse) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type ParseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseCode ResponseCode `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Content      string       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Message      string       `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Report       *ParseReport `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ParseResponse) GetResponseCode() ResponseCode {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ResponseCode_OK
+}
+
+func (x *ParseResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetReport() *ParseReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ExportOutboundsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content    string       `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`                         // профиль в любом поддерживаемом формате
+	ConfigPath string       `protobuf:"bytes,2,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"` // или путь к нему; пусто — текущий собранный конфиг
+	Tags       []string     `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`                               // пусто — все outbound'ы
+	Format     ExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=rostovvpnrpc.ExportFormat" json:"format,omitempty"`
+}
+
+func (x *ExportOutboundsRequest) Reset() {
+	*x = ExportOutboundsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOutboundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOutboundsRequest) ProtoMessage() {}
+
+func (x *ExportOutboundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOutboundsRequest.ProtoReflect.Descriptor instead.
+func (*ExportOutboundsRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportOutboundsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExportOutboundsRequest) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *ExportOutboundsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExportOutboundsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_LINKS
+}
+
+type ExportOutboundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseCode ResponseCode `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3,enum=rostovvpnrpc.ResponseCode" json:"response_code,omitempty"`
+	Content      string       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Links        []string     `protobuf:"bytes,3,rep,name=links,proto3" json:"links,omitempty"`
+	Skipped      []string     `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	Message      string       `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ExportOutboundsResponse) Reset() {
+	*x = ExportOutboundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOutboundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOutboundsResponse) ProtoMessage() {}
+
+func (x *ExportOutboundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOutboundsResponse.ProtoReflect.Descriptor instead.
+func (*ExportOutboundsResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportOutboundsResponse) GetResponseCode() ResponseCode {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ResponseCode_OK
+}
+
+func (x *ExportOutboundsResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ExportOutboundsResponse) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ExportOutboundsResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ExportOutboundsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChangeRostovVPNSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RostovvpnSettingsJson string `protobuf:"bytes,1,opt,name=rostovvpn_settings_json,json=rostovvpnSettingsJson,proto3" json:"rostovvpn_settings_json,omitempty"`
+}
+
+func (x *ChangeRostovVPNSettingsRequest) Reset() {
+	*x = ChangeRostovVPNSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeRostovVPNSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRostovVPNSettingsRequest) ProtoMessage() {}
+
+func (x *ChangeRostovVPNSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRostovVPNSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRostovVPNSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChangeRostovVPNSettingsRequest) GetRostovvpnSettingsJson() string {
+	if x != nil {
+		return x.RostovvpnSettingsJson
+	}
+	return ""
+}
+
+type GenerateConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	TempPath string `protobuf:"bytes,2,opt,name=temp_path,json=tempPath,proto3" json:"temp_path,omitempty"`
+	Debug    bool   `protobuf:"varint,3,opt,name=debug,proto3" json:"debug,omitempty"`
+}
+
+func (x *GenerateConfigRequest) Reset() {
+	*x = GenerateConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConfigRequest) ProtoMessage() {}
+
+func (x *GenerateConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConfigRequest.ProtoReflect.Descriptor instead.
+func (*GenerateConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GenerateConfigRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GenerateConfigRequest) GetTempPath() string {
+	if x != nil {
+		return x.TempPath
+	}
+	return ""
+}
+
+func (x *GenerateConfigRequest) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
+}
+
+type GenerateConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigContent string `protobuf:"bytes,1,opt,name=config_content,json=configContent,proto3" json:"config_content,omitempty"`
+}
+
+func (x *GenerateConfigResponse) Reset() {
+	*x = GenerateConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConfigResponse) ProtoMessage() {}
+
+func (x *GenerateConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConfigResponse.ProtoReflect.Descriptor instead.
+func (*GenerateConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GenerateConfigResponse) GetConfigContent() string {
+	if x != nil {
+		return x.ConfigContent
+	}
+	return ""
+}
+
+type SelectOutboundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupTag    string `protobuf:"bytes,1,opt,name=group_tag,json=groupTag,proto3" json:"group_tag,omitempty"`
+	OutboundTag string `protobuf:"bytes,2,opt,name=outbound_tag,json=outboundTag,proto3" json:"outbound_tag,omitempty"`
+}
+
+func (x *SelectOutboundRequest) Reset() {
+	*x = SelectOutboundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectOutboundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectOutboundRequest) ProtoMessage() {}
+
+func (x *SelectOutboundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectOutboundRequest.ProtoReflect.Descriptor instead.
+func (*SelectOutboundRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SelectOutboundRequest) GetGroupTag() string {
+	if x != nil {
+		return x.GroupTag
+	}
+	return ""
+}
+
+func (x *SelectOutboundRequest) GetOutboundTag() string {
+	if x != nil {
+		return x.OutboundTag
+	}
+	return ""
+}
+
+type UrlTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupTag string `protobuf:"bytes,1,opt,name=group_tag,json=groupTag,proto3" json:"group_tag,omitempty"`
+}
+
+func (x *UrlTestRequest) Reset() {
+	*x = UrlTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UrlTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrlTestRequest) ProtoMessage() {}
+
+func (x *UrlTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrlTestRequest.ProtoReflect.Descriptor instead.
+func (*UrlTestRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UrlTestRequest) GetGroupTag() string {
+	if x != nil {
+		return x.GroupTag
+	}
+	return ""
+}
+
+type GenerateWarpConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LicenseKey  string `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	AccountId   string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *GenerateWarpConfigRequest) Reset() {
+	*x = GenerateWarpConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateWarpConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWarpConfigRequest) ProtoMessage() {}
+
+func (x *GenerateWarpConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWarpConfigRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWarpConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GenerateWarpConfigRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *GenerateWarpConfigRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GenerateWarpConfigRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type WarpAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseKey string `protobuf:"bytes,2,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+}
+
+func (x *WarpAccountRequest) Reset() {
+	*x = WarpAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpAccountRequest) ProtoMessage() {}
+
+func (x *WarpAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpAccountRequest.ProtoReflect.Descriptor instead.
+func (*WarpAccountRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WarpAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarpAccountRequest) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+type WarpAccountInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Account     *WarpAccount         `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Config      *WarpWireguardConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	LicenseKey  string               `protobuf:"bytes,4,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
+	AccountType string               `protobuf:"bytes,5,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	WarpPlus    bool                 `protobuf:"varint,6,opt,name=warp_plus,json=warpPlus,proto3" json:"warp_plus,omitempty"`
+	Quota       int64                `protobuf:"varint,7,opt,name=quota,proto3" json:"quota,omitempty"`
+	Usage       int64                `protobuf:"varint,8,opt,name=usage,proto3" json:"usage,omitempty"`
+	PremiumData int64                `protobuf:"varint,9,opt,name=premium_data,json=premiumData,proto3" json:"premium_data,omitempty"`
+	Updated     int64                `protobuf:"varint,10,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *WarpAccountInfo) Reset() {
+	*x = WarpAccountInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpAccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpAccountInfo) ProtoMessage() {}
+
+func (x *WarpAccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpAccountInfo.ProtoReflect.Descriptor instead.
+func (*WarpAccountInfo) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WarpAccountInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarpAccountInfo) GetAccount() *WarpAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *WarpAccountInfo) GetConfig() *WarpWireguardConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *WarpAccountInfo) GetLicenseKey() string {
+	if x != nil {
+		return x.LicenseKey
+	}
+	return ""
+}
+
+func (x *WarpAccountInfo) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *WarpAccountInfo) GetWarpPlus() bool {
+	if x != nil {
+		return x.WarpPlus
+	}
+	return false
+}
+
+func (x *WarpAccountInfo) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *WarpAccountInfo) GetUsage() int64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *WarpAccountInfo) GetPremiumData() int64 {
+	if x != nil {
+		return x.PremiumData
+	}
+	return 0
+}
+
+func (x *WarpAccountInfo) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type WarpAccountList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WarpAccountInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *WarpAccountList) Reset() {
+	*x = WarpAccountList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarpAccountList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarpAccountList) ProtoMessage() {}
+
+func (x *WarpAccountList) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarpAccountList.ProtoReflect.Descriptor instead.
+func (*WarpAccountList) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WarpAccountList) GetItems() []*WarpAccountInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SetSystemProxyEnabledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsEnabled bool `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+}
+
+func (x *SetSystemProxyEnabledRequest) Reset() {
+	*x = SetSystemProxyEnabledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSystemProxyEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSystemProxyEnabledRequest) ProtoMessage() {}
+
+func (x *SetSystemProxyEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSystemProxyEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetSystemProxyEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetSystemProxyEnabledRequest) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+type LogMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level   LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=rostovvpnrpc.LogLevel" json:"level,omitempty"`
+	Type    LogType  `protobuf:"varint,2,opt,name=type,proto3,enum=rostovvpnrpc.LogType" json:"type,omitempty"`
+	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LogMessage) Reset() {
+	*x = LogMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rostovvpn_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMessage) ProtoMessage() {}
+
+func (x *LogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
+func (*LogMessage) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LogMessage) GetLevel() LogLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LogLevel_DEBUG
+}
+
+func (x *LogMessage) GetType() LogType {
 	if x != nil {
-		return x.GroupTag
+		return x.Type
 	}
-	return ""
+	return LogType_CORE
 }
 
-func (x *SelectOutboundRequest) GetOutboundTag() string {
+func (x *LogMessage) GetMessage() string {
 	if x != nil {
-		return x.OutboundTag
+		return x.Message
 	}
 	return ""
 }
 
-type UrlTestRequest struct {
+type StopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupTag string `protobuf:"bytes,1,opt,name=group_tag,json=groupTag,proto3" json:"group_tag,omitempty"`
 }
 
-func (x *UrlTestRequest) Reset() {
-	*x = UrlTestRequest{}
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[18]
+		mi := &file_rostovvpn_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UrlTestRequest) String() string {
+func (x *StopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UrlTestRequest) ProtoMessage() {}
+func (*StopRequest) ProtoMessage() {}
 
-func (x *UrlTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[18]
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,45 +3710,36 @@ func (x *UrlTestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UrlTestRequest.ProtoReflect.Descriptor instead.
-func (*UrlTestRequest) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *UrlTestRequest) GetGroupTag() string {
-	if x != nil {
-		return x.GroupTag
-	}
-	return ""
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{47}
 }
 
-type GenerateWarpConfigRequest struct {
+type ClashModeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LicenseKey  string `protobuf:"bytes,1,opt,name=license_key,json=licenseKey,proto3" json:"license_key,omitempty"`
-	AccountId   string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccessToken string `protobuf:"bytes,3,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (x *GenerateWarpConfigRequest) Reset() {
-	*x = GenerateWarpConfigRequest{}
+func (x *ClashModeRequest) Reset() {
+	*x = ClashModeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[19]
+		mi := &file_rostovvpn_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateWarpConfigRequest) String() string {
+func (x *ClashModeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateWarpConfigRequest) ProtoMessage() {}
+func (*ClashModeRequest) ProtoMessage() {}
 
-func (x *GenerateWarpConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[19]
+func (x *ClashModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,57 +3750,44 @@ func (x *GenerateWarpConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateWarpConfigRequest.ProtoReflect.Descriptor instead.
-func (*GenerateWarpConfigRequest) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GenerateWarpConfigRequest) GetLicenseKey() string {
-	if x != nil {
-		return x.LicenseKey
-	}
-	return ""
-}
-
-func (x *GenerateWarpConfigRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
+// Deprecated: Use ClashModeRequest.ProtoReflect.Descriptor instead.
+func (*ClashModeRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GenerateWarpConfigRequest) GetAccessToken() string {
+func (x *ClashModeRequest) GetMode() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Mode
 	}
 	return ""
 }
 
-type SetSystemProxyEnabledRequest struct {
+type ClashModeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsEnabled bool `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Modes   []string `protobuf:"bytes,1,rep,name=modes,proto3" json:"modes,omitempty"`
+	Current string   `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
 }
 
-func (x *SetSystemProxyEnabledRequest) Reset() {
-	*x = SetSystemProxyEnabledRequest{}
+func (x *ClashModeResponse) Reset() {
+	*x = ClashModeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[20]
+		mi := &file_rostovvpn_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetSystemProxyEnabledRequest) String() string {
+func (x *ClashModeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSystemProxyEnabledRequest) ProtoMessage() {}
+func (*ClashModeResponse) ProtoMessage() {}
 
-func (x *SetSystemProxyEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[20]
+func (x *ClashModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,45 +3798,52 @@ func (x *SetSystemProxyEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSystemProxyEnabledRequest.ProtoReflect.Descriptor instead.
-func (*SetSystemProxyEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ClashModeResponse.ProtoReflect.Descriptor instead.
+func (*ClashModeResponse) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SetSystemProxyEnabledRequest) GetIsEnabled() bool {
+func (x *ClashModeResponse) GetModes() []string {
 	if x != nil {
-		return x.IsEnabled
+		return x.Modes
 	}
-	return false
+	return nil
 }
 
-type LogMessage struct {
+func (x *ClashModeResponse) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+type ProfilingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Level   LogLevel `protobuf:"varint,1,opt,name=level,proto3,enum=rostovvpnrpc.LogLevel" json:"level,omitempty"`
-	Type    LogType  `protobuf:"varint,2,opt,name=type,proto3,enum=rostovvpnrpc.LogType" json:"type,omitempty"`
-	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	DurationSeconds uint32   `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Profiles        []string `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"` // cpu, heap, goroutine, block
+	Stream          bool     `protobuf:"varint,3,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (x *LogMessage) Reset() {
-	*x = LogMessage{}
+func (x *ProfilingRequest) Reset() {
+	*x = ProfilingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[21]
+		mi := &file_rostovvpn_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogMessage) String() string {
+func (x *ProfilingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMessage) ProtoMessage() {}
+func (*ProfilingRequest) ProtoMessage() {}
 
-func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[21]
+func (x *ProfilingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,55 +3854,59 @@ func (x *LogMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
-func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ProfilingRequest.ProtoReflect.Descriptor instead.
+func (*ProfilingRequest) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *LogMessage) GetLevel() LogLevel {
+func (x *ProfilingRequest) GetDurationSeconds() uint32 {
 	if x != nil {
-		return x.Level
+		return x.DurationSeconds
 	}
-	return LogLevel_DEBUG
+	return 0
 }
 
-func (x *LogMessage) GetType() LogType {
+func (x *ProfilingRequest) GetProfiles() []string {
 	if x != nil {
-		return x.Type
+		return x.Profiles
 	}
-	return LogType_CORE
+	return nil
 }
 
-func (x *LogMessage) GetMessage() string {
+func (x *ProfilingRequest) GetStream() bool {
 	if x != nil {
-		return x.Message
+		return x.Stream
 	}
-	return ""
+	return false
 }
 
-type StopRequest struct {
+type ProfileData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
+func (x *ProfileData) Reset() {
+	*x = ProfileData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[22]
+		mi := &file_rostovvpn_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopRequest) String() string {
+func (x *ProfileData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*ProfileData) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[22]
+func (x *ProfileData) ProtoReflect() protoreflect.Message {
+	mi := &file_rostovvpn_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,9 +3917,30 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ProfileData.ProtoReflect.Descriptor instead.
+func (*ProfileData) Descriptor() ([]byte, []int) {
+	return file_rostovvpn_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ProfileData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfileData) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProfileData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
 type TunnelStartRequest struct {
@@ -1697,7 +3958,7 @@ type TunnelStartRequest struct {
 func (x *TunnelStartRequest) Reset() {
 	*x = TunnelStartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[23]
+		mi := &file_rostovvpn_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1710,7 +3971,7 @@ func (x *TunnelStartRequest) String() string {
 func (*TunnelStartRequest) ProtoMessage() {}
 
 func (x *TunnelStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[23]
+	mi := &file_rostovvpn_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1723,7 +3984,7 @@ func (x *TunnelStartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TunnelStartRequest.ProtoReflect.Descriptor instead.
 func (*TunnelStartRequest) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{23}
+	return file_rostovvpn_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *TunnelStartRequest) GetIpv6() bool {
@@ -1772,7 +4033,7 @@ type TunnelResponse struct {
 func (x *TunnelResponse) Reset() {
 	*x = TunnelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rostovvpn_proto_msgTypes[24]
+		mi := &file_rostovvpn_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1785,7 +4046,7 @@ func (x *TunnelResponse) String() string {
 func (*TunnelResponse) ProtoMessage() {}
 
 func (x *TunnelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rostovvpn_proto_msgTypes[24]
+	mi := &file_rostovvpn_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1798,7 +4059,7 @@ func (x *TunnelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TunnelResponse.ProtoReflect.Descriptor instead.
 func (*TunnelResponse) Descriptor() ([]byte, []int) {
-	return file_rostovvpn_proto_rawDescGZIP(), []int{24}
+	return file_rostovvpn_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *TunnelResponse) GetMessage() string {
@@ -1824,7 +4085,7 @@ var file_rostovvpn_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x90, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61,
 	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6e,
@@ -1841,312 +4102,727 @@ var file_rostovvpn_proto_rawDesc = []byte{
 	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c,
 	0x65, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x77, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x74, 0x68,
-	0x22, 0x65, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbf, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2b,
-	0x0a, 0x11, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c,
-	0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
-	0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x65, 0x73,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x72,
-	0x6c, 0x54, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x72, 0x6c,
-	0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x75, 0x72, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22,
-	0x88, 0x01, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
-	0x63, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x46, 0x0a, 0x11, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x0b, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x13, 0x57, 0x61, 0x72, 0x70, 0x57, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x70,
-	0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x70, 0x76, 0x34, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x49, 0x70, 0x76, 0x36, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x65, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9a, 0x01,
-	0x0a, 0x16, 0x57, 0x61, 0x72, 0x70, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74,
-	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12,
-	0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57,
-	0x61, 0x72, 0x70, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4b, 0x0a, 0x11, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x7c, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61,
+	0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x22, 0x6b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61,
 	0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x84, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
-	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x1e,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x56, 0x50, 0x4e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
-	0x0a, 0x17, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x15, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x3f, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x15, 0x53, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x12, 0x21, 0x0a,
-	0x0c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x74, 0x68, 0x22,
+	0x65, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbf, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x49, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc9, 0x01, 0x0a, 0x11, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x72, 0x6c,
+	0x54, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x72, 0x6c, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x75, 0x72, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x65, 0x78, 0x69, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x08, 0x45, 0x78, 0x69, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x74, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x10, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x61, 0x67,
-	0x22, 0x2d, 0x0a, 0x0e, 0x55, 0x72, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x22,
-	0x7e, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
-	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x3d, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78,
-	0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x7f,
-	0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x6f,
-	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
-	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x0d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbc,
-	0x01, 0x0a, 0x12, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x38, 0x0a,
-	0x18, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x16, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x22, 0x2a, 0x0a,
-	0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x41, 0x0a, 0x09, 0x43, 0x6f, 0x72,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0xcd, 0x02, 0x0a,
-	0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05,
-	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4d, 0x50, 0x54, 0x59,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01,
-	0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e,
-	0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x12, 0x11,
-	0x0a, 0x0d, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10,
-	0x04, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41,
-	0x44, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f,
-	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10,
-	0x07, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x53,
-	0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45,
-	0x44, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x19, 0x0a,
-	0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x49, 0x4e, 0x47, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x0b, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44,
-	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x0d, 0x2a, 0x42, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55,
-	0x47, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0b, 0x0a,
-	0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x04,
-	0x2a, 0x2c, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x43,
-	0x4f, 0x52, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
-	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x02, 0x32, 0x9b,
-	0x01, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x61, 0x79, 0x48,
-	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
-	0x72, 0x70, 0x63, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
-	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
-	0x0e, 0x53, 0x61, 0x79, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x48,
-	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x6f,
-	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x32, 0x86, 0x0a, 0x0a,
-	0x04, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a,
-	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73,
-	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x43, 0x6f,
-	0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x13,
-	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
-	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72, 0x6f,
+	0x22, 0xa0, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x10, 0x44, 0x4e, 0x53, 0x55, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x71, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x22, 0x73, 0x0a, 0x0d, 0x44, 0x4e, 0x53, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x4e, 0x53,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x44, 0x4e, 0x53,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x4e,
+	0x53, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xba, 0x02,
+	0x0a, 0x0d, 0x44, 0x4e, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
+	0x75, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x48, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5e, 0x0a, 0x0e, 0x44, 0x4e,
+	0x53, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x10, 0x44, 0x4e,
+	0x53, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x71, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0xc1, 0x02, 0x0a, 0x0d, 0x44, 0x4e, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68,
+	0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x48, 0x69, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x3d, 0x0a,
+	0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x4e, 0x53, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x0a, 0x74, 0x6f, 0x70, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x09,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x4e, 0x53, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x64, 0x6e, 0x73, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x48, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x69, 0x74, 0x22, 0xa7,
+	0x01, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x6f,
 	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x30, 0x01, 0x12, 0x4b,
-	0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x49,
+	0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x46, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4f, 0x0a, 0x0b, 0x57,
+	0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd7, 0x01, 0x0a,
+	0x13, 0x57, 0x61, 0x72, 0x70, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49,
+	0x70, 0x76, 0x34, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x70, 0x76,
+	0x36, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x65, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x72, 0x70, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x57, 0x69, 0x72, 0x65,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x4b, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0xac, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x65, 0x6d, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12,
+	0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
+	0x78, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xad, 0x02, 0x0a, 0x0b, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0a, 0x52, 0x75,
+	0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x08,
+	0x75, 0x6e, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x75, 0x6e, 0x6d, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x2e, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0xa2, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x37,
+	0x0a, 0x08, 0x64, 0x6e, 0x73, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07,
+	0x64, 0x6e, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a,
+	0x0d, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x32, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
+	0x5e, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x65, 0x6d, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22,
+	0x3f, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x57, 0x0a, 0x15, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x61, 0x67, 0x22, 0x2d, 0x0a, 0x0e, 0x55, 0x72, 0x6c,
+	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x67, 0x22, 0x7e, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x49, 0x0a, 0x12, 0x57, 0x61, 0x72, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x4b, 0x65, 0x79, 0x22, 0xdf, 0x02, 0x0a, 0x0f, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x61, 0x72, 0x70, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x61, 0x72, 0x70, 0x5f, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x77, 0x61, 0x72, 0x70, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x0f, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3d, 0x0a,
+	0x1c, 0x53, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0d, 0x0a,
+	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x26, 0x0a, 0x10,
+	0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x71, 0x0a, 0x10, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x49, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x70,
+	0x76, 0x36, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x22, 0x2a, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2a, 0x41, 0x0a, 0x09, 0x43, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x4f, 0x50, 0x50,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0xcd, 0x02, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45,
+	0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e,
+	0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05,
+	0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e,
+	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x52, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x42, 0x55, 0x49, 0x4c, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10,
+	0x0b, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x10, 0x0d, 0x2a, 0x36, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x41, 0x53, 0x48, 0x10, 0x02, 0x2a, 0x42, 0x0a,
+	0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42,
+	0x55, 0x47, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10,
+	0x04, 0x2a, 0x2c, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x43, 0x4f, 0x52, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x02, 0x32,
+	0x9b, 0x01, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x61, 0x79,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63,
+	0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x0e, 0x53, 0x61, 0x79, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x65, 0x6c, 0x6c,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x32, 0xcf, 0x16,
+	0x0a, 0x04, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x43,
+	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x30, 0x01, 0x12,
+	0x4b, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x3b,
+	0x0a, 0x05, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x56, 0x50, 0x4e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f,
+	0x73, 0x74, 0x6f, 0x76, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x23, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x55, 0x72, 0x6c, 0x54, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x72, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x2a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x49,
 	0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
 	0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
-	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x72,
-	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x3b, 0x0a,
-	0x05, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
-	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x50, 0x61,
-	0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
-	0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x50,
-	0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x17,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x56, 0x50, 0x4e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
-	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x73,
-	0x74, 0x6f, 0x76, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74,
-	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e,
-	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
-	0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
-	0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x72, 0x6f, 0x73, 0x74,
-	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4f,
-	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x23, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
-	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x72,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x52, 0x0a, 0x0f, 0x42, 0x69, 0x6e,
+	0x64, 0x57, 0x61, 0x72, 0x70, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
+	0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x50, 0x0a,
+	0x0d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
+	0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x45, 0x78, 0x69, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x72, 0x6f, 0x73, 0x74,
+	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x44, 0x4e, 0x53,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x4e, 0x53,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x4e, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x3f, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x61, 0x6b, 0x65, 0x49,
+	0x50, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
+	0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e,
+	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x72,
 	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x55, 0x72, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x55,
-	0x72, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x57, 0x61, 0x72, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x2e, 0x72, 0x6f,
-	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x57, 0x61, 0x72, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
-	0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x72, 0x70, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
-	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x2a, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
-	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x72, 0x6f, 0x73, 0x74,
-	0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x30, 0x01, 0x32, 0x8b, 0x02, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x20, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
-	0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x39, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
-	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e,
-	0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x73,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f,
+	0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x6f,
+	0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x8b, 0x02, 0x0a, 0x0d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x47, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x72, 0x6f, 0x73,
 	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x45, 0x78, 0x69, 0x74,
-	0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70,
-	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a, 0x0e, 0x2e, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76,
-	0x70, 0x6e, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72,
+	0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x53, 0x74,
+	0x6f, 0x70, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76,
+	0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x13, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e,
+	0x72, 0x70, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x45, 0x78, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x72, 0x6f, 0x73,
+	0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1c, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a,
+	0x0e, 0x2e, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x6f, 0x76, 0x76, 0x70, 0x6e, 0x72, 0x70, 0x63, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2161,105 +4837,206 @@ func file_rostovvpn_proto_rawDescGZIP() []byte {
 	return file_rostovvpn_proto_rawDescData
 }
 
-var file_rostovvpn_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_rostovvpn_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_rostovvpn_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_rostovvpn_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_rostovvpn_proto_goTypes = []interface{}{
 	(CoreState)(0),                         // 0: rostovvpnrpc.CoreState
 	(MessageType)(0),                       // 1: rostovvpnrpc.MessageType
-	(LogLevel)(0),                          // 2: rostovvpnrpc.LogLevel
-	(LogType)(0),                           // 3: rostovvpnrpc.LogType
-	(*CoreInfoResponse)(nil),               // 4: rostovvpnrpc.CoreInfoResponse
-	(*StartRequest)(nil),                   // 5: rostovvpnrpc.StartRequest
-	(*SetupRequest)(nil),                   // 6: rostovvpnrpc.SetupRequest
-	(*Response)(nil),                       // 7: rostovvpnrpc.Response
-	(*SystemInfo)(nil),                     // 8: rostovvpnrpc.SystemInfo
-	(*OutboundGroupItem)(nil),              // 9: rostovvpnrpc.OutboundGroupItem
-	(*OutboundGroup)(nil),                  // 10: rostovvpnrpc.OutboundGroup
-	(*OutboundGroupList)(nil),              // 11: rostovvpnrpc.OutboundGroupList
-	(*WarpAccount)(nil),                    // 12: rostovvpnrpc.WarpAccount
-	(*WarpWireguardConfig)(nil),            // 13: rostovvpnrpc.WarpWireguardConfig
-	(*WarpGenerationResponse)(nil),         // 14: rostovvpnrpc.WarpGenerationResponse
-	(*SystemProxyStatus)(nil),              // 15: rostovvpnrpc.SystemProxyStatus
-	(*ParseRequest)(nil),                   // 16: rostovvpnrpc.ParseRequest
-	(*ParseResponse)(nil),                  // 17: rostovvpnrpc.ParseResponse
-	(*ChangeRostovVPNSettingsRequest)(nil), // 18: rostovvpnrpc.ChangeRostovVPNSettingsRequest
-	(*GenerateConfigRequest)(nil),          // 19: rostovvpnrpc.GenerateConfigRequest
-	(*GenerateConfigResponse)(nil),         // 20: rostovvpnrpc.GenerateConfigResponse
-	(*SelectOutboundRequest)(nil),          // 21: rostovvpnrpc.SelectOutboundRequest
-	(*UrlTestRequest)(nil),                 // 22: rostovvpnrpc.UrlTestRequest
-	(*GenerateWarpConfigRequest)(nil),      // 23: rostovvpnrpc.GenerateWarpConfigRequest
-	(*SetSystemProxyEnabledRequest)(nil),   // 24: rostovvpnrpc.SetSystemProxyEnabledRequest
-	(*LogMessage)(nil),                     // 25: rostovvpnrpc.LogMessage
-	(*StopRequest)(nil),                    // 26: rostovvpnrpc.StopRequest
-	(*TunnelStartRequest)(nil),             // 27: rostovvpnrpc.TunnelStartRequest
-	(*TunnelResponse)(nil),                 // 28: rostovvpnrpc.TunnelResponse
-	(ResponseCode)(0),                      // 29: rostovvpnrpc.ResponseCode
-	(*HelloRequest)(nil),                   // 30: rostovvpnrpc.HelloRequest
-	(*Empty)(nil),                          // 31: rostovvpnrpc.Empty
-	(*HelloResponse)(nil),                  // 32: rostovvpnrpc.HelloResponse
+	(ExportFormat)(0),                      // 2: rostovvpnrpc.ExportFormat
+	(LogLevel)(0),                          // 3: rostovvpnrpc.LogLevel
+	(LogType)(0),                           // 4: rostovvpnrpc.LogType
+	(*CoreInfoResponse)(nil),               // 5: rostovvpnrpc.CoreInfoResponse
+	(*StartRequest)(nil),                   // 6: rostovvpnrpc.StartRequest
+	(*SetupRequest)(nil),                   // 7: rostovvpnrpc.SetupRequest
+	(*Response)(nil),                       // 8: rostovvpnrpc.Response
+	(*SystemInfo)(nil),                     // 9: rostovvpnrpc.SystemInfo
+	(*OutboundGroupItem)(nil),              // 10: rostovvpnrpc.OutboundGroupItem
+	(*ExitInfo)(nil),                       // 11: rostovvpnrpc.ExitInfo
+	(*CheckExitRequest)(nil),               // 12: rostovvpnrpc.CheckExitRequest
+	(*CheckExitResponse)(nil),              // 13: rostovvpnrpc.CheckExitResponse
+	(*DNSUpstreamStats)(nil),               // 14: rostovvpnrpc.DNSUpstreamStats
+	(*DNSGroupStats)(nil),                  // 15: rostovvpnrpc.DNSGroupStats
+	(*DNSStatsResponse)(nil),               // 16: rostovvpnrpc.DNSStatsResponse
+	(*DNSQueryEntry)(nil),                  // 17: rostovvpnrpc.DNSQueryEntry
+	(*DNSDomainCount)(nil),                 // 18: rostovvpnrpc.DNSDomainCount
+	(*DNSUpstreamCount)(nil),               // 19: rostovvpnrpc.DNSUpstreamCount
+	(*DNSQueryStats)(nil),                  // 20: rostovvpnrpc.DNSQueryStats
+	(*BlocklistStats)(nil),                 // 21: rostovvpnrpc.BlocklistStats
+	(*BlocklistStatsResponse)(nil),         // 22: rostovvpnrpc.BlocklistStatsResponse
+	(*OutboundGroup)(nil),                  // 23: rostovvpnrpc.OutboundGroup
+	(*OutboundGroupList)(nil),              // 24: rostovvpnrpc.OutboundGroupList
+	(*WarpAccount)(nil),                    // 25: rostovvpnrpc.WarpAccount
+	(*WarpWireguardConfig)(nil),            // 26: rostovvpnrpc.WarpWireguardConfig
+	(*WarpGenerationResponse)(nil),         // 27: rostovvpnrpc.WarpGenerationResponse
+	(*SystemProxyStatus)(nil),              // 28: rostovvpnrpc.SystemProxyStatus
+	(*ParseRequest)(nil),                   // 29: rostovvpnrpc.ParseRequest
+	(*ParseEntry)(nil),                     // 30: rostovvpnrpc.ParseEntry
+	(*ParseReport)(nil),                    // 31: rostovvpnrpc.ParseReport
+	(*RuleImport)(nil),                     // 32: rostovvpnrpc.RuleImport
+	(*ImportRulesRequest)(nil),             // 33: rostovvpnrpc.ImportRulesRequest
+	(*ImportRulesResponse)(nil),            // 34: rostovvpnrpc.ImportRulesResponse
+	(*ExplainRouteRequest)(nil),            // 35: rostovvpnrpc.ExplainRouteRequest
+	(*RouteRuleMatch)(nil),                 // 36: rostovvpnrpc.RouteRuleMatch
+	(*ExplainRouteResponse)(nil),           // 37: rostovvpnrpc.ExplainRouteResponse
+	(*ParseResponse)(nil),                  // 38: rostovvpnrpc.ParseResponse
+	(*ExportOutboundsRequest)(nil),         // 39: rostovvpnrpc.ExportOutboundsRequest
+	(*ExportOutboundsResponse)(nil),        // 40: rostovvpnrpc.ExportOutboundsResponse
+	(*ChangeRostovVPNSettingsRequest)(nil), // 41: rostovvpnrpc.ChangeRostovVPNSettingsRequest
+	(*GenerateConfigRequest)(nil),          // 42: rostovvpnrpc.GenerateConfigRequest
+	(*GenerateConfigResponse)(nil),         // 43: rostovvpnrpc.GenerateConfigResponse
+	(*SelectOutboundRequest)(nil),          // 44: rostovvpnrpc.SelectOutboundRequest
+	(*UrlTestRequest)(nil),                 // 45: rostovvpnrpc.UrlTestRequest
+	(*GenerateWarpConfigRequest)(nil),      // 46: rostovvpnrpc.GenerateWarpConfigRequest
+	(*WarpAccountRequest)(nil),             // 47: rostovvpnrpc.WarpAccountRequest
+	(*WarpAccountInfo)(nil),                // 48: rostovvpnrpc.WarpAccountInfo
+	(*WarpAccountList)(nil),                // 49: rostovvpnrpc.WarpAccountList
+	(*SetSystemProxyEnabledRequest)(nil),   // 50: rostovvpnrpc.SetSystemProxyEnabledRequest
+	(*LogMessage)(nil),                     // 51: rostovvpnrpc.LogMessage
+	(*StopRequest)(nil),                    // 52: rostovvpnrpc.StopRequest
+	(*ClashModeRequest)(nil),               // 53: rostovvpnrpc.ClashModeRequest
+	(*ClashModeResponse)(nil),              // 54: rostovvpnrpc.ClashModeResponse
+	(*ProfilingRequest)(nil),               // 55: rostovvpnrpc.ProfilingRequest
+	(*ProfileData)(nil),                    // 56: rostovvpnrpc.ProfileData
+	(*TunnelStartRequest)(nil),             // 57: rostovvpnrpc.TunnelStartRequest
+	(*TunnelResponse)(nil),                 // 58: rostovvpnrpc.TunnelResponse
+	(ResponseCode)(0),                      // 59: rostovvpnrpc.ResponseCode
+	(*HelloRequest)(nil),                   // 60: rostovvpnrpc.HelloRequest
+	(*Empty)(nil),                          // 61: rostovvpnrpc.Empty
+	(*HelloResponse)(nil),                  // 62: rostovvpnrpc.HelloResponse
 }
 var file_rostovvpn_proto_depIdxs = []int32{
 	0,  // 0: rostovvpnrpc.CoreInfoResponse.core_state:type_name -> rostovvpnrpc.CoreState
 	1,  // 1: rostovvpnrpc.CoreInfoResponse.message_type:type_name -> rostovvpnrpc.MessageType
-	29, // 2: rostovvpnrpc.Response.response_code:type_name -> rostovvpnrpc.ResponseCode
-	9,  // 3: rostovvpnrpc.OutboundGroup.items:type_name -> rostovvpnrpc.OutboundGroupItem
-	10, // 4: rostovvpnrpc.OutboundGroupList.items:type_name -> rostovvpnrpc.OutboundGroup
-	12, // 5: rostovvpnrpc.WarpGenerationResponse.account:type_name -> rostovvpnrpc.WarpAccount
-	13, // 6: rostovvpnrpc.WarpGenerationResponse.config:type_name -> rostovvpnrpc.WarpWireguardConfig
-	29, // 7: rostovvpnrpc.ParseResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
-	2,  // 8: rostovvpnrpc.LogMessage.level:type_name -> rostovvpnrpc.LogLevel
-	3,  // 9: rostovvpnrpc.LogMessage.type:type_name -> rostovvpnrpc.LogType
-	30, // 10: rostovvpnrpc.Hello.SayHello:input_type -> rostovvpnrpc.HelloRequest
-	30, // 11: rostovvpnrpc.Hello.SayHelloStream:input_type -> rostovvpnrpc.HelloRequest
-	5,  // 12: rostovvpnrpc.Core.Start:input_type -> rostovvpnrpc.StartRequest
-	31, // 13: rostovvpnrpc.Core.CoreInfoListener:input_type -> rostovvpnrpc.Empty
-	31, // 14: rostovvpnrpc.Core.OutboundsInfo:input_type -> rostovvpnrpc.Empty
-	31, // 15: rostovvpnrpc.Core.MainOutboundsInfo:input_type -> rostovvpnrpc.Empty
-	31, // 16: rostovvpnrpc.Core.GetSystemInfo:input_type -> rostovvpnrpc.Empty
-	6,  // 17: rostovvpnrpc.Core.Setup:input_type -> rostovvpnrpc.SetupRequest
-	16, // 18: rostovvpnrpc.Core.Parse:input_type -> rostovvpnrpc.ParseRequest
-	18, // 19: rostovvpnrpc.Core.ChangeRostovVPNSettings:input_type -> rostovvpnrpc.ChangeRostovVPNSettingsRequest
-	5,  // 20: rostovvpnrpc.Core.StartService:input_type -> rostovvpnrpc.StartRequest
-	31, // 21: rostovvpnrpc.Core.Stop:input_type -> rostovvpnrpc.Empty
-	5,  // 22: rostovvpnrpc.Core.Restart:input_type -> rostovvpnrpc.StartRequest
-	21, // 23: rostovvpnrpc.Core.SelectOutbound:input_type -> rostovvpnrpc.SelectOutboundRequest
-	22, // 24: rostovvpnrpc.Core.UrlTest:input_type -> rostovvpnrpc.UrlTestRequest
-	23, // 25: rostovvpnrpc.Core.GenerateWarpConfig:input_type -> rostovvpnrpc.GenerateWarpConfigRequest
-	31, // 26: rostovvpnrpc.Core.GetSystemProxyStatus:input_type -> rostovvpnrpc.Empty
-	24, // 27: rostovvpnrpc.Core.SetSystemProxyEnabled:input_type -> rostovvpnrpc.SetSystemProxyEnabledRequest
-	31, // 28: rostovvpnrpc.Core.LogListener:input_type -> rostovvpnrpc.Empty
-	27, // 29: rostovvpnrpc.TunnelService.Start:input_type -> rostovvpnrpc.TunnelStartRequest
-	31, // 30: rostovvpnrpc.TunnelService.Stop:input_type -> rostovvpnrpc.Empty
-	31, // 31: rostovvpnrpc.TunnelService.Status:input_type -> rostovvpnrpc.Empty
-	31, // 32: rostovvpnrpc.TunnelService.Exit:input_type -> rostovvpnrpc.Empty
-	32, // 33: rostovvpnrpc.Hello.SayHello:output_type -> rostovvpnrpc.HelloResponse
-	32, // 34: rostovvpnrpc.Hello.SayHelloStream:output_type -> rostovvpnrpc.HelloResponse
-	4,  // 35: rostovvpnrpc.Core.Start:output_type -> rostovvpnrpc.CoreInfoResponse
-	4,  // 36: rostovvpnrpc.Core.CoreInfoListener:output_type -> rostovvpnrpc.CoreInfoResponse
-	11, // 37: rostovvpnrpc.Core.OutboundsInfo:output_type -> rostovvpnrpc.OutboundGroupList
-	11, // 38: rostovvpnrpc.Core.MainOutboundsInfo:output_type -> rostovvpnrpc.OutboundGroupList
-	8,  // 39: rostovvpnrpc.Core.GetSystemInfo:output_type -> rostovvpnrpc.SystemInfo
-	7,  // 40: rostovvpnrpc.Core.Setup:output_type -> rostovvpnrpc.Response
-	17, // 41: rostovvpnrpc.Core.Parse:output_type -> rostovvpnrpc.ParseResponse
-	4,  // 42: rostovvpnrpc.Core.ChangeRostovVPNSettings:output_type -> rostovvpnrpc.CoreInfoResponse
-	4,  // 43: rostovvpnrpc.Core.StartService:output_type -> rostovvpnrpc.CoreInfoResponse
-	4,  // 44: rostovvpnrpc.Core.Stop:output_type -> rostovvpnrpc.CoreInfoResponse
-	4,  // 45: rostovvpnrpc.Core.Restart:output_type -> rostovvpnrpc.CoreInfoResponse
-	7,  // 46: rostovvpnrpc.Core.SelectOutbound:output_type -> rostovvpnrpc.Response
-	7,  // 47: rostovvpnrpc.Core.UrlTest:output_type -> rostovvpnrpc.Response
-	14, // 48: rostovvpnrpc.Core.GenerateWarpConfig:output_type -> rostovvpnrpc.WarpGenerationResponse
-	15, // 49: rostovvpnrpc.Core.GetSystemProxyStatus:output_type -> rostovvpnrpc.SystemProxyStatus
-	7,  // 50: rostovvpnrpc.Core.SetSystemProxyEnabled:output_type -> rostovvpnrpc.Response
-	25, // 51: rostovvpnrpc.Core.LogListener:output_type -> rostovvpnrpc.LogMessage
-	28, // 52: rostovvpnrpc.TunnelService.Start:output_type -> rostovvpnrpc.TunnelResponse
-	28, // 53: rostovvpnrpc.TunnelService.Stop:output_type -> rostovvpnrpc.TunnelResponse
-	28, // 54: rostovvpnrpc.TunnelService.Status:output_type -> rostovvpnrpc.TunnelResponse
-	28, // 55: rostovvpnrpc.TunnelService.Exit:output_type -> rostovvpnrpc.TunnelResponse
-	33, // [33:56] is the sub-list for method output_type
-	10, // [10:33] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	59, // 2: rostovvpnrpc.Response.response_code:type_name -> rostovvpnrpc.ResponseCode
+	11, // 3: rostovvpnrpc.OutboundGroupItem.exit:type_name -> rostovvpnrpc.ExitInfo
+	59, // 4: rostovvpnrpc.CheckExitResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	11, // 5: rostovvpnrpc.CheckExitResponse.results:type_name -> rostovvpnrpc.ExitInfo
+	14, // 6: rostovvpnrpc.DNSGroupStats.upstreams:type_name -> rostovvpnrpc.DNSUpstreamStats
+	59, // 7: rostovvpnrpc.DNSStatsResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	15, // 8: rostovvpnrpc.DNSStatsResponse.groups:type_name -> rostovvpnrpc.DNSGroupStats
+	59, // 9: rostovvpnrpc.DNSQueryStats.response_code:type_name -> rostovvpnrpc.ResponseCode
+	18, // 10: rostovvpnrpc.DNSQueryStats.top_domains:type_name -> rostovvpnrpc.DNSDomainCount
+	19, // 11: rostovvpnrpc.DNSQueryStats.upstreams:type_name -> rostovvpnrpc.DNSUpstreamCount
+	59, // 12: rostovvpnrpc.BlocklistStatsResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	21, // 13: rostovvpnrpc.BlocklistStatsResponse.lists:type_name -> rostovvpnrpc.BlocklistStats
+	10, // 14: rostovvpnrpc.OutboundGroup.items:type_name -> rostovvpnrpc.OutboundGroupItem
+	23, // 15: rostovvpnrpc.OutboundGroupList.items:type_name -> rostovvpnrpc.OutboundGroup
+	25, // 16: rostovvpnrpc.WarpGenerationResponse.account:type_name -> rostovvpnrpc.WarpAccount
+	26, // 17: rostovvpnrpc.WarpGenerationResponse.config:type_name -> rostovvpnrpc.WarpWireguardConfig
+	30, // 18: rostovvpnrpc.ParseReport.accepted:type_name -> rostovvpnrpc.ParseEntry
+	30, // 19: rostovvpnrpc.ParseReport.rejected:type_name -> rostovvpnrpc.ParseEntry
+	30, // 20: rostovvpnrpc.ParseReport.filtered:type_name -> rostovvpnrpc.ParseEntry
+	32, // 21: rostovvpnrpc.ParseReport.rules:type_name -> rostovvpnrpc.RuleImport
+	30, // 22: rostovvpnrpc.ParseReport.warnings:type_name -> rostovvpnrpc.ParseEntry
+	30, // 23: rostovvpnrpc.RuleImport.unmapped:type_name -> rostovvpnrpc.ParseEntry
+	30, // 24: rostovvpnrpc.RuleImport.approximated:type_name -> rostovvpnrpc.ParseEntry
+	59, // 25: rostovvpnrpc.ImportRulesResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	32, // 26: rostovvpnrpc.ImportRulesResponse.result:type_name -> rostovvpnrpc.RuleImport
+	59, // 27: rostovvpnrpc.ExplainRouteResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	36, // 28: rostovvpnrpc.ExplainRouteResponse.rule:type_name -> rostovvpnrpc.RouteRuleMatch
+	36, // 29: rostovvpnrpc.ExplainRouteResponse.dns_rule:type_name -> rostovvpnrpc.RouteRuleMatch
+	59, // 30: rostovvpnrpc.ParseResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	31, // 31: rostovvpnrpc.ParseResponse.report:type_name -> rostovvpnrpc.ParseReport
+	2,  // 32: rostovvpnrpc.ExportOutboundsRequest.format:type_name -> rostovvpnrpc.ExportFormat
+	59, // 33: rostovvpnrpc.ExportOutboundsResponse.response_code:type_name -> rostovvpnrpc.ResponseCode
+	25, // 34: rostovvpnrpc.WarpAccountInfo.account:type_name -> rostovvpnrpc.WarpAccount
+	26, // 35: rostovvpnrpc.WarpAccountInfo.config:type_name -> rostovvpnrpc.WarpWireguardConfig
+	48, // 36: rostovvpnrpc.WarpAccountList.items:type_name -> rostovvpnrpc.WarpAccountInfo
+	3,  // 37: rostovvpnrpc.LogMessage.level:type_name -> rostovvpnrpc.LogLevel
+	4,  // 38: rostovvpnrpc.LogMessage.type:type_name -> rostovvpnrpc.LogType
+	60, // 39: rostovvpnrpc.Hello.SayHello:input_type -> rostovvpnrpc.HelloRequest
+	60, // 40: rostovvpnrpc.Hello.SayHelloStream:input_type -> rostovvpnrpc.HelloRequest
+	6,  // 41: rostovvpnrpc.Core.Start:input_type -> rostovvpnrpc.StartRequest
+	61, // 42: rostovvpnrpc.Core.CoreInfoListener:input_type -> rostovvpnrpc.Empty
+	61, // 43: rostovvpnrpc.Core.OutboundsInfo:input_type -> rostovvpnrpc.Empty
+	61, // 44: rostovvpnrpc.Core.MainOutboundsInfo:input_type -> rostovvpnrpc.Empty
+	61, // 45: rostovvpnrpc.Core.GetSystemInfo:input_type -> rostovvpnrpc.Empty
+	7,  // 46: rostovvpnrpc.Core.Setup:input_type -> rostovvpnrpc.SetupRequest
+	29, // 47: rostovvpnrpc.Core.Parse:input_type -> rostovvpnrpc.ParseRequest
+	41, // 48: rostovvpnrpc.Core.ChangeRostovVPNSettings:input_type -> rostovvpnrpc.ChangeRostovVPNSettingsRequest
+	6,  // 49: rostovvpnrpc.Core.StartService:input_type -> rostovvpnrpc.StartRequest
+	61, // 50: rostovvpnrpc.Core.Stop:input_type -> rostovvpnrpc.Empty
+	6,  // 51: rostovvpnrpc.Core.Restart:input_type -> rostovvpnrpc.StartRequest
+	44, // 52: rostovvpnrpc.Core.SelectOutbound:input_type -> rostovvpnrpc.SelectOutboundRequest
+	45, // 53: rostovvpnrpc.Core.UrlTest:input_type -> rostovvpnrpc.UrlTestRequest
+	46, // 54: rostovvpnrpc.Core.GenerateWarpConfig:input_type -> rostovvpnrpc.GenerateWarpConfigRequest
+	61, // 55: rostovvpnrpc.Core.GetSystemProxyStatus:input_type -> rostovvpnrpc.Empty
+	50, // 56: rostovvpnrpc.Core.SetSystemProxyEnabled:input_type -> rostovvpnrpc.SetSystemProxyEnabledRequest
+	61, // 57: rostovvpnrpc.Core.LogListener:input_type -> rostovvpnrpc.Empty
+	55, // 58: rostovvpnrpc.Core.StartProfiling:input_type -> rostovvpnrpc.ProfilingRequest
+	61, // 59: rostovvpnrpc.Core.StopProfiling:input_type -> rostovvpnrpc.Empty
+	61, // 60: rostovvpnrpc.Core.GetClashModes:input_type -> rostovvpnrpc.Empty
+	53, // 61: rostovvpnrpc.Core.SetClashMode:input_type -> rostovvpnrpc.ClashModeRequest
+	61, // 62: rostovvpnrpc.Core.ClashModeInfo:input_type -> rostovvpnrpc.Empty
+	61, // 63: rostovvpnrpc.Core.ListWarpAccounts:input_type -> rostovvpnrpc.Empty
+	47, // 64: rostovvpnrpc.Core.CreateWarpAccount:input_type -> rostovvpnrpc.WarpAccountRequest
+	47, // 65: rostovvpnrpc.Core.BindWarpLicense:input_type -> rostovvpnrpc.WarpAccountRequest
+	47, // 66: rostovvpnrpc.Core.RotateWarpKey:input_type -> rostovvpnrpc.WarpAccountRequest
+	47, // 67: rostovvpnrpc.Core.GetWarpAccountStatus:input_type -> rostovvpnrpc.WarpAccountRequest
+	47, // 68: rostovvpnrpc.Core.DeleteWarpAccount:input_type -> rostovvpnrpc.WarpAccountRequest
+	39, // 69: rostovvpnrpc.Core.ExportOutbounds:input_type -> rostovvpnrpc.ExportOutboundsRequest
+	12, // 70: rostovvpnrpc.Core.CheckExit:input_type -> rostovvpnrpc.CheckExitRequest
+	61, // 71: rostovvpnrpc.Core.GetDNSStats:input_type -> rostovvpnrpc.Empty
+	61, // 72: rostovvpnrpc.Core.DNSQueryLog:input_type -> rostovvpnrpc.Empty
+	61, // 73: rostovvpnrpc.Core.GetDNSQueryStats:input_type -> rostovvpnrpc.Empty
+	61, // 74: rostovvpnrpc.Core.ClearFakeIPCache:input_type -> rostovvpnrpc.Empty
+	61, // 75: rostovvpnrpc.Core.GetBlocklistStats:input_type -> rostovvpnrpc.Empty
+	61, // 76: rostovvpnrpc.Core.UpdateBlocklists:input_type -> rostovvpnrpc.Empty
+	33, // 77: rostovvpnrpc.Core.ImportRules:input_type -> rostovvpnrpc.ImportRulesRequest
+	35, // 78: rostovvpnrpc.Core.ExplainRoute:input_type -> rostovvpnrpc.ExplainRouteRequest
+	57, // 79: rostovvpnrpc.TunnelService.Start:input_type -> rostovvpnrpc.TunnelStartRequest
+	61, // 80: rostovvpnrpc.TunnelService.Stop:input_type -> rostovvpnrpc.Empty
+	61, // 81: rostovvpnrpc.TunnelService.Status:input_type -> rostovvpnrpc.Empty
+	61, // 82: rostovvpnrpc.TunnelService.Exit:input_type -> rostovvpnrpc.Empty
+	62, // 83: rostovvpnrpc.Hello.SayHello:output_type -> rostovvpnrpc.HelloResponse
+	62, // 84: rostovvpnrpc.Hello.SayHelloStream:output_type -> rostovvpnrpc.HelloResponse
+	5,  // 85: rostovvpnrpc.Core.Start:output_type -> rostovvpnrpc.CoreInfoResponse
+	5,  // 86: rostovvpnrpc.Core.CoreInfoListener:output_type -> rostovvpnrpc.CoreInfoResponse
+	24, // 87: rostovvpnrpc.Core.OutboundsInfo:output_type -> rostovvpnrpc.OutboundGroupList
+	24, // 88: rostovvpnrpc.Core.MainOutboundsInfo:output_type -> rostovvpnrpc.OutboundGroupList
+	9,  // 89: rostovvpnrpc.Core.GetSystemInfo:output_type -> rostovvpnrpc.SystemInfo
+	8,  // 90: rostovvpnrpc.Core.Setup:output_type -> rostovvpnrpc.Response
+	38, // 91: rostovvpnrpc.Core.Parse:output_type -> rostovvpnrpc.ParseResponse
+	5,  // 92: rostovvpnrpc.Core.ChangeRostovVPNSettings:output_type -> rostovvpnrpc.CoreInfoResponse
+	5,  // 93: rostovvpnrpc.Core.StartService:output_type -> rostovvpnrpc.CoreInfoResponse
+	5,  // 94: rostovvpnrpc.Core.Stop:output_type -> rostovvpnrpc.CoreInfoResponse
+	5,  // 95: rostovvpnrpc.Core.Restart:output_type -> rostovvpnrpc.CoreInfoResponse
+	8,  // 96: rostovvpnrpc.Core.SelectOutbound:output_type -> rostovvpnrpc.Response
+	8,  // 97: rostovvpnrpc.Core.UrlTest:output_type -> rostovvpnrpc.Response
+	27, // 98: rostovvpnrpc.Core.GenerateWarpConfig:output_type -> rostovvpnrpc.WarpGenerationResponse
+	28, // 99: rostovvpnrpc.Core.GetSystemProxyStatus:output_type -> rostovvpnrpc.SystemProxyStatus
+	8,  // 100: rostovvpnrpc.Core.SetSystemProxyEnabled:output_type -> rostovvpnrpc.Response
+	51, // 101: rostovvpnrpc.Core.LogListener:output_type -> rostovvpnrpc.LogMessage
+	56, // 102: rostovvpnrpc.Core.StartProfiling:output_type -> rostovvpnrpc.ProfileData
+	8,  // 103: rostovvpnrpc.Core.StopProfiling:output_type -> rostovvpnrpc.Response
+	54, // 104: rostovvpnrpc.Core.GetClashModes:output_type -> rostovvpnrpc.ClashModeResponse
+	8,  // 105: rostovvpnrpc.Core.SetClashMode:output_type -> rostovvpnrpc.Response
+	54, // 106: rostovvpnrpc.Core.ClashModeInfo:output_type -> rostovvpnrpc.ClashModeResponse
+	49, // 107: rostovvpnrpc.Core.ListWarpAccounts:output_type -> rostovvpnrpc.WarpAccountList
+	48, // 108: rostovvpnrpc.Core.CreateWarpAccount:output_type -> rostovvpnrpc.WarpAccountInfo
+	48, // 109: rostovvpnrpc.Core.BindWarpLicense:output_type -> rostovvpnrpc.WarpAccountInfo
+	48, // 110: rostovvpnrpc.Core.RotateWarpKey:output_type -> rostovvpnrpc.WarpAccountInfo
+	48, // 111: rostovvpnrpc.Core.GetWarpAccountStatus:output_type -> rostovvpnrpc.WarpAccountInfo
+	8,  // 112: rostovvpnrpc.Core.DeleteWarpAccount:output_type -> rostovvpnrpc.Response
+	40, // 113: rostovvpnrpc.Core.ExportOutbounds:output_type -> rostovvpnrpc.ExportOutboundsResponse
+	13, // 114: rostovvpnrpc.Core.CheckExit:output_type -> rostovvpnrpc.CheckExitResponse
+	16, // 115: rostovvpnrpc.Core.GetDNSStats:output_type -> rostovvpnrpc.DNSStatsResponse
+	17, // 116: rostovvpnrpc.Core.DNSQueryLog:output_type -> rostovvpnrpc.DNSQueryEntry
+	20, // 117: rostovvpnrpc.Core.GetDNSQueryStats:output_type -> rostovvpnrpc.DNSQueryStats
+	8,  // 118: rostovvpnrpc.Core.ClearFakeIPCache:output_type -> rostovvpnrpc.Response
+	22, // 119: rostovvpnrpc.Core.GetBlocklistStats:output_type -> rostovvpnrpc.BlocklistStatsResponse
+	8,  // 120: rostovvpnrpc.Core.UpdateBlocklists:output_type -> rostovvpnrpc.Response
+	34, // 121: rostovvpnrpc.Core.ImportRules:output_type -> rostovvpnrpc.ImportRulesResponse
+	37, // 122: rostovvpnrpc.Core.ExplainRoute:output_type -> rostovvpnrpc.ExplainRouteResponse
+	58, // 123: rostovvpnrpc.TunnelService.Start:output_type -> rostovvpnrpc.TunnelResponse
+	58, // 124: rostovvpnrpc.TunnelService.Stop:output_type -> rostovvpnrpc.TunnelResponse
+	58, // 125: rostovvpnrpc.TunnelService.Status:output_type -> rostovvpnrpc.TunnelResponse
+	58, // 126: rostovvpnrpc.TunnelService.Exit:output_type -> rostovvpnrpc.TunnelResponse
+	83, // [83:127] is the sub-list for method output_type
+	39, // [39:83] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_rostovvpn_proto_init() }
@@ -2281,8 +5058,104 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartRequest); i {
+		file_rostovvpn_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboundGroupItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckExitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckExitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSUpstreamStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2293,8 +5166,8 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetupRequest); i {
+		file_rostovvpn_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSGroupStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2305,8 +5178,8 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_rostovvpn_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2317,8 +5190,8 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemInfo); i {
+		file_rostovvpn_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSQueryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2329,8 +5202,8 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutboundGroupItem); i {
+		file_rostovvpn_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSDomainCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2341,7 +5214,55 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSUpstreamCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSQueryStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlocklistStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlocklistStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OutboundGroup); i {
 			case 0:
 				return &v.state
@@ -2353,7 +5274,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OutboundGroupList); i {
 			case 0:
 				return &v.state
@@ -2365,7 +5286,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WarpAccount); i {
 			case 0:
 				return &v.state
@@ -2377,7 +5298,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WarpWireguardConfig); i {
 			case 0:
 				return &v.state
@@ -2389,7 +5310,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WarpGenerationResponse); i {
 			case 0:
 				return &v.state
@@ -2401,7 +5322,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemProxyStatus); i {
 			case 0:
 				return &v.state
@@ -2413,7 +5334,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ParseRequest); i {
 			case 0:
 				return &v.state
@@ -2425,7 +5346,103 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteRuleMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ParseResponse); i {
 			case 0:
 				return &v.state
@@ -2437,7 +5454,31 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOutboundsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOutboundsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeRostovVPNSettingsRequest); i {
 			case 0:
 				return &v.state
@@ -2449,7 +5490,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateConfigRequest); i {
 			case 0:
 				return &v.state
@@ -2461,7 +5502,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateConfigResponse); i {
 			case 0:
 				return &v.state
@@ -2473,7 +5514,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SelectOutboundRequest); i {
 			case 0:
 				return &v.state
@@ -2485,7 +5526,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UrlTestRequest); i {
 			case 0:
 				return &v.state
@@ -2497,7 +5538,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateWarpConfigRequest); i {
 			case 0:
 				return &v.state
@@ -2509,7 +5550,43 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarpAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarpAccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarpAccountList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetSystemProxyEnabledRequest); i {
 			case 0:
 				return &v.state
@@ -2521,7 +5598,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogMessage); i {
 			case 0:
 				return &v.state
@@ -2533,7 +5610,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
@@ -2545,7 +5622,55 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClashModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClashModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfilingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rostovvpn_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TunnelStartRequest); i {
 			case 0:
 				return &v.state
@@ -2557,7 +5682,7 @@ func file_rostovvpn_proto_init() {
 				return nil
 			}
 		}
-		file_rostovvpn_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_rostovvpn_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TunnelResponse); i {
 			case 0:
 				return &v.state
@@ -2575,8 +5700,8 @@ func file_rostovvpn_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rostovvpn_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   25,
+			NumEnums:      5,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
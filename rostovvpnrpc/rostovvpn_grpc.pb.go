@@ -171,6 +171,27 @@ const (
 	Core_GetSystemProxyStatus_FullMethodName    = "/rostovvpnrpc.Core/GetSystemProxyStatus"
 	Core_SetSystemProxyEnabled_FullMethodName   = "/rostovvpnrpc.Core/SetSystemProxyEnabled"
 	Core_LogListener_FullMethodName             = "/rostovvpnrpc.Core/LogListener"
+	Core_StartProfiling_FullMethodName          = "/rostovvpnrpc.Core/StartProfiling"
+	Core_StopProfiling_FullMethodName           = "/rostovvpnrpc.Core/StopProfiling"
+	Core_GetClashModes_FullMethodName           = "/rostovvpnrpc.Core/GetClashModes"
+	Core_SetClashMode_FullMethodName            = "/rostovvpnrpc.Core/SetClashMode"
+	Core_ClashModeInfo_FullMethodName           = "/rostovvpnrpc.Core/ClashModeInfo"
+	Core_ListWarpAccounts_FullMethodName        = "/rostovvpnrpc.Core/ListWarpAccounts"
+	Core_CreateWarpAccount_FullMethodName       = "/rostovvpnrpc.Core/CreateWarpAccount"
+	Core_BindWarpLicense_FullMethodName         = "/rostovvpnrpc.Core/BindWarpLicense"
+	Core_RotateWarpKey_FullMethodName           = "/rostovvpnrpc.Core/RotateWarpKey"
+	Core_GetWarpAccountStatus_FullMethodName    = "/rostovvpnrpc.Core/GetWarpAccountStatus"
+	Core_DeleteWarpAccount_FullMethodName       = "/rostovvpnrpc.Core/DeleteWarpAccount"
+	Core_ExportOutbounds_FullMethodName         = "/rostovvpnrpc.Core/ExportOutbounds"
+	Core_CheckExit_FullMethodName               = "/rostovvpnrpc.Core/CheckExit"
+	Core_GetDNSStats_FullMethodName             = "/rostovvpnrpc.Core/GetDNSStats"
+	Core_DNSQueryLog_FullMethodName             = "/rostovvpnrpc.Core/DNSQueryLog"
+	Core_GetDNSQueryStats_FullMethodName        = "/rostovvpnrpc.Core/GetDNSQueryStats"
+	Core_ClearFakeIPCache_FullMethodName        = "/rostovvpnrpc.Core/ClearFakeIPCache"
+	Core_GetBlocklistStats_FullMethodName       = "/rostovvpnrpc.Core/GetBlocklistStats"
+	Core_UpdateBlocklists_FullMethodName        = "/rostovvpnrpc.Core/UpdateBlocklists"
+	Core_ImportRules_FullMethodName             = "/rostovvpnrpc.Core/ImportRules"
+	Core_ExplainRoute_FullMethodName            = "/rostovvpnrpc.Core/ExplainRoute"
 )
 
 // CoreClient is the client API for Core service.
@@ -195,6 +216,27 @@ type CoreClient interface {
 	GetSystemProxyStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SystemProxyStatus, error)
 	SetSystemProxyEnabled(ctx context.Context, in *SetSystemProxyEnabledRequest, opts ...grpc.CallOption) (*Response, error)
 	LogListener(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogMessage], error)
+	StartProfiling(ctx context.Context, in *ProfilingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProfileData], error)
+	StopProfiling(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	GetClashModes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClashModeResponse, error)
+	SetClashMode(ctx context.Context, in *ClashModeRequest, opts ...grpc.CallOption) (*Response, error)
+	ClashModeInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClashModeResponse], error)
+	ListWarpAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WarpAccountList, error)
+	CreateWarpAccount(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error)
+	BindWarpLicense(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error)
+	RotateWarpKey(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error)
+	GetWarpAccountStatus(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error)
+	DeleteWarpAccount(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*Response, error)
+	ExportOutbounds(ctx context.Context, in *ExportOutboundsRequest, opts ...grpc.CallOption) (*ExportOutboundsResponse, error)
+	CheckExit(ctx context.Context, in *CheckExitRequest, opts ...grpc.CallOption) (*CheckExitResponse, error)
+	GetDNSStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DNSStatsResponse, error)
+	DNSQueryLog(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DNSQueryEntry], error)
+	GetDNSQueryStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DNSQueryStats, error)
+	ClearFakeIPCache(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	GetBlocklistStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlocklistStatsResponse, error)
+	UpdateBlocklists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+	ImportRules(ctx context.Context, in *ImportRulesRequest, opts ...grpc.CallOption) (*ImportRulesResponse, error)
+	ExplainRoute(ctx context.Context, in *ExplainRouteRequest, opts ...grpc.CallOption) (*ExplainRouteResponse, error)
 }
 
 type coreClient struct {
@@ -420,6 +462,243 @@ func (c *coreClient) LogListener(ctx context.Context, in *Empty, opts ...grpc.Ca
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Core_LogListenerClient = grpc.ServerStreamingClient[LogMessage]
 
+func (c *coreClient) StartProfiling(ctx context.Context, in *ProfilingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProfileData], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[5], Core_StartProfiling_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProfilingRequest, ProfileData]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_StartProfilingClient = grpc.ServerStreamingClient[ProfileData]
+
+func (c *coreClient) StopProfiling(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_StopProfiling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetClashModes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClashModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClashModeResponse)
+	err := c.cc.Invoke(ctx, Core_GetClashModes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) SetClashMode(ctx context.Context, in *ClashModeRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_SetClashMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ClashModeInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClashModeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[6], Core_ClashModeInfo_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, ClashModeResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_ClashModeInfoClient = grpc.ServerStreamingClient[ClashModeResponse]
+
+func (c *coreClient) ListWarpAccounts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WarpAccountList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpAccountList)
+	err := c.cc.Invoke(ctx, Core_ListWarpAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateWarpAccount(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpAccountInfo)
+	err := c.cc.Invoke(ctx, Core_CreateWarpAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) BindWarpLicense(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpAccountInfo)
+	err := c.cc.Invoke(ctx, Core_BindWarpLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RotateWarpKey(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpAccountInfo)
+	err := c.cc.Invoke(ctx, Core_RotateWarpKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetWarpAccountStatus(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*WarpAccountInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarpAccountInfo)
+	err := c.cc.Invoke(ctx, Core_GetWarpAccountStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DeleteWarpAccount(ctx context.Context, in *WarpAccountRequest, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_DeleteWarpAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ExportOutbounds(ctx context.Context, in *ExportOutboundsRequest, opts ...grpc.CallOption) (*ExportOutboundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportOutboundsResponse)
+	err := c.cc.Invoke(ctx, Core_ExportOutbounds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CheckExit(ctx context.Context, in *CheckExitRequest, opts ...grpc.CallOption) (*CheckExitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckExitResponse)
+	err := c.cc.Invoke(ctx, Core_CheckExit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetDNSStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DNSStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DNSStatsResponse)
+	err := c.cc.Invoke(ctx, Core_GetDNSStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DNSQueryLog(ctx context.Context, in *Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DNSQueryEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[7], Core_DNSQueryLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Empty, DNSQueryEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_DNSQueryLogClient = grpc.ServerStreamingClient[DNSQueryEntry]
+
+func (c *coreClient) GetDNSQueryStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DNSQueryStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DNSQueryStats)
+	err := c.cc.Invoke(ctx, Core_GetDNSQueryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ClearFakeIPCache(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_ClearFakeIPCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetBlocklistStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BlocklistStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlocklistStatsResponse)
+	err := c.cc.Invoke(ctx, Core_GetBlocklistStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) UpdateBlocklists(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Core_UpdateBlocklists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ImportRules(ctx context.Context, in *ImportRulesRequest, opts ...grpc.CallOption) (*ImportRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRulesResponse)
+	err := c.cc.Invoke(ctx, Core_ImportRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ExplainRoute(ctx context.Context, in *ExplainRouteRequest, opts ...grpc.CallOption) (*ExplainRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainRouteResponse)
+	err := c.cc.Invoke(ctx, Core_ExplainRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoreServer is the server API for Core service.
 // All implementations must embed UnimplementedCoreServer
 // for forward compatibility.
@@ -442,6 +721,27 @@ type CoreServer interface {
 	GetSystemProxyStatus(context.Context, *Empty) (*SystemProxyStatus, error)
 	SetSystemProxyEnabled(context.Context, *SetSystemProxyEnabledRequest) (*Response, error)
 	LogListener(*Empty, grpc.ServerStreamingServer[LogMessage]) error
+	StartProfiling(*ProfilingRequest, grpc.ServerStreamingServer[ProfileData]) error
+	StopProfiling(context.Context, *Empty) (*Response, error)
+	GetClashModes(context.Context, *Empty) (*ClashModeResponse, error)
+	SetClashMode(context.Context, *ClashModeRequest) (*Response, error)
+	ClashModeInfo(*Empty, grpc.ServerStreamingServer[ClashModeResponse]) error
+	ListWarpAccounts(context.Context, *Empty) (*WarpAccountList, error)
+	CreateWarpAccount(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error)
+	BindWarpLicense(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error)
+	RotateWarpKey(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error)
+	GetWarpAccountStatus(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error)
+	DeleteWarpAccount(context.Context, *WarpAccountRequest) (*Response, error)
+	ExportOutbounds(context.Context, *ExportOutboundsRequest) (*ExportOutboundsResponse, error)
+	CheckExit(context.Context, *CheckExitRequest) (*CheckExitResponse, error)
+	GetDNSStats(context.Context, *Empty) (*DNSStatsResponse, error)
+	DNSQueryLog(*Empty, grpc.ServerStreamingServer[DNSQueryEntry]) error
+	GetDNSQueryStats(context.Context, *Empty) (*DNSQueryStats, error)
+	ClearFakeIPCache(context.Context, *Empty) (*Response, error)
+	GetBlocklistStats(context.Context, *Empty) (*BlocklistStatsResponse, error)
+	UpdateBlocklists(context.Context, *Empty) (*Response, error)
+	ImportRules(context.Context, *ImportRulesRequest) (*ImportRulesResponse, error)
+	ExplainRoute(context.Context, *ExplainRouteRequest) (*ExplainRouteResponse, error)
 	mustEmbedUnimplementedCoreServer()
 }
 
@@ -503,6 +803,69 @@ func (UnimplementedCoreServer) SetSystemProxyEnabled(context.Context, *SetSystem
 func (UnimplementedCoreServer) LogListener(*Empty, grpc.ServerStreamingServer[LogMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method LogListener not implemented")
 }
+func (UnimplementedCoreServer) StartProfiling(*ProfilingRequest, grpc.ServerStreamingServer[ProfileData]) error {
+	return status.Errorf(codes.Unimplemented, "method StartProfiling not implemented")
+}
+func (UnimplementedCoreServer) StopProfiling(context.Context, *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopProfiling not implemented")
+}
+func (UnimplementedCoreServer) GetClashModes(context.Context, *Empty) (*ClashModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClashModes not implemented")
+}
+func (UnimplementedCoreServer) SetClashMode(context.Context, *ClashModeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClashMode not implemented")
+}
+func (UnimplementedCoreServer) ClashModeInfo(*Empty, grpc.ServerStreamingServer[ClashModeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ClashModeInfo not implemented")
+}
+func (UnimplementedCoreServer) ListWarpAccounts(context.Context, *Empty) (*WarpAccountList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarpAccounts not implemented")
+}
+func (UnimplementedCoreServer) CreateWarpAccount(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarpAccount not implemented")
+}
+func (UnimplementedCoreServer) BindWarpLicense(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindWarpLicense not implemented")
+}
+func (UnimplementedCoreServer) RotateWarpKey(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateWarpKey not implemented")
+}
+func (UnimplementedCoreServer) GetWarpAccountStatus(context.Context, *WarpAccountRequest) (*WarpAccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarpAccountStatus not implemented")
+}
+func (UnimplementedCoreServer) DeleteWarpAccount(context.Context, *WarpAccountRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWarpAccount not implemented")
+}
+func (UnimplementedCoreServer) ExportOutbounds(context.Context, *ExportOutboundsRequest) (*ExportOutboundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOutbounds not implemented")
+}
+func (UnimplementedCoreServer) CheckExit(context.Context, *CheckExitRequest) (*CheckExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckExit not implemented")
+}
+func (UnimplementedCoreServer) GetDNSStats(context.Context, *Empty) (*DNSStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSStats not implemented")
+}
+func (UnimplementedCoreServer) DNSQueryLog(*Empty, grpc.ServerStreamingServer[DNSQueryEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method DNSQueryLog not implemented")
+}
+func (UnimplementedCoreServer) GetDNSQueryStats(context.Context, *Empty) (*DNSQueryStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDNSQueryStats not implemented")
+}
+func (UnimplementedCoreServer) ClearFakeIPCache(context.Context, *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearFakeIPCache not implemented")
+}
+func (UnimplementedCoreServer) GetBlocklistStats(context.Context, *Empty) (*BlocklistStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocklistStats not implemented")
+}
+func (UnimplementedCoreServer) UpdateBlocklists(context.Context, *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlocklists not implemented")
+}
+func (UnimplementedCoreServer) ImportRules(context.Context, *ImportRulesRequest) (*ImportRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRules not implemented")
+}
+func (UnimplementedCoreServer) ExplainRoute(context.Context, *ExplainRouteRequest) (*ExplainRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainRoute not implemented")
+}
 func (UnimplementedCoreServer) mustEmbedUnimplementedCoreServer() {}
 func (UnimplementedCoreServer) testEmbeddedByValue()              {}
 
@@ -795,6 +1158,363 @@ func _Core_LogListener_Handler(srv interface{}, stream grpc.ServerStream) error
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Core_LogListenerServer = grpc.ServerStreamingServer[LogMessage]
 
+func _Core_StartProfiling_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfilingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).StartProfiling(m, &grpc.GenericServerStream[ProfilingRequest, ProfileData]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_StartProfilingServer = grpc.ServerStreamingServer[ProfileData]
+
+func _Core_StopProfiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).StopProfiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_StopProfiling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).StopProfiling(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetClashModes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetClashModes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetClashModes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetClashModes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_SetClashMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClashModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).SetClashMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_SetClashMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).SetClashMode(ctx, req.(*ClashModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ClashModeInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).ClashModeInfo(m, &grpc.GenericServerStream[Empty, ClashModeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_ClashModeInfoServer = grpc.ServerStreamingServer[ClashModeResponse]
+
+func _Core_ListWarpAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListWarpAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListWarpAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListWarpAccounts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_CreateWarpAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateWarpAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateWarpAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateWarpAccount(ctx, req.(*WarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_BindWarpLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).BindWarpLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_BindWarpLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).BindWarpLicense(ctx, req.(*WarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RotateWarpKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RotateWarpKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RotateWarpKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RotateWarpKey(ctx, req.(*WarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetWarpAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetWarpAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetWarpAccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetWarpAccountStatus(ctx, req.(*WarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DeleteWarpAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).DeleteWarpAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_DeleteWarpAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).DeleteWarpAccount(ctx, req.(*WarpAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ExportOutbounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOutboundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ExportOutbounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ExportOutbounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ExportOutbounds(ctx, req.(*ExportOutboundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_CheckExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CheckExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CheckExit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CheckExit(ctx, req.(*CheckExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetDNSStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDNSStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDNSStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDNSStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_DNSQueryLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).DNSQueryLog(m, &grpc.GenericServerStream[Empty, DNSQueryEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_DNSQueryLogServer = grpc.ServerStreamingServer[DNSQueryEntry]
+
+func _Core_GetDNSQueryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDNSQueryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDNSQueryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDNSQueryStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ClearFakeIPCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ClearFakeIPCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ClearFakeIPCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ClearFakeIPCache(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetBlocklistStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetBlocklistStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetBlocklistStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetBlocklistStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_UpdateBlocklists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).UpdateBlocklists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_UpdateBlocklists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).UpdateBlocklists(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ImportRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ImportRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ImportRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ImportRules(ctx, req.(*ImportRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ExplainRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ExplainRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ExplainRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ExplainRoute(ctx, req.(*ExplainRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Core_ServiceDesc is the grpc.ServiceDesc for Core service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -850,6 +1570,78 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSystemProxyEnabled",
 			Handler:    _Core_SetSystemProxyEnabled_Handler,
 		},
+		{
+			MethodName: "StopProfiling",
+			Handler:    _Core_StopProfiling_Handler,
+		},
+		{
+			MethodName: "GetClashModes",
+			Handler:    _Core_GetClashModes_Handler,
+		},
+		{
+			MethodName: "SetClashMode",
+			Handler:    _Core_SetClashMode_Handler,
+		},
+		{
+			MethodName: "ListWarpAccounts",
+			Handler:    _Core_ListWarpAccounts_Handler,
+		},
+		{
+			MethodName: "CreateWarpAccount",
+			Handler:    _Core_CreateWarpAccount_Handler,
+		},
+		{
+			MethodName: "BindWarpLicense",
+			Handler:    _Core_BindWarpLicense_Handler,
+		},
+		{
+			MethodName: "RotateWarpKey",
+			Handler:    _Core_RotateWarpKey_Handler,
+		},
+		{
+			MethodName: "GetWarpAccountStatus",
+			Handler:    _Core_GetWarpAccountStatus_Handler,
+		},
+		{
+			MethodName: "DeleteWarpAccount",
+			Handler:    _Core_DeleteWarpAccount_Handler,
+		},
+		{
+			MethodName: "ExportOutbounds",
+			Handler:    _Core_ExportOutbounds_Handler,
+		},
+		{
+			MethodName: "CheckExit",
+			Handler:    _Core_CheckExit_Handler,
+		},
+		{
+			MethodName: "GetDNSStats",
+			Handler:    _Core_GetDNSStats_Handler,
+		},
+		{
+			MethodName: "GetDNSQueryStats",
+			Handler:    _Core_GetDNSQueryStats_Handler,
+		},
+		{
+			MethodName: "ClearFakeIPCache",
+			Handler:    _Core_ClearFakeIPCache_Handler,
+		},
+		{
+			MethodName: "GetBlocklistStats",
+			Handler:    _Core_GetBlocklistStats_Handler,
+		},
+		{
+			MethodName: "UpdateBlocklists",
+			Handler:    _Core_UpdateBlocklists_Handler,
+		},
+		{
+			MethodName: "ImportRules",
+			Handler:    _Core_ImportRules_Handler,
+		},
+		{
+			MethodName: "ExplainRoute",
+			Handler:    _Core_ExplainRoute_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -877,6 +1669,21 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Core_LogListener_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StartProfiling",
+			Handler:       _Core_StartProfiling_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ClashModeInfo",
+			Handler:       _Core_ClashModeInfo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DNSQueryLog",
+			Handler:       _Core_DNSQueryLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rostovvpn.proto",
 }
@@ -0,0 +1,143 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/Darkmen203/rostovvpn-core/protocol/blocklist"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+// как часто апдейтер смотрит, не устарел ли какой-нибудь список
+const blocklistCheckInterval = 10 * time.Minute
+
+var (
+	blocklistUpdaterAccess sync.Mutex
+	blocklistUpdaterCancel context.CancelFunc
+)
+
+// updatableBlocklists — включённые списки в .srs: inline обновляются только
+// пересборкой конфига.
+func updatableBlocklists(lists []config.BlocklistOptions) []config.BlocklistOptions {
+	var result []config.BlocklistOptions
+	for _, list := range lists {
+		if list.Enable && !config.BlocklistInline(list) {
+			result = append(result, list)
+		}
+	}
+	return result
+}
+
+// startBlocklistUpdater перекачивает устаревшие списки, пока ядро запущено.
+// Новый .srs sing-box подхватывает сам.
+func startBlocklistUpdater(lists []config.BlocklistOptions) {
+	stopBlocklistUpdater()
+	lists = updatableBlocklists(lists)
+	if len(lists) == 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	blocklistUpdaterAccess.Lock()
+	blocklistUpdaterCancel = cancel
+	blocklistUpdaterAccess.Unlock()
+	go func() {
+		ticker := time.NewTicker(blocklistCheckInterval)
+		defer ticker.Stop()
+		for {
+			for _, list := range lists {
+				if ctx.Err() != nil {
+					return
+				}
+				if !config.BlocklistDue(list) {
+					continue
+				}
+				if err := config.UpdateBlocklist(ctx, list); err != nil && ctx.Err() == nil {
+					Log(pb.LogLevel_WARNING, pb.LogType_CORE, fmt.Sprint("update blocklist ", list.Name, ": ", err))
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func stopBlocklistUpdater() {
+	blocklistUpdaterAccess.Lock()
+	defer blocklistUpdaterAccess.Unlock()
+	if blocklistUpdaterCancel != nil {
+		blocklistUpdaterCancel()
+		blocklistUpdaterCancel = nil
+	}
+}
+
+func (s *CoreService) GetBlocklistStats(ctx context.Context, in *pb.Empty) (*pb.BlocklistStatsResponse, error) {
+	return GetBlocklistStats(in)
+}
+
+// GetBlocklistStats — свои списки блокировки последней сборки конфига
+// и сколько раз каждый сработал с запуска ядра.
+func GetBlocklistStats(in *pb.Empty) (*pb.BlocklistStatsResponse, error) {
+	var lists []*pb.BlocklistStats
+	for _, status := range config.Blocklists() {
+		hits := blocklist.Hits(config.BlocklistTag(status.Name))
+		item := &pb.BlocklistStats{
+			Name:           status.Name,
+			Source:         status.Source,
+			Enabled:        status.Enabled,
+			Entries:        int32(status.Entries),
+			Skipped:        int32(status.Skipped),
+			LastError:      status.LastError,
+			DnsHits:        hits.DNS,
+			ConnectionHits: hits.Connections,
+		}
+		if !status.UpdatedAt.IsZero() {
+			item.UpdatedAt = status.UpdatedAt.Unix()
+		}
+		if !hits.LastHit.IsZero() {
+			item.LastHit = hits.LastHit.Unix()
+		}
+		lists = append(lists, item)
+	}
+	return &pb.BlocklistStatsResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Lists:        lists,
+	}, nil
+}
+
+func (s *CoreService) UpdateBlocklists(ctx context.Context, in *pb.Empty) (*pb.Response, error) {
+	return UpdateBlocklists(in)
+}
+
+// UpdateBlocklists перекачивает все включённые списки, не дожидаясь расписания.
+func UpdateBlocklists(in *pb.Empty) (*pb.Response, error) {
+	if RostovVPNOptions == nil {
+		err := fmt.Errorf("settings are not set")
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	var errs []error
+	for _, list := range updatableBlocklists(RostovVPNOptions.Blocklists) {
+		if err := config.UpdateBlocklist(context.Background(), list); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", list.Name, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
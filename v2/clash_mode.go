@@ -0,0 +1,124 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/Darkmen203/rostovvpn-core/v2/db"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing/service"
+)
+
+const clashModeStateId = "current"
+
+// ClashModeState хранит выбранный режим между перезапусками ядра.
+type ClashModeState struct {
+	Id   string
+	Mode string
+}
+
+func (s *CoreService) GetClashModes(ctx context.Context, in *pb.Empty) (*pb.ClashModeResponse, error) {
+	return GetClashModes()
+}
+
+func GetClashModes() (*pb.ClashModeResponse, error) {
+	if clashServer := currentClashServer(); clashServer != nil {
+		return &pb.ClashModeResponse{
+			Modes:   clashServer.ModeList(),
+			Current: clashServer.Mode(),
+		}, nil
+	}
+	return &pb.ClashModeResponse{
+		Modes:   config.ClashModes,
+		Current: storedClashMode(),
+	}, nil
+}
+
+func (s *CoreService) SetClashMode(ctx context.Context, in *pb.ClashModeRequest) (*pb.Response, error) {
+	return SetClashMode(in)
+}
+
+func SetClashMode(in *pb.ClashModeRequest) (*pb.Response, error) {
+	mode := config.NormalizeClashMode(in.Mode)
+	if !strings.EqualFold(mode, strings.TrimSpace(in.Mode)) {
+		err := fmt.Errorf("unknown clash mode: %s", in.Mode)
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	if err := saveClashMode(mode); err != nil {
+		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "save clash mode: "+err.Error())
+	}
+	if err := applyClashMode(mode); err != nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
+
+// applyClashMode переключает режим работающего ядра; без запущенного ядра
+// режим просто применится при следующей сборке конфига.
+func applyClashMode(mode string) error {
+	if RostovVPNOptions == nil {
+		RostovVPNOptions = config.DefaultRostovVPNOptions()
+	}
+	RostovVPNOptions.ClashMode = mode
+	clashServer := currentClashServer()
+	if clashServer == nil {
+		if Box != nil {
+			return fmt.Errorf("clash server is off, enable enable-clash-api or clash-mode-switch")
+		}
+		return nil
+	}
+	if strings.EqualFold(clashServer.Mode(), mode) {
+		return nil
+	}
+	setter, ok := clashServer.(interface{ SetMode(string) })
+	if !ok {
+		return fmt.Errorf("clash server %T does not support mode switching", clashServer)
+	}
+	setter.SetMode(mode)
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, "Clash mode: "+mode)
+	return nil
+}
+
+// restoreClashMode подставляет сохранённый режим в настройки перед сборкой конфига.
+func restoreClashMode() {
+	if RostovVPNOptions == nil {
+		return
+	}
+	if state, err := db.GetTable[ClashModeState]().Get(clashModeStateId); err == nil && state.Mode != "" {
+		RostovVPNOptions.ClashMode = config.NormalizeClashMode(state.Mode)
+	}
+}
+
+func storedClashMode() string {
+	if state, err := db.GetTable[ClashModeState]().Get(clashModeStateId); err == nil && state.Mode != "" {
+		return config.NormalizeClashMode(state.Mode)
+	}
+	if RostovVPNOptions != nil {
+		return config.NormalizeClashMode(RostovVPNOptions.ClashMode)
+	}
+	return config.ClashModeRule
+}
+
+func saveClashMode(mode string) error {
+	return db.GetTable[ClashModeState]().UpdateInsert(&ClashModeState{Id: clashModeStateId, Mode: mode})
+}
+
+func currentClashServer() adapter.ClashServer {
+	ctx := boxServiceContext(Box)
+	if ctx == nil {
+		return nil
+	}
+	return service.FromContext[adapter.ClashServer](ctx)
+}
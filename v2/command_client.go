@@ -12,6 +12,7 @@ import (
 	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
 	"github.com/sagernet/sing-box/experimental/libbox"
 	"github.com/sagernet/sing-box/log"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type ffiHandler struct {
@@ -20,10 +21,12 @@ type ffiHandler struct {
 }
 
 type groupItemJSON struct {
-	Tag          string `json:"tag"`
-	Type         string `json:"type"`
-	URLTestTime  int64  `json:"url-test-time"`
-	URLTestDelay int32  `json:"url-test-delay"`
+	Tag          string       `json:"tag"`
+	Type         string       `json:"type"`
+	URLTestTime  int64        `json:"url-test-time"`
+	URLTestDelay int32        `json:"url-test-delay"`
+	Country      string       `json:"country,omitempty"`
+	Exit         *pb.ExitInfo `json:"exit,omitempty"`
 }
 
 type groupJSON struct {
@@ -135,10 +138,31 @@ func (h *ffiHandler) Disconnected(message string) {
 	h.logger.Debug("[cmd] DISCONNECTED: ", message)
 }
 func (h *ffiHandler) ClearLogs() { /* no-op to UI */ }
-func (h *ffiHandler) InitializeClashMode(_ libbox.StringIterator, cur string) {
+
+// Режим clash: шлём во Flutter список режимов и текущий режим
+func (h *ffiHandler) InitializeClashMode(it libbox.StringIterator, cur string) {
 	h.logger.Debug("[cmd] clash mode: ", cur)
+	var modes []string
+	for it != nil && it.HasNext() {
+		modes = append(modes, it.Next())
+	}
+	h.sendClashMode(modes, cur)
+}
+
+func (h *ffiHandler) UpdateClashMode(newMode string) {
+	h.logger.Debug("[cmd] clash mode -> ", newMode)
+	h.sendClashMode(nil, newMode)
+}
+
+func (h *ffiHandler) sendClashMode(modes []string, cur string) {
+	if RostovVPNOptions != nil && cur != "" {
+		RostovVPNOptions.ClashMode = cur
+	}
+	mode := &pb.ClashModeResponse{Modes: modes, Current: cur}
+	b, _ := protojson.Marshal(mode)
+	bridge.SendStringToPort(h.port, string(b))
+	clashModeObserver.Emit(*mode)
 }
-func (h *ffiHandler) UpdateClashMode(newMode string) { h.logger.Debug("[cmd] clash mode -> ", newMode) }
 
 // Логи: просто построчно прокидываем в Flutter
 func (h *ffiHandler) WriteLogs(it libbox.StringIterator) {
@@ -181,6 +205,8 @@ func (h *ffiHandler) WriteGroups(it libbox.OutboundGroupIterator) {
 				Type:         o.Type,
 				URLTestTime:  o.URLTestTime,
 				URLTestDelay: o.URLTestDelay,
+				Country:      outboundCountry(o.Tag),
+				Exit:         exitInfo(o.Tag),
 			})
 		}
 		groups = append(groups, groupJSON{
@@ -210,6 +236,8 @@ func toProtoGroups(gs []groupJSON) pb.OutboundGroupList {
 				Type:         it.Type,
 				UrlTestTime:  it.URLTestTime,
 				UrlTestDelay: it.URLTestDelay,
+				Country:      it.Country,
+				Exit:         it.Exit,
 			})
 		}
 		out.Items = append(out.Items, &pb.OutboundGroup{
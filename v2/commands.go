@@ -6,6 +6,7 @@ import (
 
 	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
 	"github.com/sagernet/sing-box/experimental/libbox"
+	"github.com/sagernet/sing-box/log"
 	"google.golang.org/grpc"
 )
 
@@ -13,12 +14,14 @@ var (
 	systemInfoObserver        = NewObserver[pb.SystemInfo](10)
 	outboundsInfoObserver     = NewObserver[pb.OutboundGroupList](10)
 	mainOutboundsInfoObserver = NewObserver[pb.OutboundGroupList](10)
+	clashModeObserver         = NewObserver[pb.ClashModeResponse](10)
 )
 
 var (
 	statusClient        *libbox.CommandClient
 	groupClient         *libbox.CommandClient
 	groupInfoOnlyClient *libbox.CommandClient
+	clashModeClient     *libbox.CommandClient
 )
 
 func (s *CoreService) GetSystemInfo(req *pb.Empty, stream grpc.ServerStreamingServer[pb.SystemInfo]) error {
@@ -117,6 +120,37 @@ func (s *CoreService) MainOutboundsInfo(req *pb.Empty, stream grpc.ServerStreami
 	}
 }
 
+func (s *CoreService) ClashModeInfo(req *pb.Empty, stream grpc.ServerStreamingServer[pb.ClashModeResponse]) error {
+	if clashModeClient == nil {
+		clashModeClient = libbox.NewCommandClient(
+			newFFIHandler(0, log.NewNOPFactory().Logger()),
+			&libbox.CommandClientOptions{
+				Command: libbox.CommandClashMode,
+			},
+		)
+
+		defer func() {
+			clashModeClient.Disconnect()
+			clashModeClient = nil
+		}()
+		clashModeClient.Connect()
+	}
+
+	sub, done, _ := clashModeObserver.Subscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-done:
+			return nil
+		case mode := <-sub:
+			stream.Send(&mode)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
 func (s *CoreService) SelectOutbound(ctx context.Context, in *pb.SelectOutboundRequest) (*pb.Response, error) {
 	return SelectOutbound(in)
 }
@@ -129,6 +163,7 @@ func SelectOutbound(in *pb.SelectOutboundRequest) (*pb.Response, error) {
 			Message:      err.Error(),
 		}, err
 	}
+	rememberSelectedOutbound(in.GroupTag, in.OutboundTag)
 
 	return &pb.Response{
 		ResponseCode: pb.ResponseCode_OK,
@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/Darkmen203/rostovvpn-core/protocol/balancer"
+	"github.com/Darkmen203/rostovvpn-core/protocol/blocklist"
+	"github.com/Darkmen203/rostovvpn-core/protocol/dnsgroup"
+	"github.com/Darkmen203/rostovvpn-core/protocol/fakeip"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/adapter/outbound"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing/service"
+)
+
+func init() {
+	config.RegisterTypes(registerTypes)
+}
+
+// registerTypes добавляет в реестры контекста собственные типы ядра.
+func registerTypes(ctx context.Context) {
+	if registry, ok := service.FromContext[adapter.OutboundRegistry](ctx).(*outbound.Registry); ok {
+		balancer.Register(registry)
+		blocklist.RegisterOutbound(registry)
+	}
+	if registry, ok := service.FromContext[adapter.DNSTransportRegistry](ctx).(*dns.TransportRegistry); ok {
+		dnsgroup.Register(registry)
+		fakeip.Register(registry)
+		blocklist.RegisterTransport(registry)
+	}
+}
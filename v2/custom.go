@@ -8,6 +8,8 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unsafe"
 
@@ -32,6 +34,19 @@ var (
 	useFlutterBridge bool = true
 )
 
+// activeBuild — собранный конфиг запущенного ядра и отчёт о сборке для
+// ExplainRoute; меняются одним указателем, чтобы читатель видел согласованную пару.
+type activeBuild struct {
+	options *option.Options
+	report  *config.BuildReport
+
+	// страны outbound'ов для UI: из сборки и досчитанные после старта
+	countryAccess sync.RWMutex
+	countries     map[string]string
+}
+
+var currentBuild atomic.Pointer[activeBuild]
+
 func StopAndAlert(msgType pb.MessageType, message string) {
 	SetCoreStatus(pb.CoreState_STOPPED, msgType, message)
 	config.DeactivateTunnelService()
@@ -39,7 +54,9 @@ func StopAndAlert(msgType pb.MessageType, message string) {
 		oldCommandServer.SetService(nil)
 
 	}
+	stopOutboundStateWatcher()
 	if Box != nil {
+		saveOutboundState(Box)
 		Box.Close()
 		Box = nil
 	}
@@ -84,6 +101,11 @@ func (s *CoreService) StartService(ctx context.Context, in *pb.StartRequest) (*p
 
 func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Starting Core Service")
+	profile := outboundStateId(in.ProfileId, in.ConfigPath)
+	outboundStateAccess.Lock()
+	outboundStateProfile = profile
+	outboundStateAccess.Unlock()
+	resetExitChecks(profile)
 	content := in.ConfigContent
 	if content == "" {
 
@@ -108,12 +130,14 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 		StopAndAlert(pb.MessageType_UNEXPECTED_ERROR, err.Error())
 		return resp, err
 	}
+	var buildReport *config.BuildReport
 	if !in.EnableRawConfig {
 		Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Building config")
 		if RostovVPNOptions == nil {
 			RostovVPNOptions = config.DefaultRostovVPNOptions()
 		}
-		parsedContent_tmp, err := config.BuildConfig(*RostovVPNOptions, parsedContent)
+		restoreClashMode()
+		parsedContent_tmp, report, err := config.BuildConfigWithReport(*RostovVPNOptions, parsedContent)
 		if err != nil {
 			Log(pb.LogLevel_FATAL, pb.LogType_CORE, err.Error())
 			resp := SetCoreStatus(pb.CoreState_STOPPED, pb.MessageType_ERROR_BUILDING_CONFIG, err.Error())
@@ -121,6 +145,8 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 			return resp, err
 		}
 		parsedContent = *parsedContent_tmp
+		buildReport = report
+		restoreSelectedOutbounds(profile, &parsedContent)
 	}
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Saving config")
 	currentBuildConfigPath := filepath.Join(sWorkingPath, "current-config.json")
@@ -148,6 +174,7 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 		return resp, err
 	}
 	Log(pb.LogLevel_DEBUG, pb.LogType_CORE, "Service.. started")
+	restoreURLTestHistory(profile, instance)
 	if in.DelayStart {
 		<-time.After(250 * time.Millisecond)
 	}
@@ -160,6 +187,20 @@ func StartService(in *pb.StartRequest) (*pb.CoreInfoResponse, error) {
 		return resp, err
 	}
 	Box = instance
+	build := newActiveBuild(&parsedContent, buildReport)
+	currentBuild.Store(build)
+	if RostovVPNOptions != nil {
+		go build.resolveCountries(RostovVPNOptions.GeoIPPath)
+	}
+	applySelectedOutbounds(profile, instance)
+	startOutboundStateWatcher(instance)
+	if !in.EnableRawConfig {
+		// cache file мог вернуть старый режим — приводим к сохранённому
+		if err := applyClashMode(RostovVPNOptions.ClashMode); err != nil {
+			Log(pb.LogLevel_WARNING, pb.LogType_CORE, err.Error())
+		}
+		startBlocklistUpdater(RostovVPNOptions.Blocklists)
+	}
 	if in.EnableOldCommandServer {
 		if primeClashServerAfterStart(Box, int(RostovVPNOptions.ClashApiPort)) {
 			Log(pb.LogLevel_INFO, pb.LogType_CORE, "Binding CommandServer to BoxService")
@@ -194,11 +235,30 @@ func Parse(in *pb.ParseRequest) (*pb.ParseResponse, error) {
 
 	}
 
-	config, err := config.ParseConfigContent(content, true, RostovVPNOptions, false)
+	parseOptions := RostovVPNOptions
+	if in.ProfileFilterJson != "" {
+		var filter config.ProfileFilterOptions
+		if err := json.Unmarshal([]byte(in.ProfileFilterJson), &filter); err != nil {
+			return &pb.ParseResponse{
+				ResponseCode: pb.ResponseCode_FAILED,
+				Message:      "invalid profile filter: " + err.Error(),
+			}, err
+		}
+		// фильтр действует только на этот профиль, общие настройки не трогаем
+		profileOptions := *config.DefaultRostovVPNOptions()
+		if RostovVPNOptions != nil {
+			profileOptions = *RostovVPNOptions
+		}
+		profileOptions.ProfileFilter = filter
+		parseOptions = &profileOptions
+	}
+
+	config, report, err := config.ParseConfigContentWithReport(content, true, parseOptions, false)
 	if err != nil {
 		return &pb.ParseResponse{
 			ResponseCode: pb.ResponseCode_FAILED,
 			Message:      err.Error(),
+			Report:       parseReportToPb(report),
 		}, err
 	}
 	if in.ConfigPath != "" {
@@ -207,6 +267,7 @@ func Parse(in *pb.ParseRequest) (*pb.ParseResponse, error) {
 			return &pb.ParseResponse{
 				ResponseCode: pb.ResponseCode_FAILED,
 				Message:      err.Error(),
+				Report:       parseReportToPb(report),
 			}, err
 		}
 	}
@@ -214,9 +275,39 @@ func Parse(in *pb.ParseRequest) (*pb.ParseResponse, error) {
 		ResponseCode: pb.ResponseCode_OK,
 		Content:      string(config),
 		Message:      "",
+		Report:       parseReportToPb(report),
 	}, err
 }
 
+func parseReportToPb(report *config.ParseReport) *pb.ParseReport {
+	if report == nil {
+		return nil
+	}
+	rules, _ := ruleImportToPb(report.Rules)
+	return &pb.ParseReport{
+		Format:   report.Format,
+		Accepted: parseEntriesToPb(report.Accepted),
+		Rejected: parseEntriesToPb(report.Rejected),
+		Filtered: parseEntriesToPb(report.Filtered),
+		Warnings: parseEntriesToPb(report.Warnings),
+		Rules:    rules,
+	}
+}
+
+func parseEntriesToPb(entries []config.ParseEntry) []*pb.ParseEntry {
+	res := make([]*pb.ParseEntry, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, &pb.ParseEntry{
+			Line:    int32(entry.Line),
+			Tag:     entry.Tag,
+			Type:    entry.Type,
+			Content: entry.Content,
+			Reason:  entry.Reason,
+		})
+	}
+	return res
+}
+
 func (s *CoreService) ChangeRostovVPNSettings(ctx context.Context, in *pb.ChangeRostovVPNSettingsRequest) (*pb.CoreInfoResponse, error) {
 	return ChangeRostovVPNSettings(in)
 }
@@ -253,7 +344,7 @@ func GenerateConfig(in *pb.GenerateConfigRequest) (*pb.GenerateConfigResponse, e
 
 // parseOptionsStrict парсит конфиг sing-box в строго типизированный option.Options.
 func parseOptionsStrict(content string) (option.Options, error) {
-	ctx := libbox.BaseContext(nil)
+	ctx := config.BaseContext()
 	// UnmarshalExtendedContext возвращает (T, error)
 	return singjson.UnmarshalExtendedContext[option.Options](ctx, []byte(content))
 }
@@ -302,10 +393,13 @@ func Stop() (*pb.CoreInfoResponse, error) {
 	}
 	SetCoreStatus(pb.CoreState_STOPPING, pb.MessageType_EMPTY, "")
 	config.DeactivateTunnelService()
+	stopBlocklistUpdater()
 	if oldCommandServer != nil {
 		oldCommandServer.SetService(nil)
 
 	}
+	stopOutboundStateWatcher()
+	saveOutboundState(Box)
 
 	err := Box.Close()
 	if err != nil {
@@ -316,6 +410,7 @@ func Stop() (*pb.CoreInfoResponse, error) {
 		}, fmt.Errorf("Error while stopping the service.")
 	}
 	Box = nil
+	currentBuild.Store(nil)
 	if oldCommandServer != nil {
 		err = oldCommandServer.Close()
 		if err != nil {
@@ -387,23 +482,34 @@ func probeClashTCP(addr string, timeout time.Duration) bool {
 	return true
 }
 
-// после svc.Start(): подождём ClashServer в ctx с ретраями и логом
-func primeClashServerAfterStart(svc *libbox.BoxService, port int) bool {
+// boxServiceContext читает приватное поле ctx у BoxService через unsafe.
+func boxServiceContext(svc *libbox.BoxService) context.Context {
+	if svc == nil {
+		return nil
+	}
 	rv := reflect.ValueOf(svc).Elem()
-
-	// 1) ctx: читаем приватное поле через unsafe
 	ctxField := rv.FieldByName("ctx")
 	if !ctxField.IsValid() {
 		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "BoxService has no ctx field")
-		return false
+		return nil
 	}
-	// достаём значение приватного поля
 	ctxPtr := unsafe.Pointer(ctxField.UnsafeAddr())
 	ctxVal := reflect.NewAt(ctxField.Type(), ctxPtr).Elem()
-	ctxIface := ctxVal.Interface()
-	ctx, ok := ctxIface.(context.Context)
+	ctx, ok := ctxVal.Interface().(context.Context)
 	if !ok || ctx == nil {
 		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "BoxService ctx invalid")
+		return nil
+	}
+	return ctx
+}
+
+// после svc.Start(): подождём ClashServer в ctx с ретраями и логом
+func primeClashServerAfterStart(svc *libbox.BoxService, port int) bool {
+	rv := reflect.ValueOf(svc).Elem()
+
+	// 1) ctx: читаем приватное поле через unsafe
+	ctx := boxServiceContext(svc)
+	if ctx == nil {
 		return false
 	}
 
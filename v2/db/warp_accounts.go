@@ -0,0 +1,59 @@
+package db
+
+import (
+	"sort"
+	"time"
+)
+
+// WarpAccountRecord is a stored Warp identity. Id is the account name that
+// warp://<name> references resolve to (p1, p2, ... or any custom name).
+type WarpAccountRecord struct {
+	Id          string
+	License     string
+	AccountID   string
+	AccessToken string
+
+	PrivateKey       string
+	PeerPublicKey    string
+	LocalAddressIPv4 string
+	LocalAddressIPv6 string
+	ClientID         string
+
+	AccountType string
+	WarpPlus    bool
+	Quota       int64
+	Usage       int64
+	PremiumData int64
+
+	Created time.Time
+	Updated time.Time
+}
+
+// WarpAccounts returns the Warp account table.
+func WarpAccounts() *Table[WarpAccountRecord] {
+	return GetTable[WarpAccountRecord]()
+}
+
+// GetWarpAccount returns the account by name, or nil if it is not stored.
+func GetWarpAccount(name string) *WarpAccountRecord {
+	if name == "" {
+		return nil
+	}
+	account, err := WarpAccounts().Get(name)
+	if err != nil || account == nil || account.Id == "" {
+		return nil
+	}
+	return account
+}
+
+// ListWarpAccounts returns all stored accounts sorted by name.
+func ListWarpAccounts() ([]*WarpAccountRecord, error) {
+	accounts, err := WarpAccounts().All()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Id < accounts[j].Id
+	})
+	return accounts, nil
+}
@@ -0,0 +1,477 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	R "github.com/sagernet/sing-box/route/rule"
+	M "github.com/sagernet/sing/common/metadata"
+	"github.com/sagernet/sing/service"
+	"google.golang.org/grpc"
+
+	mDNS "github.com/miekg/dns"
+)
+
+const (
+	dnsQueryHistorySize = 1000
+	dnsQueryTopDomains  = 20
+)
+
+var (
+	dnsQueryObserver = NewObserver[*pb.DNSQueryEntry](64)
+	dnsQueryLog      = &dnsQueryRecorder{}
+)
+
+// dnsQueryRecorder — журнал DNS-запросов. Запросы к DNS-роутеру ядра видит
+// его обёртка (recordingDNSRouter), DNS-сервер, который ответил, — обёртки
+// серверов, правило ищется по DNS-правилам конфига. Запросы пишутся, только
+// пока открыт хотя бы один поток DNSQueryLog.
+type dnsQueryRecorder struct {
+	listeners atomic.Int32
+	access    sync.Mutex
+	history   []*pb.DNSQueryEntry
+	next      int
+}
+
+func (w *dnsQueryRecorder) listen() {
+	w.listeners.Add(1)
+}
+
+func (w *dnsQueryRecorder) unlisten() {
+	w.listeners.Add(-1)
+}
+
+func (w *dnsQueryRecorder) enabled() bool {
+	return w.listeners.Load() > 0
+}
+
+func (w *dnsQueryRecorder) record(entry *pb.DNSQueryEntry) {
+	w.access.Lock()
+	if len(w.history) < dnsQueryHistorySize {
+		w.history = append(w.history, entry)
+	} else {
+		w.history[w.next] = entry
+		w.next = (w.next + 1) % dnsQueryHistorySize
+	}
+	w.access.Unlock()
+	dnsQueryObserver.Emit(entry)
+}
+
+// dnsRecordingRegistry — реестр сервисов экземпляра sing-box. box.New берёт
+// из него фабрику DNS-серверов и кладёт в него DNS-роутер; обе подменяются
+// обёртками, которые пишут журнал. Роутер маршрутизации и остальные сервисы
+// получают роутер из реестра, поэтому все запросы проходят через обёртку.
+type dnsRecordingRegistry struct {
+	service.Registry
+	ctx   context.Context
+	rules []option.DNSRule
+}
+
+// withDNSQueryLog ставит dnsRecordingRegistry поверх реестра ctx.
+func withDNSQueryLog(ctx context.Context, options option.Options) context.Context {
+	registry := &dnsRecordingRegistry{Registry: service.RegistryFromContext(ctx)}
+	if options.DNS != nil {
+		registry.rules = options.DNS.Rules
+	}
+	ctx = service.ContextWithRegistry(ctx, registry)
+	registry.ctx = ctx
+	return ctx
+}
+
+func (r *dnsRecordingRegistry) Register(serviceType any, value any) any {
+	if _, isDNSRouter := serviceType.(*adapter.DNSRouter); isDNSRouter {
+		if router, loaded := value.(adapter.DNSRouter); loaded {
+			value = adapter.DNSRouter(&recordingDNSRouter{DNSRouter: router, ctx: r.ctx, rules: r.rules})
+		}
+	}
+	return r.Registry.Register(serviceType, value)
+}
+
+func (r *dnsRecordingRegistry) Get(serviceType any) any {
+	value := r.Registry.Get(serviceType)
+	if _, isTransportRegistry := serviceType.(*adapter.DNSTransportRegistry); isTransportRegistry {
+		if registry, loaded := value.(adapter.DNSTransportRegistry); loaded {
+			return adapter.DNSTransportRegistry(recordingDNSTransportRegistry{registry})
+		}
+	}
+	return value
+}
+
+type recordingDNSTransportRegistry struct {
+	adapter.DNSTransportRegistry
+}
+
+// CreateDNSTransport оборачивает сервер. Обёртка сохраняет интерфейсы, которые
+// sing-box достаёт из серверов приведением типа: стратегию (legacy-опции) и
+// хранилище fakeip.
+func (r recordingDNSTransportRegistry) CreateDNSTransport(ctx context.Context, logger log.ContextLogger, tag string, transportType string, options any) (adapter.DNSTransport, error) {
+	transport, err := r.DNSTransportRegistry.CreateDNSTransport(ctx, logger, tag, transportType, options)
+	if err != nil {
+		return nil, err
+	}
+	recording := &recordingDNSTransport{DNSTransport: transport}
+	if fakeIP, isFakeIP := transport.(adapter.FakeIPTransport); isFakeIP {
+		return &recordingFakeIPTransport{recordingDNSTransport: recording, store: fakeIP}, nil
+	}
+	return recording, nil
+}
+
+type recordingDNSTransport struct {
+	adapter.DNSTransport
+}
+
+func (t *recordingDNSTransport) Exchange(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+	traceDNSExchange(ctx, t.Tag())
+	return t.DNSTransport.Exchange(ctx, message)
+}
+
+func (t *recordingDNSTransport) LegacyStrategy() C.DomainStrategy {
+	if legacy, isLegacy := t.DNSTransport.(adapter.LegacyDNSTransport); isLegacy {
+		return legacy.LegacyStrategy()
+	}
+	return C.DomainStrategyAsIS
+}
+
+func (t *recordingDNSTransport) LegacyClientSubnet() netip.Prefix {
+	if legacy, isLegacy := t.DNSTransport.(adapter.LegacyDNSTransport); isLegacy {
+		return legacy.LegacyClientSubnet()
+	}
+	return netip.Prefix{}
+}
+
+type recordingFakeIPTransport struct {
+	*recordingDNSTransport
+	store adapter.FakeIPTransport
+}
+
+func (t *recordingFakeIPTransport) Store() adapter.FakeIPStore {
+	return t.store.Store()
+}
+
+// dnsQueryTrace — сервер, до которого дошёл записываемый запрос. Первым
+// отмечается сервер из правила: группа DNS-серверов зовёт свои upstream'ы
+// уже изнутри.
+type dnsQueryTrace struct {
+	access   sync.Mutex
+	upstream string
+}
+
+type dnsQueryTraceKey struct{}
+
+func traceDNSExchange(ctx context.Context, tag string) {
+	trace, loaded := ctx.Value(dnsQueryTraceKey{}).(*dnsQueryTrace)
+	if !loaded {
+		return
+	}
+	trace.access.Lock()
+	defer trace.access.Unlock()
+	if trace.upstream == "" {
+		trace.upstream = tag
+	}
+}
+
+func (t *dnsQueryTrace) exchanged() string {
+	t.access.Lock()
+	defer t.access.Unlock()
+	return t.upstream
+}
+
+type recordingDNSRouter struct {
+	adapter.DNSRouter
+	ctx         context.Context
+	rules       []option.DNSRule
+	matcherOnce sync.Once
+	matcher     *config.DNSRuleMatcher
+}
+
+// dnsRuleMatcher собирается при первом записанном запросе: к этому моменту
+// роутер маршрутизации и его rule-set'ы уже созданы.
+func (r *recordingDNSRouter) dnsRuleMatcher() *config.DNSRuleMatcher {
+	r.matcherOnce.Do(func() {
+		matcher, err := config.NewDNSRuleMatcher(r.ctx, r.rules)
+		if err != nil {
+			log.Warn("dns query log: ", err)
+			return
+		}
+		r.matcher = matcher
+	})
+	return r.matcher
+}
+
+func (r *recordingDNSRouter) Exchange(ctx context.Context, message *mDNS.Msg, options adapter.DNSQueryOptions) (*mDNS.Msg, error) {
+	if !dnsQueryLog.enabled() || len(message.Question) != 1 {
+		return r.DNSRouter.Exchange(ctx, message, options)
+	}
+	question := message.Question[0]
+	query := r.begin(ctx, dns.FqdnToDomain(question.Name), mDNS.TypeToString[question.Qtype])
+	response, err := r.DNSRouter.Exchange(context.WithValue(ctx, dnsQueryTraceKey{}, query.trace), message, options)
+	if response != nil {
+		query.entry.Rcode = mDNS.RcodeToString[response.Rcode]
+		for _, answer := range response.Answer {
+			header := answer.Header()
+			query.entry.Answers = append(query.entry.Answers, mDNS.TypeToString[header.Rrtype]+" "+strings.TrimPrefix(answer.String(), header.String()))
+		}
+	}
+	r.finish(query, options, question.Qtype, responseAddresses(response), err)
+	return response, err
+}
+
+func (r *recordingDNSRouter) Lookup(ctx context.Context, domain string, options adapter.DNSQueryOptions) ([]netip.Addr, error) {
+	if !dnsQueryLog.enabled() {
+		return r.DNSRouter.Lookup(ctx, domain, options)
+	}
+	query := r.begin(ctx, domain, "lookup")
+	addresses, err := r.DNSRouter.Lookup(context.WithValue(ctx, dnsQueryTraceKey{}, query.trace), domain, options)
+	if err == nil {
+		query.entry.Rcode = mDNS.RcodeToString[mDNS.RcodeSuccess]
+	}
+	for _, address := range addresses {
+		query.entry.Answers = append(query.entry.Answers, address.String())
+	}
+	r.finish(query, options, mDNS.TypeA, addresses, err)
+	return addresses, err
+}
+
+func (r *recordingDNSRouter) Close() error {
+	if r.matcher != nil {
+		r.matcher.Close()
+	}
+	return r.DNSRouter.Close()
+}
+
+type recordingDNSQuery struct {
+	entry    *pb.DNSQueryEntry
+	metadata adapter.InboundContext
+	trace    *dnsQueryTrace
+	start    time.Time
+}
+
+func (r *recordingDNSRouter) begin(ctx context.Context, domain string, queryType string) *recordingDNSQuery {
+	query := &recordingDNSQuery{trace: &dnsQueryTrace{}, start: time.Now()}
+	if metadata := adapter.ContextFrom(ctx); metadata != nil {
+		query.metadata = *metadata
+	}
+	query.entry = &pb.DNSQueryEntry{
+		Time:      query.start.UnixMilli(),
+		Domain:    domain,
+		Type:      queryType,
+		Inbound:   query.metadata.Inbound,
+		RuleIndex: -1,
+	}
+	return query
+}
+
+// finish подписывает запрос правилом и сервером. Сервера нет, а правило ведёт
+// на сервер (или сработал final) — ответ взят из кэша.
+func (r *recordingDNSRouter) finish(query *recordingDNSQuery, options adapter.DNSQueryOptions, queryType uint16, addresses []netip.Addr, err error) {
+	entry := query.entry
+	entry.LatencyMs = int32(time.Since(query.start).Milliseconds())
+	if err != nil {
+		entry.Error = err.Error()
+		var rcodeError dns.RcodeError
+		if errors.As(err, &rcodeError) {
+			entry.Rcode = rcodeError.Error()
+		}
+	}
+	upstream := query.trace.exchanged()
+	var server string
+	if options.Transport != nil {
+		server = options.Transport.Tag()
+	} else if matcher := r.dnsRuleMatcher(); matcher != nil {
+		metadata := query.metadata
+		metadata.Domain = entry.Domain
+		metadata.QueryType = queryType
+		switch queryType {
+		case mDNS.TypeA:
+			metadata.IPVersion = 4
+		case mDNS.TypeAAAA:
+			metadata.IPVersion = 6
+		}
+		metadata.Destination = M.Socksaddr{}
+		metadata.DestinationAddresses = nil
+		isAddressQuery := queryType == mDNS.TypeA || queryType == mDNS.TypeAAAA
+		for from := 0; ; {
+			index, rule := matcher.Match(&metadata, from, isAddressQuery)
+			if rule == nil {
+				break
+			}
+			// ответ не прошёл ограничение по адресам — роутер идёт дальше
+			if rule.WithAddressLimit() && err == nil {
+				metadata.DestinationAddresses = addresses
+				passed := rule.MatchAddressLimit(&metadata)
+				metadata.DestinationAddresses = nil
+				if !passed {
+					from = index + 1
+					continue
+				}
+			}
+			entry.RuleIndex = int32(index)
+			entry.Rule = rule.String()
+			switch action := rule.Action().(type) {
+			case *R.RuleActionDNSRoute:
+				server = action.Server
+			case *R.RuleActionPredefined:
+				entry.Upstream = "predefined"
+				dnsQueryLog.record(entry)
+				return
+			default:
+				entry.Upstream = action.String()
+				dnsQueryLog.record(entry)
+				return
+			}
+			break
+		}
+	}
+	if upstream != "" {
+		entry.Upstream = upstream
+	} else {
+		entry.Upstream = server
+		entry.CacheHit = err == nil
+	}
+	dnsQueryLog.record(entry)
+}
+
+func responseAddresses(response *mDNS.Msg) []netip.Addr {
+	if response == nil {
+		return nil
+	}
+	var addresses []netip.Addr
+	for _, answer := range response.Answer {
+		switch record := answer.(type) {
+		case *mDNS.A:
+			if address, ok := netip.AddrFromSlice(record.A); ok {
+				addresses = append(addresses, address.Unmap())
+			}
+		case *mDNS.AAAA:
+			if address, ok := netip.AddrFromSlice(record.AAAA); ok {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	return addresses
+}
+
+// snapshot — история от старых записей к новым.
+func (w *dnsQueryRecorder) snapshot() []*pb.DNSQueryEntry {
+	w.access.Lock()
+	defer w.access.Unlock()
+	entries := make([]*pb.DNSQueryEntry, 0, len(w.history))
+	entries = append(entries, w.history[w.next:]...)
+	entries = append(entries, w.history[:w.next]...)
+	return entries
+}
+
+// DNSQueryLog отдаёт сначала историю, потом новые запросы по мере поступления.
+// Запросы пишутся в журнал, только пока открыт хотя бы один такой поток.
+func (s *CoreService) DNSQueryLog(req *pb.Empty, stream grpc.ServerStreamingServer[pb.DNSQueryEntry]) error {
+	querySub, stopch, _ := dnsQueryObserver.Subscribe()
+	defer dnsQueryObserver.UnSubscribe(querySub)
+	dnsQueryLog.listen()
+	defer dnsQueryLog.unlisten()
+
+	for _, entry := range dnsQueryLog.snapshot() {
+		if err := stream.Send(entry); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-stopch:
+			return nil
+		case entry := <-querySub:
+			if err := stream.Send(entry); err != nil {
+				return err
+			}
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func (s *CoreService) GetDNSQueryStats(ctx context.Context, in *pb.Empty) (*pb.DNSQueryStats, error) {
+	return GetDNSQueryStats(in)
+}
+
+// GetDNSQueryStats — сводка по истории журнала: частые домены, доля ошибок
+// по upstream'ам и попадания в кэш. История копится, пока открыт DNSQueryLog.
+func GetDNSQueryStats(in *pb.Empty) (*pb.DNSQueryStats, error) {
+	entries := dnsQueryLog.snapshot()
+	stats := &pb.DNSQueryStats{
+		ResponseCode: pb.ResponseCode_OK,
+		Total:        int32(len(entries)),
+	}
+	domains := make(map[string]*pb.DNSDomainCount)
+	upstreams := make(map[string]*pb.DNSUpstreamCount)
+	var resolved, cacheHits int
+	for _, entry := range entries {
+		failed := entry.Error != "" || entry.Rcode == mDNS.RcodeToString[mDNS.RcodeServerFailure]
+		domain := domains[entry.Domain]
+		if domain == nil {
+			domain = &pb.DNSDomainCount{Domain: entry.Domain}
+			domains[entry.Domain] = domain
+		}
+		domain.Queries++
+		if failed {
+			domain.Failures++
+			stats.Failures++
+		}
+		name := entry.Upstream
+		switch {
+		case name == "predefined", strings.HasPrefix(name, "reject"):
+			continue
+		case name == "":
+			name = "final"
+		}
+		upstream := upstreams[name]
+		if upstream == nil {
+			upstream = &pb.DNSUpstreamCount{Upstream: name}
+			upstreams[name] = upstream
+		}
+		upstream.Queries++
+		if failed {
+			upstream.Failures++
+			continue
+		}
+		resolved++
+		if entry.CacheHit {
+			cacheHits++
+		}
+	}
+	if resolved > 0 {
+		stats.CacheHitRatio = float32(cacheHits) / float32(resolved)
+	}
+	for _, domain := range domains {
+		stats.TopDomains = append(stats.TopDomains, domain)
+	}
+	sort.Slice(stats.TopDomains, func(i, j int) bool {
+		a, b := stats.TopDomains[i], stats.TopDomains[j]
+		if a.Queries != b.Queries {
+			return a.Queries > b.Queries
+		}
+		return a.Domain < b.Domain
+	})
+	if len(stats.TopDomains) > dnsQueryTopDomains {
+		stats.TopDomains = stats.TopDomains[:dnsQueryTopDomains]
+	}
+	for _, upstream := range upstreams {
+		stats.Upstreams = append(stats.Upstreams, upstream)
+	}
+	sort.Slice(stats.Upstreams, func(i, j int) bool {
+		return stats.Upstreams[i].Queries > stats.Upstreams[j].Queries
+	})
+	return stats, nil
+}
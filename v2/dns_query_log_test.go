@@ -0,0 +1,263 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/dns"
+	"github.com/sagernet/sing-box/log"
+	"github.com/sagernet/sing-box/option"
+	singjson "github.com/sagernet/sing/common/json"
+	"github.com/sagernet/sing/service"
+
+	mDNS "github.com/miekg/dns"
+)
+
+const testDNSRules = `[
+  {"domain_suffix": ["ads.example"], "action": "reject"},
+  {"domain": ["local.example"], "action": "predefined", "rcode": "NXDOMAIN"},
+  {"domain_suffix": ["example.com"], "action": "route-options", "rewrite_ttl": 60},
+  {"domain_suffix": ["example.com", "example.net"], "server": "remote"}
+]`
+
+type testDNSTransport struct {
+	adapter.DNSTransport
+	tag string
+}
+
+func (t *testDNSTransport) Tag() string {
+	return t.tag
+}
+
+func (t *testDNSTransport) Exchange(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+	response := new(mDNS.Msg)
+	response.SetReply(message)
+	response.Answer = []mDNS.RR{&mDNS.A{
+		Hdr: mDNS.RR_Header{Name: message.Question[0].Name, Rrtype: mDNS.TypeA, Class: mDNS.ClassINET, Ttl: 300},
+		A:   net.IPv4(93, 184, 216, 34),
+	}}
+	return response, nil
+}
+
+// testDNSRouter отвечает как роутер: ходит в upstream или отвечает сам
+// (кэш, reject, predefined).
+type testDNSRouter struct {
+	adapter.DNSRouter
+	exchange func(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error)
+	lookup   func(ctx context.Context, domain string) ([]netip.Addr, error)
+}
+
+func (r *testDNSRouter) Exchange(ctx context.Context, message *mDNS.Msg, options adapter.DNSQueryOptions) (*mDNS.Msg, error) {
+	return r.exchange(ctx, message)
+}
+
+func (r *testDNSRouter) Lookup(ctx context.Context, domain string, options adapter.DNSQueryOptions) ([]netip.Addr, error) {
+	return r.lookup(ctx, domain)
+}
+
+func newTestDNSQueryLog(t *testing.T, inner *testDNSRouter) *recordingDNSRouter {
+	t.Helper()
+	ctx := config.BaseContext()
+	rules, err := singjson.UnmarshalExtendedContext[[]option.DNSRule](ctx, []byte(testDNSRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := dnsQueryLog
+	dnsQueryLog = &dnsQueryRecorder{}
+	dnsQueryLog.listen()
+	t.Cleanup(func() { dnsQueryLog = previous })
+	return &recordingDNSRouter{DNSRouter: inner, ctx: ctx, rules: rules}
+}
+
+func exchangeTestQuery(t *testing.T, router adapter.DNSRouter, inbound string, domain string) *pb.DNSQueryEntry {
+	t.Helper()
+	ctx := adapter.WithContext(context.Background(), &adapter.InboundContext{Inbound: inbound})
+	query := new(mDNS.Msg)
+	query.SetQuestion(mDNS.Fqdn(domain), mDNS.TypeA)
+	router.Exchange(ctx, query, adapter.DNSQueryOptions{})
+	entries := dnsQueryLog.snapshot()
+	if len(entries) == 0 {
+		t.Fatalf("%s is not recorded", domain)
+	}
+	return entries[len(entries)-1]
+}
+
+func TestDNSQueryLogExchange(t *testing.T) {
+	remote := &recordingDNSTransport{DNSTransport: &testDNSTransport{tag: "remote"}}
+	router := newTestDNSQueryLog(t, &testDNSRouter{exchange: func(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+		return remote.Exchange(ctx, message)
+	}})
+
+	entry := exchangeTestQuery(t, router, "tun-in", "www.example.com")
+	if entry.Domain != "www.example.com" || entry.Type != "A" || entry.Inbound != "tun-in" {
+		t.Errorf("query = %s %s from %q", entry.Domain, entry.Type, entry.Inbound)
+	}
+	// route-options не финальное, сработало правило с сервером
+	if entry.Upstream != "remote" || entry.RuleIndex != 3 || entry.Rule == "" {
+		t.Errorf("rule = %d %q => %q", entry.RuleIndex, entry.Rule, entry.Upstream)
+	}
+	if entry.Rcode != "NOERROR" || entry.CacheHit || entry.Error != "" {
+		t.Errorf("response = %s, cache hit %v, error %q", entry.Rcode, entry.CacheHit, entry.Error)
+	}
+	if want := []string{"A 93.184.216.34"}; !reflect.DeepEqual(entry.Answers, want) {
+		t.Errorf("answers = %q, want %q", entry.Answers, want)
+	}
+
+	// правило не сработало — final, сервер берётся тот, что ответил
+	entry = exchangeTestQuery(t, router, "", "example.org")
+	if entry.RuleIndex != -1 || entry.Upstream != "remote" {
+		t.Errorf("final = %+v", entry)
+	}
+}
+
+func TestDNSQueryLogCached(t *testing.T) {
+	router := newTestDNSQueryLog(t, &testDNSRouter{exchange: func(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+		response := new(mDNS.Msg)
+		response.SetReply(message)
+		return response, nil
+	}})
+	entry := exchangeTestQuery(t, router, "", "example.net")
+	if !entry.CacheHit || entry.Upstream != "remote" || entry.RuleIndex != 3 {
+		t.Errorf("entry = %+v", entry)
+	}
+}
+
+func TestDNSQueryLogFinishedByRule(t *testing.T) {
+	router := newTestDNSQueryLog(t, &testDNSRouter{exchange: func(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+		response := new(mDNS.Msg)
+		response.SetRcode(message, mDNS.RcodeRefused)
+		if message.Question[0].Name == "local.example." {
+			response.Rcode = mDNS.RcodeNameError
+		}
+		return response, nil
+	}})
+	if entry := exchangeTestQuery(t, router, "", "tracker.ads.example"); entry.Rcode != "REFUSED" || entry.RuleIndex != 0 || entry.Upstream != "reject" || entry.CacheHit {
+		t.Errorf("rejected = %+v", entry)
+	}
+	if entry := exchangeTestQuery(t, router, "", "local.example"); entry.Rcode != "NXDOMAIN" || entry.RuleIndex != 1 || entry.Upstream != "predefined" {
+		t.Errorf("predefined = %+v", entry)
+	}
+}
+
+func TestDNSQueryLogLookup(t *testing.T) {
+	remote := &recordingDNSTransport{DNSTransport: &testDNSTransport{tag: "remote"}}
+	router := newTestDNSQueryLog(t, &testDNSRouter{lookup: func(ctx context.Context, domain string) ([]netip.Addr, error) {
+		query := new(mDNS.Msg)
+		query.SetQuestion(mDNS.Fqdn(domain), mDNS.TypeA)
+		remote.Exchange(ctx, query)
+		return []netip.Addr{netip.MustParseAddr("198.51.100.1"), netip.MustParseAddr("2001:db8::2")}, nil
+	}})
+	router.Lookup(context.Background(), "example.net", adapter.DNSQueryOptions{})
+
+	entries := dnsQueryLog.snapshot()
+	if len(entries) != 1 {
+		t.Fatalf("history = %d entries", len(entries))
+	}
+	entry := entries[0]
+	if entry.Type != "lookup" || entry.Upstream != "remote" || entry.Rcode != "NOERROR" || entry.CacheHit {
+		t.Errorf("entry = %+v", entry)
+	}
+	if want := []string{"198.51.100.1", "2001:db8::2"}; !reflect.DeepEqual(entry.Answers, want) {
+		t.Errorf("answers = %q, want %q", entry.Answers, want)
+	}
+}
+
+func TestDNSQueryLogErrors(t *testing.T) {
+	router := newTestDNSQueryLog(t, &testDNSRouter{
+		exchange: func(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+			return nil, errors.New("i/o timeout")
+		},
+		lookup: func(ctx context.Context, domain string) ([]netip.Addr, error) {
+			return nil, dns.RcodeError(mDNS.RcodeNameError)
+		},
+	})
+	exchangeTestQuery(t, router, "", "example.com")
+	router.Lookup(context.Background(), "example.org", adapter.DNSQueryOptions{})
+
+	entries := dnsQueryLog.snapshot()
+	if len(entries) != 2 || entries[0].Error != "i/o timeout" || entries[0].CacheHit {
+		t.Fatalf("entries = %+v", entries)
+	}
+	if entry := entries[1]; entry.Rcode != "NXDOMAIN" || entry.Error == "" {
+		t.Errorf("lookup = %+v", entry)
+	}
+}
+
+func TestDNSQueryLogListeners(t *testing.T) {
+	var exchanged int
+	router := newTestDNSQueryLog(t, &testDNSRouter{exchange: func(ctx context.Context, message *mDNS.Msg) (*mDNS.Msg, error) {
+		exchanged++
+		return new(mDNS.Msg).SetReply(message), nil
+	}})
+	dnsQueryLog.unlisten()
+	query := new(mDNS.Msg)
+	query.SetQuestion("ignored.example.", mDNS.TypeA)
+	router.Exchange(context.Background(), query, adapter.DNSQueryOptions{})
+	if exchanged != 1 || len(dnsQueryLog.snapshot()) != 0 {
+		t.Errorf("query recorded without listeners")
+	}
+}
+
+func TestDNSQueryLogRegistry(t *testing.T) {
+	ctx := withDNSQueryLog(config.BaseContext(), option.Options{})
+	service.MustRegister[adapter.DNSRouter](ctx, &testDNSRouter{})
+	if _, wrapped := service.FromContext[adapter.DNSRouter](ctx).(*recordingDNSRouter); !wrapped {
+		t.Errorf("dns router is not wrapped")
+	}
+	registry := service.FromContext[adapter.DNSTransportRegistry](ctx)
+	transport, err := registry.CreateDNSTransport(ctx, log.NewNOPFactory().Logger(), "blocklist-ads", "blocklist", &option.StubOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, wrapped := transport.(*recordingDNSTransport); !wrapped || transport.Tag() != "blocklist-ads" {
+		t.Errorf("transport = %T %s", transport, transport.Tag())
+	}
+	// роутер читает у серверов стратегию приведением типа
+	if _, isLegacy := transport.(adapter.LegacyDNSTransport); !isLegacy {
+		t.Errorf("wrapped transport lost the legacy options")
+	}
+}
+
+type testFakeIPTransport struct {
+	*testDNSTransport
+}
+
+func (t testFakeIPTransport) Store() adapter.FakeIPStore {
+	return nil
+}
+
+type testDNSTransportRegistry struct {
+	adapter.DNSTransportRegistry
+	transport adapter.DNSTransport
+}
+
+func (r testDNSTransportRegistry) CreateDNSTransport(ctx context.Context, logger log.ContextLogger, tag string, transportType string, options any) (adapter.DNSTransport, error) {
+	return r.transport, nil
+}
+
+// fakeip-сервер после обёртки остаётся FakeIPTransport: иначе менеджер
+// серверов не найдёт хранилище адресов
+func TestRecordingFakeIPTransport(t *testing.T) {
+	registry := recordingDNSTransportRegistry{testDNSTransportRegistry{transport: testFakeIPTransport{&testDNSTransport{tag: "fakeip"}}}}
+	transport, err := registry.CreateDNSTransport(context.Background(), nil, "fakeip", "fakeip", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, isFakeIP := transport.(adapter.FakeIPTransport); !isFakeIP {
+		t.Errorf("fakeip transport lost its store: %T", transport)
+	}
+	trace := &dnsQueryTrace{}
+	query := new(mDNS.Msg)
+	query.SetQuestion("example.com.", mDNS.TypeA)
+	transport.Exchange(context.WithValue(context.Background(), dnsQueryTraceKey{}, trace), query)
+	if trace.exchanged() != "fakeip" {
+		t.Errorf("upstream = %q", trace.exchanged())
+	}
+}
@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/dnsgroup"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+func (s *CoreService) GetDNSStats(ctx context.Context, in *pb.Empty) (*pb.DNSStatsResponse, error) {
+	return GetDNSStats(in)
+}
+
+// GetDNSStats — задержки и ошибки upstream'ов в racing-группах DNS
+// (dns-groups в настройках). Без групп список пуст.
+func GetDNSStats(in *pb.Empty) (*pb.DNSStatsResponse, error) {
+	if Box == nil {
+		err := fmt.Errorf("core is not running")
+		return &pb.DNSStatsResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	var groups []*pb.DNSGroupStats
+	for _, group := range dnsgroup.Stats() {
+		item := &pb.DNSGroupStats{
+			Tag:  group.Tag,
+			Mode: group.Mode,
+		}
+		for _, upstream := range group.Upstreams {
+			stats := &pb.DNSUpstreamStats{
+				Tag:       upstream.Tag,
+				Queries:   upstream.Queries,
+				Failures:  upstream.Failures,
+				LatencyMs: int32(upstream.Latency.Milliseconds()),
+				LastError: upstream.LastError,
+			}
+			if time.Now().Before(upstream.DemotedUntil) {
+				stats.DemotedUntil = upstream.DemotedUntil.Unix()
+			}
+			item.Upstreams = append(item.Upstreams, stats)
+		}
+		groups = append(groups, item)
+	}
+	return &pb.DNSStatsResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Groups:       groups,
+	}, nil
+}
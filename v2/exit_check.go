@@ -0,0 +1,196 @@
+package v2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/Darkmen203/rostovvpn-core/protocol/blocklist"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/sagernet/sing-box/adapter"
+	C "github.com/sagernet/sing-box/constant"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	exitCheckTimeout     = 10 * time.Second
+	exitCheckIPv6Timeout = 5 * time.Second
+	exitCheckConcurrency = 8
+)
+
+var (
+	exitCheckAccess  sync.RWMutex
+	exitCheckProfile string
+	exitChecks       = map[string]*pb.ExitInfo{}
+)
+
+func (s *CoreService) CheckExit(ctx context.Context, in *pb.CheckExitRequest) (*pb.CheckExitResponse, error) {
+	return CheckExit(ctx, in)
+}
+
+// CheckExit ходит на cdn-cgi/trace через выбранный outbound (или через все прокси)
+// и показывает, где на самом деле выход. Результаты кэшируются по тегу
+// и уходят в OutboundGroupItem.exit. Отмена ctx прерывает все проверки.
+func CheckExit(ctx context.Context, in *pb.CheckExitRequest) (*pb.CheckExitResponse, error) {
+	manager := outboundManager(Box)
+	if manager == nil {
+		err := fmt.Errorf("core is not running")
+		return &pb.CheckExitResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	var outbounds []adapter.Outbound
+	if in.OutboundTag != "" {
+		outbound, loaded := manager.Outbound(in.OutboundTag)
+		if !loaded {
+			err := fmt.Errorf("outbound not found: %s", in.OutboundTag)
+			return &pb.CheckExitResponse{
+				ResponseCode: pb.ResponseCode_FAILED,
+				Message:      err.Error(),
+			}, err
+		}
+		outbounds = append(outbounds, outbound)
+	} else {
+		for _, outbound := range manager.Outbounds() {
+			if isExitCheckTarget(outbound) {
+				outbounds = append(outbounds, outbound)
+			}
+		}
+	}
+
+	results := make([]*pb.ExitInfo, len(outbounds))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, exitCheckConcurrency)
+	for i, outbound := range outbounds {
+		wg.Add(1)
+		go func(i int, outbound adapter.Outbound) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			results[i] = checkOutboundExit(ctx, outbound)
+		}(i, outbound)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		// прерванные проверки не должны затирать прошлые результаты
+		return &pb.CheckExitResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+
+	exitCheckAccess.Lock()
+	for _, result := range results {
+		exitChecks[result.Tag] = result
+	}
+	exitCheckAccess.Unlock()
+
+	return &pb.CheckExitResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Results:      results,
+	}, nil
+}
+
+// группы проверяются через свои участники, служебные outbound'ы — не выход
+func isExitCheckTarget(outbound adapter.Outbound) bool {
+	switch outbound.Type() {
+	case C.TypeDirect, C.TypeBlock, C.TypeDNS, C.TypeSelector, C.TypeURLTest, blocklist.Type:
+		return false
+	}
+	if _, isGroup := outbound.(adapter.OutboundGroup); isGroup {
+		return false
+	}
+	return !strings.Contains(strings.ToLower(outbound.Tag()), "hide")
+}
+
+func checkOutboundExit(ctx context.Context, outbound adapter.Outbound) *pb.ExitInfo {
+	result := &pb.ExitInfo{Tag: outbound.Tag()}
+	// время окончания: очередь за семафором и таймауты не делают результат старее
+	defer func() { result.CheckedAt = time.Now().Unix() }()
+	ctx4, cancel4 := context.WithTimeout(ctx, exitCheckTimeout)
+	defer cancel4()
+	start := time.Now()
+	trace, err := fetchTrace(ctx4, outbound, config.TraceURL)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.LatencyMs = int32(time.Since(start).Milliseconds())
+	result.Ip = trace["ip"]
+	result.Colo = trace["colo"]
+	result.Country = trace["loc"]
+
+	ctx6, cancel6 := context.WithTimeout(ctx, exitCheckIPv6Timeout)
+	defer cancel6()
+	if trace6, err := fetchTrace(ctx6, outbound, config.TraceURLv6); err == nil && strings.Contains(trace6["ip"], ":") {
+		result.Ipv6 = true
+	}
+	return result
+}
+
+// fetchTrace — GET через outbound; ответ trace — строки key=value.
+func fetchTrace(ctx context.Context, outbound adapter.Outbound, url string) (map[string]string, error) {
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return outbound.DialContext(ctx, N.NetworkTCP, M.ParseSocksaddr(addr))
+			},
+			DisableKeepAlives: true,
+		},
+	}
+	defer client.CloseIdleConnections()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status code: %d", resp.StatusCode)
+	}
+	trace := make(map[string]string)
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, 4096))
+	for scanner.Scan() {
+		if key, value, found := strings.Cut(scanner.Text(), "="); found {
+			trace[key] = value
+		}
+	}
+	if trace["ip"] == "" {
+		return nil, fmt.Errorf("unexpected trace response")
+	}
+	return trace, nil
+}
+
+// exitInfo — копия закэшированной проверки для OutboundGroupItem, nil если не проверяли.
+func exitInfo(tag string) *pb.ExitInfo {
+	exitCheckAccess.RLock()
+	defer exitCheckAccess.RUnlock()
+	if info, ok := exitChecks[tag]; ok {
+		return proto.Clone(info).(*pb.ExitInfo)
+	}
+	return nil
+}
+
+// resetExitChecks — при смене профиля теги могут совпасть, а серверы нет.
+func resetExitChecks(profile string) {
+	exitCheckAccess.Lock()
+	defer exitCheckAccess.Unlock()
+	// профиль без ключа мог быть любым — старые проверки не годятся
+	if profile == "" || exitCheckProfile != profile {
+		exitCheckProfile = profile
+		exitChecks = map[string]*pb.ExitInfo{}
+	}
+}
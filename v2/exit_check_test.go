@@ -0,0 +1,143 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/sagernet/sing-box/adapter/outbound"
+	C "github.com/sagernet/sing-box/constant"
+	M "github.com/sagernet/sing/common/metadata"
+	N "github.com/sagernet/sing/common/network"
+)
+
+// traceOutbound отправляет любое соединение на локальный сервер trace.
+type traceOutbound struct {
+	outbound.Adapter
+	server string
+}
+
+func (o *traceOutbound) DialContext(ctx context.Context, network string, destination M.Socksaddr) (net.Conn, error) {
+	if o.server == "" {
+		return nil, net.ErrClosed
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, network, o.server)
+}
+
+func (o *traceOutbound) ListenPacket(ctx context.Context, destination M.Socksaddr) (net.PacketConn, error) {
+	return nil, net.ErrClosed
+}
+
+func newTraceOutbound(outboundType string, tag string, server string) *traceOutbound {
+	return &traceOutbound{outbound.NewAdapter(outboundType, tag, []string{N.NetworkTCP}, nil), server}
+}
+
+// newTraceServer отвечает как cdn-cgi/trace: IPv6-адрес для запроса к IPv6-хосту.
+func newTraceServer(t *testing.T, handler http.HandlerFunc) string {
+	if handler == nil {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			ip := "198.51.100.7"
+			if strings.HasPrefix(r.Host, "[") {
+				ip = "2001:db8::7"
+			}
+			fmt.Fprintf(w, "fl=1\nh=1.1.1.1\nip=%s\nts=1\ncolo=FRA\nloc=DE\n", ip)
+		}
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.Listener.Addr().String()
+}
+
+func TestCheckOutboundExit(t *testing.T) {
+	start := time.Now().Unix()
+	result := checkOutboundExit(context.Background(), newTraceOutbound(C.TypeVLESS, "proxy", newTraceServer(t, nil)))
+	if result.Error != "" {
+		t.Fatal(result.Error)
+	}
+	if result.Tag != "proxy" || result.Ip != "198.51.100.7" || result.Country != "DE" || result.Colo != "FRA" || !result.Ipv6 {
+		t.Errorf("result = %+v", result)
+	}
+	if result.CheckedAt < start {
+		t.Errorf("checked at %d, started %d", result.CheckedAt, start)
+	}
+}
+
+func TestCheckOutboundExitErrors(t *testing.T) {
+	cases := map[string]*traceOutbound{
+		"dial":   newTraceOutbound(C.TypeVLESS, "dial", ""),
+		"status": newTraceOutbound(C.TypeVLESS, "status", newTraceServer(t, func(w http.ResponseWriter, r *http.Request) { http.NotFound(w, r) })),
+		"body":   newTraceOutbound(C.TypeVLESS, "body", newTraceServer(t, func(w http.ResponseWriter, r *http.Request) { fmt.Fprint(w, "<html>") })),
+	}
+	for name, traceOutbound := range cases {
+		result := checkOutboundExit(context.Background(), traceOutbound)
+		if result.Error == "" || result.Ip != "" {
+			t.Errorf("%s: result = %+v", name, result)
+		}
+		if result.CheckedAt == 0 {
+			t.Errorf("%s: checked at is not set", name)
+		}
+	}
+}
+
+func TestCheckOutboundExitCanceled(t *testing.T) {
+	// сервер не отвечает: проверку обрывает только отмена запроса
+	blocked := make(chan struct{})
+	server := newTraceServer(t, func(w http.ResponseWriter, r *http.Request) { <-blocked })
+	t.Cleanup(func() { close(blocked) })
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	result := checkOutboundExit(ctx, newTraceOutbound(C.TypeVLESS, "proxy", server))
+	if result.Error == "" || time.Since(start) >= exitCheckTimeout/2 {
+		t.Errorf("result = %+v after %s", result, time.Since(start))
+	}
+}
+
+func TestIsExitCheckTarget(t *testing.T) {
+	for target, want := range map[*traceOutbound]bool{
+		newTraceOutbound(C.TypeVLESS, "proxy", ""):         true,
+		newTraceOutbound(C.TypeVLESS, "proxy-hide", ""):    false,
+		newTraceOutbound(C.TypeDirect, "direct", ""):       false,
+		newTraceOutbound(C.TypeSelector, "select", ""):     false,
+		newTraceOutbound(C.TypeWireGuard, "warp", ""):      true,
+		newTraceOutbound(C.TypeBlock, "block", ""):         false,
+		newTraceOutbound(C.TypeURLTest, "auto", ""):        false,
+		newTraceOutbound(C.TypeShadowsocks, "Hide ss", ""): false,
+	} {
+		if got := isExitCheckTarget(target); got != want {
+			t.Errorf("%s/%s: %v, want %v", target.Type(), target.Tag(), got, want)
+		}
+	}
+}
+
+func TestExitChecksCache(t *testing.T) {
+	resetExitChecks("first")
+	exitCheckAccess.Lock()
+	exitChecks["proxy"] = &pb.ExitInfo{Tag: "proxy", Ip: "198.51.100.7"}
+	exitCheckAccess.Unlock()
+
+	info := exitInfo("proxy")
+	if info == nil || info.Ip != "198.51.100.7" {
+		t.Fatalf("info = %+v", info)
+	}
+	// копия: правка ответа не трогает кэш
+	info.Ip = ""
+	if exitInfo("proxy").Ip == "" {
+		t.Errorf("cached info changed through the copy")
+	}
+	resetExitChecks("first")
+	if exitInfo("proxy") == nil {
+		t.Errorf("same profile must keep checks")
+	}
+	resetExitChecks("second")
+	if exitInfo("proxy") != nil {
+		t.Errorf("profile change must drop checks")
+	}
+}
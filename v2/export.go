@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+func (s *CoreService) ExportOutbounds(ctx context.Context, in *pb.ExportOutboundsRequest) (*pb.ExportOutboundsResponse, error) {
+	return ExportOutbounds(in)
+}
+
+// ExportOutbounds — обратная конвертация в ссылки/подписку/Clash. Без content и
+// config_path берётся последний собранный конфиг (current-config.json).
+func ExportOutbounds(in *pb.ExportOutboundsRequest) (*pb.ExportOutboundsResponse, error) {
+	content := in.Content
+	if content == "" {
+		path := in.ConfigPath
+		if path == "" {
+			path = filepath.Join(sWorkingPath, "current-config.json")
+		}
+		contentBytes, err := os.ReadFile(path)
+		if err != nil {
+			return &pb.ExportOutboundsResponse{
+				ResponseCode: pb.ResponseCode_FAILED,
+				Message:      err.Error(),
+			}, err
+		}
+		content = string(contentBytes)
+	}
+	outbounds, err := config.ParseOutbounds(content, RostovVPNOptions)
+	if err != nil {
+		return &pb.ExportOutboundsResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	format := strings.ToLower(in.Format.String())
+	result, err := config.ExportOutbounds(config.FilterOutboundsByTag(outbounds, in.Tags), format)
+	if err != nil {
+		return &pb.ExportOutboundsResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.ExportOutboundsResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Content:      result.Content,
+		Links:        result.Links,
+		Skipped:      result.Skipped,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/Darkmen203/rostovvpn-core/protocol/fakeip"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+func (s *CoreService) ClearFakeIPCache(ctx context.Context, in *pb.Empty) (*pb.Response, error) {
+	return ClearFakeIPCache(in)
+}
+
+// ClearFakeIPCache забывает все выданные fake-IP: и у запущенного ядра,
+// и сохранённые на диске.
+func ClearFakeIPCache(in *pb.Empty) (*pb.Response, error) {
+	if err := fakeip.Clear(filepath.Join(sWorkingPath, fakeip.CacheFileName)); err != nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"context"
+	"maps"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	"github.com/sagernet/sing-box/option"
+)
+
+func newActiveBuild(options *option.Options, report *config.BuildReport) *activeBuild {
+	build := &activeBuild{options: options, report: report, countries: map[string]string{}}
+	if report != nil {
+		maps.Copy(build.countries, report.OutboundCountries)
+	}
+	return build
+}
+
+// resolveCountries досчитывает страны серверов, которые при сборке были
+// доменами: DNS ждёт уже запущенное ядро, а не старт.
+func (b *activeBuild) resolveCountries(geoIPPath string) {
+	b.countryAccess.RLock()
+	known := maps.Clone(b.countries)
+	b.countryAccess.RUnlock()
+	countries := config.ResolveOutboundCountries(context.Background(), geoIPPath, b.options.Outbounds, known)
+	if len(countries) == 0 {
+		return
+	}
+	b.countryAccess.Lock()
+	maps.Copy(b.countries, countries)
+	b.countryAccess.Unlock()
+}
+
+func (b *activeBuild) country(tag string) string {
+	b.countryAccess.RLock()
+	defer b.countryAccess.RUnlock()
+	return config.OutboundCountry(b.countries, tag)
+}
+
+// outboundCountry — страна outbound'а запущенного конфига для UI.
+func outboundCountry(tag string) string {
+	if build := currentBuild.Load(); build != nil {
+		return build.country(tag)
+	}
+	return config.OutboundCountry(nil, tag)
+}
@@ -0,0 +1,243 @@
+package v2
+
+import (
+	"context"
+	"maps"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/Darkmen203/rostovvpn-core/v2/db"
+	"github.com/sagernet/sing-box/adapter"
+	"github.com/sagernet/sing-box/common/urltest"
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/experimental/libbox"
+	"github.com/sagernet/sing-box/option"
+	"github.com/sagernet/sing/service"
+)
+
+// OutboundState — выбор в селекторах и снимок истории urltest для одного профиля.
+type OutboundState struct {
+	Id       string
+	Selected map[string]string
+	URLTest  map[string]URLTestSnapshot
+	Updated  time.Time
+}
+
+type URLTestSnapshot struct {
+	Time  time.Time
+	Delay uint16
+}
+
+// как часто проверять, не сменился ли выбор (в т.ч. через Clash API)
+const outboundStateCheckInterval = 10 * time.Second
+
+var (
+	outboundStateAccess  sync.Mutex
+	outboundStateProfile string
+
+	outboundStateWatcherAccess sync.Mutex
+	outboundStateWatcherCancel context.CancelFunc
+)
+
+// outboundStateId — ключ профиля: id профиля из приложения или путь к файлу.
+// По содержимому ключ не строится: обновление подписки меняет конфиг, а выбор
+// должен остаться. Профиль без id и пути состояние не сохраняет.
+func outboundStateId(profileID string, configPath string) string {
+	if profileID != "" {
+		return "profile-" + profileID
+	}
+	if configPath != "" {
+		return filepath.Clean(configPath)
+	}
+	return ""
+}
+
+func loadOutboundState(profile string) *OutboundState {
+	if profile == "" {
+		return &OutboundState{}
+	}
+	state, err := db.GetTable[OutboundState]().Get(profile)
+	if err != nil || state == nil || state.Id == "" {
+		return &OutboundState{Id: profile}
+	}
+	return state
+}
+
+// restoreSelectedOutbounds выставляет Default у селекторов по сохранённому выбору профиля.
+func restoreSelectedOutbounds(profile string, options *option.Options) {
+	state := loadOutboundState(profile)
+	if len(state.Selected) == 0 {
+		return
+	}
+	for i := range options.Outbounds {
+		if options.Outbounds[i].Type != C.TypeSelector {
+			continue
+		}
+		selector, ok := options.Outbounds[i].Options.(*option.SelectorOutboundOptions)
+		if !ok || selector == nil {
+			continue
+		}
+		selected := state.Selected[options.Outbounds[i].Tag]
+		for _, tag := range selector.Outbounds {
+			if tag == selected {
+				selector.Default = selected
+				break
+			}
+		}
+	}
+}
+
+// restoreURLTestHistory заполняет историю urltest до старта, чтобы группы
+// сразу выбрали последний рабочий сервер, не дожидаясь первой проверки.
+func restoreURLTestHistory(profile string, instance *libbox.BoxService) {
+	storage := urlTestHistoryStorage(instance)
+	if storage == nil {
+		return
+	}
+	state := loadOutboundState(profile)
+	for tag, snapshot := range state.URLTest {
+		storage.StoreURLTestHistory(tag, &adapter.URLTestHistory{
+			Time:  snapshot.Time,
+			Delay: snapshot.Delay,
+		})
+	}
+}
+
+// applySelectedOutbounds повторяет сохранённый выбор на запущенном ядре:
+// cache file мог восстановить выбор, сделанный в другом профиле.
+func applySelectedOutbounds(profile string, instance *libbox.BoxService) {
+	manager := outboundManager(instance)
+	if manager == nil {
+		return
+	}
+	for group, tag := range loadOutboundState(profile).Selected {
+		outbound, loaded := manager.Outbound(group)
+		if !loaded {
+			continue
+		}
+		if selector, ok := outbound.(interface{ SelectOutbound(string) bool }); ok {
+			selector.SelectOutbound(tag)
+		}
+	}
+}
+
+func rememberSelectedOutbound(group string, tag string) {
+	outboundStateAccess.Lock()
+	defer outboundStateAccess.Unlock()
+	if outboundStateProfile == "" {
+		return
+	}
+	state := loadOutboundState(outboundStateProfile)
+	if state.Selected == nil {
+		state.Selected = make(map[string]string)
+	}
+	state.Selected[group] = tag
+	state.Updated = time.Now()
+	if err := db.GetTable[OutboundState]().UpdateInsert(state); err != nil {
+		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "save outbound state: "+err.Error())
+	}
+}
+
+// saveOutboundState сохраняет текущий выбор селекторов (в т.ч. сделанный через
+// Clash API) и снимок истории urltest текущего профиля.
+func saveOutboundState(instance *libbox.BoxService) {
+	outboundStateAccess.Lock()
+	defer outboundStateAccess.Unlock()
+	if outboundStateProfile == "" {
+		return
+	}
+	storage := urlTestHistoryStorage(instance)
+	manager := outboundManager(instance)
+	if storage == nil || manager == nil {
+		return
+	}
+	state := loadOutboundState(outboundStateProfile)
+	state.URLTest = make(map[string]URLTestSnapshot)
+	if state.Selected == nil {
+		state.Selected = make(map[string]string)
+	}
+	maps.Copy(state.Selected, selectedOutbounds(manager))
+	for _, outbound := range manager.Outbounds() {
+		if history := storage.LoadURLTestHistory(outbound.Tag()); history != nil {
+			state.URLTest[outbound.Tag()] = URLTestSnapshot{Time: history.Time, Delay: history.Delay}
+		}
+	}
+	state.Updated = time.Now()
+	if err := db.GetTable[OutboundState]().UpdateInsert(state); err != nil {
+		Log(pb.LogLevel_WARNING, pb.LogType_CORE, "save outbound state: "+err.Error())
+	}
+}
+
+// selectedOutbounds — текущий выбор во всех селекторах.
+func selectedOutbounds(manager adapter.OutboundManager) map[string]string {
+	selected := make(map[string]string)
+	for _, outbound := range manager.Outbounds() {
+		if outbound.Type() != C.TypeSelector {
+			continue
+		}
+		if group, ok := outbound.(adapter.OutboundGroup); ok && group.Now() != "" {
+			selected[outbound.Tag()] = group.Now()
+		}
+	}
+	return selected
+}
+
+// startOutboundStateWatcher сохраняет состояние, как только меняется выбор в
+// селекторах: переключение через Clash API не проходит через SelectOutbound,
+// а при падении ядра Stop не вызывается.
+func startOutboundStateWatcher(instance *libbox.BoxService) {
+	stopOutboundStateWatcher()
+	manager := outboundManager(instance)
+	if manager == nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	outboundStateWatcherAccess.Lock()
+	outboundStateWatcherCancel = cancel
+	outboundStateWatcherAccess.Unlock()
+	go func() {
+		ticker := time.NewTicker(outboundStateCheckInterval)
+		defer ticker.Stop()
+		last := selectedOutbounds(manager)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			current := selectedOutbounds(manager)
+			if maps.Equal(current, last) {
+				continue
+			}
+			last = current
+			saveOutboundState(instance)
+		}
+	}()
+}
+
+func stopOutboundStateWatcher() {
+	outboundStateWatcherAccess.Lock()
+	defer outboundStateWatcherAccess.Unlock()
+	if outboundStateWatcherCancel != nil {
+		outboundStateWatcherCancel()
+		outboundStateWatcherCancel = nil
+	}
+}
+
+func urlTestHistoryStorage(instance *libbox.BoxService) *urltest.HistoryStorage {
+	ctx := boxServiceContext(instance)
+	if ctx == nil {
+		return nil
+	}
+	return service.PtrFromContext[urltest.HistoryStorage](ctx)
+}
+
+func outboundManager(instance *libbox.BoxService) adapter.OutboundManager {
+	ctx := boxServiceContext(instance)
+	if ctx == nil {
+		return nil
+	}
+	return service.FromContext[adapter.OutboundManager](ctx)
+}
@@ -0,0 +1,60 @@
+package v2
+
+import (
+	"testing"
+
+	C "github.com/sagernet/sing-box/constant"
+	"github.com/sagernet/sing-box/option"
+)
+
+func TestOutboundStateId(t *testing.T) {
+	if id := outboundStateId("", "profiles/../profiles/a.json"); id != "profiles/a.json" {
+		t.Errorf("path id = %s", id)
+	}
+	// id из приложения переживает обновление подписки и смену пути
+	if id := outboundStateId("42", "profiles/a.json"); id != outboundStateId("42", "") {
+		t.Errorf("profile id = %s", id)
+	}
+	if id := outboundStateId("", ""); id != "" {
+		t.Errorf("content-only profile id = %s", id)
+	}
+	if state := loadOutboundState(""); state.Id != "" || len(state.Selected) != 0 {
+		t.Errorf("state without profile = %+v", state)
+	}
+}
+
+func TestOutboundStateRestore(t *testing.T) {
+	t.Chdir(t.TempDir())
+	profiles := []string{outboundStateId("first", ""), outboundStateId("second", "")}
+	for i, profile := range profiles {
+		outboundStateAccess.Lock()
+		outboundStateProfile = profile
+		outboundStateAccess.Unlock()
+		rememberSelectedOutbound("select", []string{"a", "b"}[i])
+	}
+	t.Cleanup(func() { outboundStateProfile = "" })
+
+	for i, profile := range profiles {
+		options := option.Options{Outbounds: []option.Outbound{{
+			Type:    C.TypeSelector,
+			Tag:     "select",
+			Options: &option.SelectorOutboundOptions{Outbounds: []string{"a", "b"}},
+		}}}
+		restoreSelectedOutbounds(profile, &options)
+		selector := options.Outbounds[0].Options.(*option.SelectorOutboundOptions)
+		if want := []string{"a", "b"}[i]; selector.Default != want {
+			t.Errorf("%s: default = %s, want %s", profile, selector.Default, want)
+		}
+	}
+
+	// выбор, которого нет в селекторе, не восстанавливается
+	options := option.Options{Outbounds: []option.Outbound{{
+		Type:    C.TypeSelector,
+		Tag:     "select",
+		Options: &option.SelectorOutboundOptions{Outbounds: []string{"c"}},
+	}}}
+	restoreSelectedOutbounds(profiles[0], &options)
+	if selector := options.Outbounds[0].Options.(*option.SelectorOutboundOptions); selector.Default != "" {
+		t.Errorf("stale selection restored: %s", selector.Default)
+	}
+}
@@ -0,0 +1,173 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultProfilingDuration = 30 * time.Second
+	maxProfilingDuration     = 10 * time.Minute
+)
+
+var defaultProfiles = []string{"cpu", "heap", "goroutine", "block"}
+
+var (
+	profilingAccess sync.Mutex
+	profilingCancel context.CancelFunc
+)
+
+func (s *CoreService) StartProfiling(in *pb.ProfilingRequest, stream grpc.ServerStreamingServer[pb.ProfileData]) error {
+	return StartProfiling(stream.Context(), in, stream.Send)
+}
+
+// StartProfiling снимает запрошенные профили за указанное время и либо
+// сохраняет их в sTempPath/pprof, либо отдаёт байты через send.
+func StartProfiling(ctx context.Context, in *pb.ProfilingRequest, send func(*pb.ProfileData) error) error {
+	if RostovVPNOptions == nil || !RostovVPNOptions.EnableProfiling {
+		return fmt.Errorf("profiling is disabled in settings")
+	}
+	profiles, err := normalizeProfiles(in.Profiles)
+	if err != nil {
+		return err
+	}
+	duration := time.Duration(in.DurationSeconds) * time.Second
+	if duration <= 0 {
+		duration = defaultProfilingDuration
+	}
+	if duration > maxProfilingDuration {
+		duration = maxProfilingDuration
+	}
+
+	profilingAccess.Lock()
+	if profilingCancel != nil {
+		profilingAccess.Unlock()
+		return fmt.Errorf("profiling is already running")
+	}
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	profilingCancel = cancel
+	profilingAccess.Unlock()
+	defer func() {
+		profilingAccess.Lock()
+		profilingCancel = nil
+		profilingAccess.Unlock()
+		cancel()
+	}()
+
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, fmt.Sprintf("Profiling %s for %s", strings.Join(profiles, ","), duration))
+
+	results := make(map[string][]byte)
+	var cpuBuffer bytes.Buffer
+	cpuStarted := false
+	if slices.Contains(profiles, "cpu") {
+		if err := pprof.StartCPUProfile(&cpuBuffer); err != nil {
+			return fmt.Errorf("start cpu profile: %w", err)
+		}
+		cpuStarted = true
+	}
+	if slices.Contains(profiles, "block") {
+		runtime.SetBlockProfileRate(1)
+		defer runtime.SetBlockProfileRate(0)
+	}
+
+	<-ctx.Done()
+
+	if cpuStarted {
+		pprof.StopCPUProfile()
+		results["cpu"] = cpuBuffer.Bytes()
+	}
+	for _, name := range profiles {
+		if name == "cpu" {
+			continue
+		}
+		if name == "heap" {
+			runtime.GC()
+		}
+		var buffer bytes.Buffer
+		if err := pprof.Lookup(name).WriteTo(&buffer, 0); err != nil {
+			return fmt.Errorf("write %s profile: %w", name, err)
+		}
+		results[name] = buffer.Bytes()
+	}
+
+	timestamp := time.Now().Format("20060102-150405")
+	for _, name := range profiles {
+		data := &pb.ProfileData{Name: name}
+		if in.Stream {
+			data.Data = results[name]
+		} else {
+			path, err := saveProfile(name, timestamp, results[name])
+			if err != nil {
+				return err
+			}
+			data.Path = path
+		}
+		if err := send(data); err != nil {
+			return err
+		}
+	}
+	Log(pb.LogLevel_INFO, pb.LogType_CORE, "Profiling finished")
+	return nil
+}
+
+func (s *CoreService) StopProfiling(ctx context.Context, in *pb.Empty) (*pb.Response, error) {
+	return StopProfiling()
+}
+
+// StopProfiling завершает текущую сессию досрочно; уже собранные профили сохраняются.
+func StopProfiling() (*pb.Response, error) {
+	profilingAccess.Lock()
+	defer profilingAccess.Unlock()
+	if profilingCancel == nil {
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      "profiling is not running",
+		}, nil
+	}
+	profilingCancel()
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
+
+func normalizeProfiles(profiles []string) ([]string, error) {
+	if len(profiles) == 0 {
+		return defaultProfiles, nil
+	}
+	var result []string
+	for _, name := range profiles {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(defaultProfiles, name) {
+			return nil, fmt.Errorf("unsupported profile: %s", name)
+		}
+		if !slices.Contains(result, name) {
+			result = append(result, name)
+		}
+	}
+	return result, nil
+}
+
+func saveProfile(name string, timestamp string, data []byte) (string, error) {
+	dir := filepath.Join(sTempPath, "pprof")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.pprof", name, timestamp))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
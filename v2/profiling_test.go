@@ -0,0 +1,119 @@
+package v2
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+func TestNormalizeProfiles(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want []string
+		err  string
+	}{
+		{nil, defaultProfiles, ""},
+		{[]string{" Heap ", "heap", "CPU"}, []string{"heap", "cpu"}, ""},
+		{[]string{"goroutine"}, []string{"goroutine"}, ""},
+		{[]string{"heap", "mutex"}, nil, "unsupported profile: mutex"},
+	}
+	for _, test := range tests {
+		got, err := normalizeProfiles(test.in)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("%v: err = %v, want %q", test.in, err, test.err)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v = %v, %v; want %v", test.in, got, err, test.want)
+		}
+	}
+}
+
+func withProfiling(t *testing.T, enabled bool) {
+	saved := RostovVPNOptions
+	t.Cleanup(func() { RostovVPNOptions = saved })
+	RostovVPNOptions = config.DefaultRostovVPNOptions()
+	RostovVPNOptions.EnableProfiling = enabled
+}
+
+func TestStartProfilingDisabled(t *testing.T) {
+	withProfiling(t, false)
+	err := StartProfiling(context.Background(), &pb.ProfilingRequest{}, func(*pb.ProfileData) error {
+		t.Error("profile sent while disabled")
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "disabled") {
+		t.Fatalf("err = %v", err)
+	}
+
+	RostovVPNOptions = nil
+	if err := StartProfiling(context.Background(), &pb.ProfilingRequest{}, nil); err == nil {
+		t.Fatal("profiling without options")
+	}
+}
+
+func TestStartProfilingStop(t *testing.T) {
+	withProfiling(t, true)
+	if response, _ := StopProfiling(); response.ResponseCode != pb.ResponseCode_FAILED {
+		t.Errorf("stop without session = %v", response)
+	}
+
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	var sent []*pb.ProfileData
+	go func() {
+		close(started)
+		done <- StartProfiling(context.Background(), &pb.ProfilingRequest{
+			Profiles: []string{"heap", "goroutine"}, DurationSeconds: 60, Stream: true,
+		}, func(data *pb.ProfileData) error {
+			sent = append(sent, data)
+			return nil
+		})
+	}()
+	<-started
+	// ждём, пока сессия займёт слот
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		profilingAccess.Lock()
+		running := profilingCancel != nil
+		profilingAccess.Unlock()
+		if running {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("profiling did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	err := StartProfiling(context.Background(), &pb.ProfilingRequest{Profiles: []string{"heap"}}, nil)
+	if err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("second session: %v", err)
+	}
+	if response, _ := StopProfiling(); response.ResponseCode != pb.ResponseCode_OK {
+		t.Errorf("stop = %v", response)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("stop did not end the session")
+	}
+	if len(sent) != 2 || sent[0].Name != "heap" || sent[1].Name != "goroutine" {
+		t.Fatalf("sent = %v", sent)
+	}
+	for _, data := range sent {
+		if len(data.Data) == 0 || data.Path != "" {
+			t.Errorf("%s: %d bytes, path %q", data.Name, len(data.Data), data.Path)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+func (s *CoreService) ExplainRoute(ctx context.Context, in *pb.ExplainRouteRequest) (*pb.ExplainRouteResponse, error) {
+	return ExplainRoute(in)
+}
+
+// ExplainRoute показывает, какое правило запущенного ядра сработает для
+// соединения, откуда это правило взялось и куда уйдёт трафик.
+func ExplainRoute(in *pb.ExplainRouteRequest) (*pb.ExplainRouteResponse, error) {
+	ctx := boxServiceContext(Box)
+	build := currentBuild.Load()
+	if Box == nil || ctx == nil || build == nil {
+		err := fmt.Errorf("core is not running")
+		return &pb.ExplainRouteResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	if in.Port > 0xffff {
+		err := fmt.Errorf("invalid port %d", in.Port)
+		return &pb.ExplainRouteResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	explanation, err := config.ExplainRoute(ctx, build.options, build.report, config.RouteRequest{
+		Host:    in.Host,
+		Port:    uint16(in.Port),
+		Network: in.Network,
+		Process: in.Process,
+		Inbound: in.Inbound,
+	})
+	if err != nil {
+		return &pb.ExplainRouteResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.ExplainRouteResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Rule:         ruleMatchToPb(explanation.Rule),
+		Outbound:     explanation.Outbound,
+		Path:         explanation.Path,
+		DnsRule:      ruleMatchToPb(explanation.DNSRule),
+		DnsServer:    explanation.DNSServer,
+		Notes:        explanation.Notes,
+	}, nil
+}
+
+func ruleMatchToPb(match *config.RuleMatch) *pb.RouteRuleMatch {
+	if match == nil {
+		return nil
+	}
+	return &pb.RouteRuleMatch{
+		Index:  int32(match.Index),
+		Rule:   match.Rule,
+		Action: match.Action,
+		Source: match.Origin.Source,
+		Option: match.Origin.Option,
+	}
+}
@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/Darkmen203/rostovvpn-core/config"
+	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+)
+
+func (s *CoreService) ImportRules(ctx context.Context, in *pb.ImportRulesRequest) (*pb.ImportRulesResponse, error) {
+	return ImportRules(in)
+}
+
+// ImportRules переводит правила Clash или маршрутизацию v2ray в настройки
+// RostovVPN. Ничего не применяет: клиент показывает результат и сам вливает
+// settings в настройки.
+func ImportRules(in *pb.ImportRulesRequest) (*pb.ImportRulesResponse, error) {
+	result, err := config.ImportRules(in.Content)
+	if err != nil {
+		return &pb.ImportRulesResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	converted, err := ruleImportToPb(result)
+	if err != nil {
+		return &pb.ImportRulesResponse{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.ImportRulesResponse{
+		ResponseCode: pb.ResponseCode_OK,
+		Result:       converted,
+	}, nil
+}
+
+func ruleImportToPb(result *config.RuleImport) (*pb.RuleImport, error) {
+	if result == nil {
+		return nil, nil
+	}
+	settings, err := result.Settings()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RuleImport{
+		Format:       result.Format,
+		Settings:     string(settings),
+		Unmapped:     parseEntriesToPb(result.Unmapped),
+		Approximated: parseEntriesToPb(result.Approximated),
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	runtimeDebug "runtime/debug"
 	"time"
 
+	"github.com/Darkmen203/rostovvpn-core/config"
 	"github.com/Darkmen203/rostovvpn-core/v2/service_manager"
 
 	// sing-box core
@@ -61,6 +62,7 @@ func Setup(basePath, workingPath, tempPath string, statusPort int64, debug bool)
 	libbox.Setup(&libboxOpts)
 	// пути
 	sWorkingPath = workingPath
+	config.SetWorkingDir(sWorkingPath)
 	_ = os.Chdir(sWorkingPath)
 	sTempPath = tempPath
 	sUserID = os.Getuid()
@@ -86,11 +88,13 @@ func Setup(basePath, workingPath, tempPath string, statusPort int64, debug bool)
 func NewService(opts option.Options) (*libbox.BoxService, error) {
 	runtimeDebug.FreeOSMemory()
 
-	base := libbox.BaseContext(nil)         // nil — если не нужно подменять LocalDNS транспорт платформой
+	base := config.BaseContext()            // libbox.BaseContext + собственные типы ядра (load-balance, racing)
 	ctx, cancel := context.WithCancel(base) // уже поверх базового контекста
 	ctx = filemanager.WithDefault(ctx, sWorkingPath, sTempPath, sUserID, sGroupID)
 	urlTestHistoryStorage := urltest.NewHistoryStorage()
 	ctx = service.ContextWithPtr(ctx, urlTestHistoryStorage)
+	// DNS-роутер и серверы оборачиваются для журнала DNS-запросов
+	ctx = withDNSQueryLog(ctx, opts)
 	instance, err := B.New(B.Options{
 		Context: ctx,
 		Options: opts,
@@ -115,7 +119,7 @@ func readOptions(configContent string) (option.Options, error) {
 	// nested fields like RemoteDNSServerOptions become map[string]any, which later
 	// crashes in dns.RegisterTransport with:
 	//   interface conversion: interface {} is map[string]interface {}, not *option.RemoteDNSServerOptions
-	ctx := libbox.BaseContext(nil)
+	ctx := config.BaseContext()
 	opts, err := singjson.UnmarshalExtendedContext[option.Options](ctx, []byte(configContent))
 	if err != nil {
 		return option.Options{}, fmt.Errorf("decode config: %w", err)
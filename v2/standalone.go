@@ -20,7 +20,6 @@ import (
 	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
 	dns "github.com/sagernet/sing-dns"
 
-	"github.com/sagernet/sing-box/experimental/libbox"
 	"github.com/sagernet/sing-box/option"
 	singjson "github.com/sagernet/sing/common/json"
 )
@@ -243,7 +242,7 @@ func updateConfigInterval(current ConfigResult, rostovvpnSettingPath string, con
 }
 
 func readConfigBytes(content []byte) (*option.Options, error) {
-	ctx := libbox.BaseContext(nil)
+	ctx := config.BaseContext()
 	parsed, err := singjson.UnmarshalExtendedContext[option.Options](ctx, content)
 	if err != nil {
 		return nil, err
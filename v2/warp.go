@@ -2,11 +2,15 @@ package v2
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Darkmen203/rostovvpn-core/config"
 	pb "github.com/Darkmen203/rostovvpn-core/rostovvpnrpc"
+	"github.com/Darkmen203/rostovvpn-core/v2/db"
 )
 
+// GenerateWarpConfig выпускает разовую учётку без сохранения; для хранимых
+// аккаунтов — CreateWarpAccount и остальные RPC ниже.
 func (s *CoreService) GenerateWarpConfig(ctx context.Context, in *pb.GenerateWarpConfigRequest) (*pb.WarpGenerationResponse, error) {
 	return GenerateWarpConfig(in)
 }
@@ -30,3 +34,115 @@ func GenerateWarpConfig(in *pb.GenerateWarpConfigRequest) (*pb.WarpGenerationRes
 		Log: log,
 	}, nil
 }
+
+func (s *CoreService) ListWarpAccounts(ctx context.Context, in *pb.Empty) (*pb.WarpAccountList, error) {
+	return ListWarpAccounts()
+}
+
+func ListWarpAccounts() (*pb.WarpAccountList, error) {
+	accounts, err := db.ListWarpAccounts()
+	if err != nil {
+		return nil, err
+	}
+	list := &pb.WarpAccountList{}
+	for _, account := range accounts {
+		list.Items = append(list.Items, warpAccountInfo(account))
+	}
+	return list, nil
+}
+
+func (s *CoreService) CreateWarpAccount(ctx context.Context, in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	return CreateWarpAccount(in)
+}
+
+func CreateWarpAccount(in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	account, err := config.CreateWarpAccount(in.Name, in.LicenseKey)
+	if err != nil {
+		return nil, err
+	}
+	return warpAccountInfo(account), nil
+}
+
+func (s *CoreService) BindWarpLicense(ctx context.Context, in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	return BindWarpLicense(in)
+}
+
+func BindWarpLicense(in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	account, err := config.BindWarpLicense(in.Name, in.LicenseKey)
+	if err != nil {
+		return nil, err
+	}
+	return warpAccountInfo(account), nil
+}
+
+func (s *CoreService) RotateWarpKey(ctx context.Context, in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	return RotateWarpKey(in)
+}
+
+func RotateWarpKey(in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	account, err := config.RotateWarpKey(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return warpAccountInfo(account), nil
+}
+
+func (s *CoreService) GetWarpAccountStatus(ctx context.Context, in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	return GetWarpAccountStatus(in)
+}
+
+func GetWarpAccountStatus(in *pb.WarpAccountRequest) (*pb.WarpAccountInfo, error) {
+	account, err := config.RefreshWarpAccount(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return warpAccountInfo(account), nil
+}
+
+func (s *CoreService) DeleteWarpAccount(ctx context.Context, in *pb.WarpAccountRequest) (*pb.Response, error) {
+	return DeleteWarpAccount(in)
+}
+
+func DeleteWarpAccount(in *pb.WarpAccountRequest) (*pb.Response, error) {
+	if err := config.DeleteWarpAccount(in.Name); err != nil {
+		// запись уже удалена, сообщаем только об устройстве у Cloudflare
+		if errors.Is(err, config.ErrWarpDeviceRemains) {
+			return &pb.Response{
+				ResponseCode: pb.ResponseCode_OK,
+				Message:      err.Error(),
+			}, nil
+		}
+		return &pb.Response{
+			ResponseCode: pb.ResponseCode_FAILED,
+			Message:      err.Error(),
+		}, err
+	}
+	return &pb.Response{
+		ResponseCode: pb.ResponseCode_OK,
+		Message:      "",
+	}, nil
+}
+
+func warpAccountInfo(account *db.WarpAccountRecord) *pb.WarpAccountInfo {
+	return &pb.WarpAccountInfo{
+		Name: account.Id,
+		Account: &pb.WarpAccount{
+			AccountId:   account.AccountID,
+			AccessToken: account.AccessToken,
+		},
+		Config: &pb.WarpWireguardConfig{
+			PrivateKey:       account.PrivateKey,
+			LocalAddressIpv4: account.LocalAddressIPv4,
+			LocalAddressIpv6: account.LocalAddressIPv6,
+			PeerPublicKey:    account.PeerPublicKey,
+			ClientId:         account.ClientID,
+		},
+		LicenseKey:  account.License,
+		AccountType: account.AccountType,
+		WarpPlus:    account.WarpPlus,
+		Quota:       account.Quota,
+		Usage:       account.Usage,
+		PremiumData: account.PremiumData,
+		Updated:     account.Updated.Unix(),
+	}
+}
@@ -0,0 +1,200 @@
+package warpscan
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net"
+	"net/netip"
+	"time"
+
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	noiseConstruction = "Noise_IKpsk2_25519_ChaChaPoly_BLAKE2s"
+	wgIdentifier      = "WireGuard v1 zx2c4 Jason@zx2c4.com"
+	wgLabelMAC1       = "mac1----"
+
+	messageInitiationSize = 148
+	messageResponseSize   = 92
+	messageTypeInitiation = 1
+	messageTypeResponse   = 2
+
+	tai64nBase = 0x400000000000000a
+)
+
+// handshake отправляет WireGuard handshake initiation и ждёт response.
+// RTT — время от отправки инициации до ответа; ответ не расшифровываем,
+// достаточно того, что сервер принял наш ключ и вернул наш sender index.
+func handshake(ctx context.Context, endpoint netip.AddrPort, privateKey, peerPublicKey [32]byte, reserved [3]byte, timeout time.Duration) (time.Duration, error) {
+	var senderIndexBytes [4]byte
+	if _, err := rand.Read(senderIndexBytes[:]); err != nil {
+		return 0, err
+	}
+	senderIndex := binary.LittleEndian.Uint32(senderIndexBytes[:])
+	packet, err := initiationPacket(senderIndex, privateKey, peerPublicKey, reserved)
+	if err != nil {
+		return 0, err
+	}
+
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "udp", endpoint.String())
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+
+	start := time.Now()
+	if _, err := conn.Write(packet); err != nil {
+		return 0, err
+	}
+	response := make([]byte, messageResponseSize*2)
+	n, err := conn.Read(response)
+	if err != nil {
+		return 0, err
+	}
+	rtt := time.Since(start)
+	if n != messageResponseSize || response[0] != messageTypeResponse {
+		return 0, fmt.Errorf("unexpected handshake response (%d bytes, type %d)", n, response[0])
+	}
+	if binary.LittleEndian.Uint32(response[8:12]) != senderIndex {
+		return 0, errors.New("handshake response for another session")
+	}
+	return rtt, nil
+}
+
+func initiationPacket(senderIndex uint32, privateKey, peerPublicKey [32]byte, reserved [3]byte) ([]byte, error) {
+	publicKey, err := curve25519.X25519(privateKey[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	var ephemeralPrivate [32]byte
+	if _, err := rand.Read(ephemeralPrivate[:]); err != nil {
+		return nil, err
+	}
+	ephemeralPublic, err := curve25519.X25519(ephemeralPrivate[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	chainKey := blake2s.Sum256([]byte(noiseConstruction))
+	hashState := mixHash(chainKey[:], []byte(wgIdentifier))
+	hashState = mixHash(hashState, peerPublicKey[:])
+
+	packet := make([]byte, 0, messageInitiationSize)
+	// reserved Warp вместо нулей: mac1 считается уже с ними
+	packet = append(packet, messageTypeInitiation)
+	packet = append(packet, reserved[:]...)
+	packet = binary.LittleEndian.AppendUint32(packet, senderIndex)
+
+	// ephemeral
+	chainKey = kdf1(chainKey[:], ephemeralPublic)
+	packet = append(packet, ephemeralPublic...)
+	hashState = mixHash(hashState, ephemeralPublic)
+
+	// static
+	shared, err := curve25519.X25519(ephemeralPrivate[:], peerPublicKey[:])
+	if err != nil {
+		return nil, err
+	}
+	var key [32]byte
+	chainKey, key = kdf2(chainKey[:], shared)
+	encryptedStatic, err := seal(key, publicKey, hashState)
+	if err != nil {
+		return nil, err
+	}
+	packet = append(packet, encryptedStatic...)
+	hashState = mixHash(hashState, encryptedStatic)
+
+	// timestamp
+	shared, err = curve25519.X25519(privateKey[:], peerPublicKey[:])
+	if err != nil {
+		return nil, err
+	}
+	_, key = kdf2(chainKey[:], shared)
+	now := time.Now()
+	timestamp := binary.BigEndian.AppendUint64(nil, uint64(tai64nBase+now.Unix()))
+	timestamp = binary.BigEndian.AppendUint32(timestamp, uint32(now.Nanosecond()))
+	encryptedTimestamp, err := seal(key, timestamp, hashState)
+	if err != nil {
+		return nil, err
+	}
+	packet = append(packet, encryptedTimestamp...)
+
+	// mac1 по ключу сервера, mac2 (cookie) пустой
+	macKey := blake2s.Sum256(append([]byte(wgLabelMAC1), peerPublicKey[:]...))
+	mac, err := blake2s.New128(macKey[:])
+	if err != nil {
+		return nil, err
+	}
+	mac.Write(packet)
+	packet = mac.Sum(packet)
+	packet = append(packet, make([]byte, 16)...)
+	return packet, nil
+}
+
+func mixHash(h []byte, data []byte) []byte {
+	sum := blake2s.Sum256(append(append([]byte{}, h...), data...))
+	return sum[:]
+}
+
+func hmacBlake2s(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(func() hash.Hash {
+		h, _ := blake2s.New256(nil)
+		return h
+	}, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+func kdf1(key []byte, input []byte) [32]byte {
+	t0 := hmacBlake2s(key, input)
+	var t1 [32]byte
+	copy(t1[:], hmacBlake2s(t0, []byte{1}))
+	return t1
+}
+
+func kdf2(key []byte, input []byte) ([32]byte, [32]byte) {
+	t0 := hmacBlake2s(key, input)
+	var t1, t2 [32]byte
+	copy(t1[:], hmacBlake2s(t0, []byte{1}))
+	copy(t2[:], hmacBlake2s(t0, t1[:], []byte{2}))
+	return t1, t2
+}
+
+func seal(key [32]byte, plaintext []byte, additional []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key[:])
+	if err != nil {
+		return nil, err
+	}
+	var nonce [chacha20poly1305.NonceSize]byte
+	return aead.Seal(nil, nonce[:], plaintext, additional), nil
+}
+
+func decodeKey(value string) ([32]byte, error) {
+	var key [32]byte
+	raw, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return key, err
+	}
+	if len(raw) != len(key) {
+		return key, fmt.Errorf("invalid key length %d", len(raw))
+	}
+	copy(key[:], raw)
+	return key, nil
+}
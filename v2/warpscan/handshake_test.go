@@ -0,0 +1,34 @@
+package warpscan
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"golang.org/x/crypto/blake2s"
+)
+
+func TestInitiationPacket(t *testing.T) {
+	var privateKey, peerPublicKey [32]byte
+	privateKey[0], peerPublicKey[0] = 1, 9
+	reserved := [3]byte{0x1a, 0x2b, 0x3c}
+	packet, err := initiationPacket(0x01020304, privateKey, peerPublicKey, reserved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packet) != messageInitiationSize {
+		t.Fatalf("size = %d", len(packet))
+	}
+	if packet[0] != messageTypeInitiation || [3]byte(packet[1:4]) != reserved {
+		t.Errorf("header = % x", packet[:4])
+	}
+	if index := binary.LittleEndian.Uint32(packet[4:8]); index != 0x01020304 {
+		t.Errorf("sender index = %x", index)
+	}
+	// mac1 покрывает reserved, иначе сервер отбросит пакет
+	macKey := blake2s.Sum256(append([]byte(wgLabelMAC1), peerPublicKey[:]...))
+	mac, _ := blake2s.New128(macKey[:])
+	mac.Write(packet[:messageInitiationSize-32])
+	if sum := mac.Sum(nil); string(sum) != string(packet[messageInitiationSize-32:messageInitiationSize-16]) {
+		t.Errorf("mac1 mismatch")
+	}
+}
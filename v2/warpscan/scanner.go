@@ -0,0 +1,180 @@
+// Package warpscan ищет доступные эндпоинты Cloudflare Warp: перебирает
+// диапазоны и порты Warp и проверяет каждый адрес настоящим WireGuard-рукопожатием.
+package warpscan
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/netip"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bepass-org/warp-plus/warp"
+)
+
+const (
+	DefaultConcurrency = 16
+	DefaultMaxRTT      = time.Second
+	DefaultTimeout     = 2 * time.Second
+	DefaultLimit       = 1000
+	DefaultCount       = 5
+)
+
+type Options struct {
+	// ключи аккаунта Warp: сервер отвечает только на рукопожатие известного устройства
+	PrivateKey    string
+	PeerPublicKey string
+	Reserved      [3]byte // байты client id, как в reserved аутбаунда
+
+	Prefixes    []netip.Prefix // пусто — все диапазоны Warp
+	Ports       []uint16       // пусто — все порты Warp
+	IPv4        bool
+	IPv6        bool
+	Concurrency int
+	MaxRTT      time.Duration // ответы медленнее не считаются годными
+	Timeout     time.Duration // ожидание ответа на одно рукопожатие
+	Limit       int           // сколько адресов проверить максимум
+	Count       int           // остановиться, найдя столько годных
+}
+
+type Result struct {
+	Endpoint netip.AddrPort
+	RTT      time.Duration
+}
+
+type Progress struct {
+	Probed int
+	Found  int
+	Total  int
+	Last   Result
+	Err    error
+}
+
+// Scan проверяет случайные адреса из диапазонов Warp и возвращает годные,
+// отсортированные по RTT. progress вызывается после каждой проверки
+// (из разных горутин, но последовательно).
+func Scan(ctx context.Context, options Options, progress func(Progress)) ([]Result, error) {
+	privateKey, err := decodeKey(options.PrivateKey)
+	if err != nil {
+		return nil, errors.New("invalid warp private key: " + err.Error())
+	}
+	peerPublicKey, err := decodeKey(options.PeerPublicKey)
+	if err != nil {
+		return nil, errors.New("invalid warp peer public key: " + err.Error())
+	}
+	options = normalizeOptions(options)
+	if len(options.Prefixes) == 0 {
+		return nil, errors.New("no warp prefixes to scan")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	candidates := make(chan netip.AddrPort)
+	go func() {
+		defer close(candidates)
+		for i := 0; i < options.Limit; i++ {
+			select {
+			case candidates <- randomEndpoint(options):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		access  sync.Mutex
+		results []Result
+		probed  atomic.Int32
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < options.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for endpoint := range candidates {
+				rtt, err := handshake(ctx, endpoint, privateKey, peerPublicKey, options.Reserved, options.Timeout)
+				if ctx.Err() != nil {
+					return
+				}
+				if err == nil && rtt > options.MaxRTT {
+					err = errors.New("rtt above limit: " + rtt.String())
+				}
+				access.Lock()
+				result := Result{Endpoint: endpoint, RTT: rtt}
+				if err == nil {
+					results = append(results, result)
+				}
+				found := len(results)
+				if progress != nil {
+					progress(Progress{Probed: int(probed.Add(1)), Found: found, Total: options.Limit, Last: result, Err: err})
+				}
+				access.Unlock()
+				if found >= options.Count {
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].RTT < results[j].RTT
+	})
+	if len(results) > options.Count {
+		results = results[:options.Count]
+	}
+	return results, nil
+}
+
+func normalizeOptions(options Options) Options {
+	if !options.IPv4 && !options.IPv6 {
+		options.IPv4 = true
+	}
+	if len(options.Prefixes) == 0 {
+		options.Prefixes = warp.WarpPrefixes()
+	}
+	var prefixes []netip.Prefix
+	for _, prefix := range options.Prefixes {
+		if (prefix.Addr().Is4() && options.IPv4) || (prefix.Addr().Is6() && options.IPv6) {
+			prefixes = append(prefixes, prefix.Masked())
+		}
+	}
+	options.Prefixes = prefixes
+	if len(options.Ports) == 0 {
+		options.Ports = warp.WarpPorts()
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultConcurrency
+	}
+	if options.MaxRTT <= 0 {
+		options.MaxRTT = DefaultMaxRTT
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+	if options.Limit <= 0 {
+		options.Limit = DefaultLimit
+	}
+	if options.Count <= 0 {
+		options.Count = DefaultCount
+	}
+	return options
+}
+
+func randomEndpoint(options Options) netip.AddrPort {
+	prefix := options.Prefixes[rand.Intn(len(options.Prefixes))]
+	addr := prefix.Addr().AsSlice()
+	hostBits := len(addr)*8 - prefix.Bits()
+	// случайные биты хостовой части, не больше 64 младших
+	for bit := 0; bit < hostBits && bit < 64; bit++ {
+		if rand.Intn(2) == 1 {
+			addr[len(addr)-1-bit/8] |= 1 << (bit % 8)
+		}
+	}
+	ip, _ := netip.AddrFromSlice(addr)
+	return netip.AddrPortFrom(ip, options.Ports[rand.Intn(len(options.Ports))])
+}